@@ -222,6 +222,65 @@ const (
 	ErrorReason_ORDER_REMARK_TOO_LONG ErrorReason = 118
 	// 订单提交失败 - Internal Server Error
 	ErrorReason_ORDER_SUBMIT_FAILED ErrorReason = 119
+	// ============ 搜索错误 ============
+	// 搜索服务不可用 - Service Unavailable
+	ErrorReason_SEARCH_UNAVAILABLE ErrorReason = 120
+	// 索引重建进行中 - Conflict
+	ErrorReason_GOODS_REINDEX_RUNNING ErrorReason = 121
+	// 没有可回滚的索引版本 - Conflict
+	ErrorReason_GOODS_INDEX_NO_PREVIOUS ErrorReason = 122
+	// 分页游标无效 - Bad Request
+	ErrorReason_SEARCH_PAGE_TOKEN_INVALID ErrorReason = 123
+	// 同义词规则无效 - Bad Request
+	ErrorReason_GOODS_SYNONYM_INVALID ErrorReason = 124
+	// ============ 商品 SKU 错误 ============
+	// SKU 不存在 - Not Found
+	ErrorReason_GOODS_SKU_NOT_FOUND ErrorReason = 130
+	// SKU 编码已存在 - Conflict
+	ErrorReason_GOODS_SKU_CODE_EXISTS ErrorReason = 131
+	// SKU 规格无效 - Bad Request
+	ErrorReason_GOODS_SKU_SPEC_INVALID ErrorReason = 132
+	// 相同规格的 SKU 已存在 - Conflict
+	ErrorReason_GOODS_SKU_SPEC_EXISTS ErrorReason = 133
+	// ============ 分类属性模板错误 ============
+	// 分类属性不存在 - Not Found
+	ErrorReason_CATEGORY_ATTRIBUTE_NOT_FOUND ErrorReason = 140
+	// 分类属性名称已存在（含上下级分类）- Conflict
+	ErrorReason_CATEGORY_ATTRIBUTE_EXISTS ErrorReason = 141
+	// 分类属性定义无效 - Bad Request
+	ErrorReason_CATEGORY_ATTRIBUTE_INVALID ErrorReason = 142
+	// 商品属性值不符合分类属性模板 - Bad Request
+	ErrorReason_GOODS_ATTRIBUTE_INVALID ErrorReason = 143
+	// ============ 商品发布流程错误 ============
+	// 商品状态流转不允许 - Conflict
+	ErrorReason_GOODS_STATUS_TRANSITION_INVALID ErrorReason = 150
+	// 上下架计划无效 - Bad Request
+	ErrorReason_GOODS_SCHEDULE_INVALID ErrorReason = 151
+	// ============ 商品导入导出错误 ============
+	// 导入文件无效（格式错误、缺少表头、超过行数限制等）- Bad Request
+	ErrorReason_GOODS_IMPORT_FILE_INVALID ErrorReason = 160
+	// ============ 商品价格错误 ============
+	// 价格变动幅度超过限制，需确认后强制修改 - Conflict
+	ErrorReason_GOODS_PRICE_CHANGE_TOO_LARGE ErrorReason = 170
+	// ============ 商品描述错误 ============
+	// 商品描述无效（格式不支持、超过大小限制、图片地址不合法等）- Bad Request
+	ErrorReason_GOODS_DESC_INVALID ErrorReason = 180
+	// 商品描述版本不存在 - Not Found
+	ErrorReason_GOODS_DESC_VERSION_NOT_FOUND ErrorReason = 181
+	// 商品描述已被并发修改 - Conflict
+	ErrorReason_GOODS_DESC_VERSION_CONFLICT ErrorReason = 182
+	// ============ 用户收藏错误 ============
+	// 收藏记录不存在 - Not Found
+	ErrorReason_USER_FAV_NOT_FOUND ErrorReason = 190
+	// ============ 商品评价错误 ============
+	// 评价不存在 - Not Found
+	ErrorReason_GOODS_REVIEW_NOT_FOUND ErrorReason = 200
+	// 订单未完成或不属于当前用户，不能评价 - Forbidden
+	ErrorReason_GOODS_REVIEW_NOT_ALLOWED ErrorReason = 201
+	// 该订单商品已评价 - Conflict
+	ErrorReason_GOODS_REVIEW_EXISTS ErrorReason = 202
+	// 评价内容无效（图片过多、图片地址不合法、审核状态不合法等）- Bad Request
+	ErrorReason_GOODS_REVIEW_INVALID ErrorReason = 203
 )
 
 // Enum value maps for ErrorReason.
@@ -321,102 +380,152 @@ var (
 		117: "ORDER_NOT_PAID",
 		118: "ORDER_REMARK_TOO_LONG",
 		119: "ORDER_SUBMIT_FAILED",
+		120: "SEARCH_UNAVAILABLE",
+		121: "GOODS_REINDEX_RUNNING",
+		122: "GOODS_INDEX_NO_PREVIOUS",
+		123: "SEARCH_PAGE_TOKEN_INVALID",
+		124: "GOODS_SYNONYM_INVALID",
+		130: "GOODS_SKU_NOT_FOUND",
+		131: "GOODS_SKU_CODE_EXISTS",
+		132: "GOODS_SKU_SPEC_INVALID",
+		133: "GOODS_SKU_SPEC_EXISTS",
+		140: "CATEGORY_ATTRIBUTE_NOT_FOUND",
+		141: "CATEGORY_ATTRIBUTE_EXISTS",
+		142: "CATEGORY_ATTRIBUTE_INVALID",
+		143: "GOODS_ATTRIBUTE_INVALID",
+		150: "GOODS_STATUS_TRANSITION_INVALID",
+		151: "GOODS_SCHEDULE_INVALID",
+		160: "GOODS_IMPORT_FILE_INVALID",
+		170: "GOODS_PRICE_CHANGE_TOO_LARGE",
+		180: "GOODS_DESC_INVALID",
+		181: "GOODS_DESC_VERSION_NOT_FOUND",
+		182: "GOODS_DESC_VERSION_CONFLICT",
+		190: "USER_FAV_NOT_FOUND",
+		200: "GOODS_REVIEW_NOT_FOUND",
+		201: "GOODS_REVIEW_NOT_ALLOWED",
+		202: "GOODS_REVIEW_EXISTS",
+		203: "GOODS_REVIEW_INVALID",
 	}
 	ErrorReason_value = map[string]int32{
-		"INVALID_PARAMS":               0,
-		"DATABASE_ERROR":               1,
-		"RECORD_NOT_FOUND":             2,
-		"INTERNAL_ERROR":               3,
-		"PERMISSION_DENIED":            4,
-		"UNAUTHORIZED":                 5,
-		"GOODS_NOT_FOUND":              10,
-		"GOODS_OFF_SALE":               11,
-		"GOODS_STOCK_INSUFFICIENT":     12,
-		"GOODS_DELETED":                13,
-		"GOODS_SN_EXISTS":              14,
-		"GOODS_NAME_EMPTY":             15,
-		"GOODS_PRICE_INVALID":          16,
-		"GOODS_CREATE_FAILED":          17,
-		"GOODS_UPDATE_FAILED":          18,
-		"GOODS_DELETE_FAILED":          19,
-		"BRAND_NOT_FOUND":              20,
-		"BRAND_NAME_EXISTS":            21,
-		"BRAND_NAME_EMPTY":             22,
-		"BRAND_CREATE_FAILED":          23,
-		"BRAND_UPDATE_FAILED":          24,
-		"BRAND_DELETE_FAILED":          25,
-		"BRAND_DELETED":                26,
-		"CATEGORY_NOT_FOUND":           30,
-		"CATEGORY_NAME_EXISTS":         31,
-		"CATEGORY_NAME_EMPTY":          32,
-		"CATEGORY_LEVEL_INVALID":       33,
-		"PARENT_CATEGORY_NOT_FOUND":    34,
-		"CATEGORY_CREATE_FAILED":       35,
-		"CATEGORY_UPDATE_FAILED":       36,
-		"CATEGORY_HAS_CHILDREN":        37,
-		"CATEGORY_HAS_GOODS":           38,
-		"CATEGORY_DELETED":             39,
-		"CATEGORY_PARENT_INVALID":      40,
-		"BANNER_NOT_FOUND":             50,
-		"BANNER_IMAGE_EMPTY":           51,
-		"BANNER_URL_EMPTY":             52,
-		"BANNER_CREATE_FAILED":         53,
-		"BANNER_UPDATE_FAILED":         54,
-		"BANNER_DELETE_FAILED":         55,
-		"BANNER_DELETED":               56,
-		"BANNER_INDEX_EXISTS":          57,
-		"CATEGORY_BRAND_NOT_FOUND":     60,
-		"CATEGORY_BRAND_EXISTS":        61,
-		"CATEGORY_BRAND_CREATE_FAILED": 62,
-		"CATEGORY_BRAND_DELETE_FAILED": 63,
-		"INVENTORY_NOT_FOUND":          70,
-		"INVENTORY_INSUFFICIENT":       71,
-		"INVENTORY_SELL_FAILED":        72,
-		"INVENTORY_REBACK_FAILED":      73,
-		"INVENTORY_SET_FAILED":         74,
-		"ORDER_SN_EMPTY":               75,
-		"ORDER_SN_EXISTS":              76,
-		"GOODS_ID_INVALID":             77,
-		"INVENTORY_NUM_INVALID":        78,
-		"INVENTORY_LOCKED":             79,
-		"INVENTORY_LOCK_FAILED":        80,
-		"INVENTORY_UNLOCK_FAILED":      81,
-		"INVENTORY_ALREADY_EXISTS":     82,
-		"INVENTORY_BATCH_FAILED":       83,
-		"INVENTORY_DATA_INCONSISTENT":  84,
-		"INVENTORY_INIT_FAILED":        85,
-		"INVENTORY_SYNC_FAILED":        86,
-		"INVENTORY_ROLLBACK_FAILED":    87,
-		"CART_NOT_FOUND":               90,
-		"CART_EMPTY":                   91,
-		"CART_ITEM_NOT_FOUND":          92,
-		"CART_CREATE_FAILED":           93,
-		"CART_UPDATE_FAILED":           94,
-		"CART_DELETE_FAILED":           95,
-		"CART_ITEM_NUM_INVALID":        96,
-		"CART_ITEM_EXISTS":             97,
-		"CART_EXPIRED":                 98,
-		"CART_ITEM_LIMIT_EXCEEDED":     99,
-		"ORDER_NOT_FOUND":              100,
-		"ORDER_CREATE_FAILED":          101,
-		"ORDER_UPDATE_FAILED":          102,
-		"ORDER_DELETE_FAILED":          103,
-		"ORDER_STATUS_INVALID":         104,
-		"ORDER_CANCELLED":              105,
-		"ORDER_COMPLETED":              106,
-		"ORDER_PAYMENT_FAILED":         107,
-		"ORDER_ALREADY_PAID":           108,
-		"ORDER_TIMEOUT":                109,
-		"ORDER_AMOUNT_INVALID":         110,
-		"ORDER_GOODS_EMPTY":            111,
-		"ORDER_ADDRESS_INVALID":        112,
-		"ORDER_RECEIVER_INVALID":       113,
-		"ORDER_CANNOT_CANCEL":          114,
-		"ORDER_CANNOT_MODIFY":          115,
-		"ORDER_SHIPPED":                116,
-		"ORDER_NOT_PAID":               117,
-		"ORDER_REMARK_TOO_LONG":        118,
-		"ORDER_SUBMIT_FAILED":          119,
+		"INVALID_PARAMS":                  0,
+		"DATABASE_ERROR":                  1,
+		"RECORD_NOT_FOUND":                2,
+		"INTERNAL_ERROR":                  3,
+		"PERMISSION_DENIED":               4,
+		"UNAUTHORIZED":                    5,
+		"GOODS_NOT_FOUND":                 10,
+		"GOODS_OFF_SALE":                  11,
+		"GOODS_STOCK_INSUFFICIENT":        12,
+		"GOODS_DELETED":                   13,
+		"GOODS_SN_EXISTS":                 14,
+		"GOODS_NAME_EMPTY":                15,
+		"GOODS_PRICE_INVALID":             16,
+		"GOODS_CREATE_FAILED":             17,
+		"GOODS_UPDATE_FAILED":             18,
+		"GOODS_DELETE_FAILED":             19,
+		"BRAND_NOT_FOUND":                 20,
+		"BRAND_NAME_EXISTS":               21,
+		"BRAND_NAME_EMPTY":                22,
+		"BRAND_CREATE_FAILED":             23,
+		"BRAND_UPDATE_FAILED":             24,
+		"BRAND_DELETE_FAILED":             25,
+		"BRAND_DELETED":                   26,
+		"CATEGORY_NOT_FOUND":              30,
+		"CATEGORY_NAME_EXISTS":            31,
+		"CATEGORY_NAME_EMPTY":             32,
+		"CATEGORY_LEVEL_INVALID":          33,
+		"PARENT_CATEGORY_NOT_FOUND":       34,
+		"CATEGORY_CREATE_FAILED":          35,
+		"CATEGORY_UPDATE_FAILED":          36,
+		"CATEGORY_HAS_CHILDREN":           37,
+		"CATEGORY_HAS_GOODS":              38,
+		"CATEGORY_DELETED":                39,
+		"CATEGORY_PARENT_INVALID":         40,
+		"BANNER_NOT_FOUND":                50,
+		"BANNER_IMAGE_EMPTY":              51,
+		"BANNER_URL_EMPTY":                52,
+		"BANNER_CREATE_FAILED":            53,
+		"BANNER_UPDATE_FAILED":            54,
+		"BANNER_DELETE_FAILED":            55,
+		"BANNER_DELETED":                  56,
+		"BANNER_INDEX_EXISTS":             57,
+		"CATEGORY_BRAND_NOT_FOUND":        60,
+		"CATEGORY_BRAND_EXISTS":           61,
+		"CATEGORY_BRAND_CREATE_FAILED":    62,
+		"CATEGORY_BRAND_DELETE_FAILED":    63,
+		"INVENTORY_NOT_FOUND":             70,
+		"INVENTORY_INSUFFICIENT":          71,
+		"INVENTORY_SELL_FAILED":           72,
+		"INVENTORY_REBACK_FAILED":         73,
+		"INVENTORY_SET_FAILED":            74,
+		"ORDER_SN_EMPTY":                  75,
+		"ORDER_SN_EXISTS":                 76,
+		"GOODS_ID_INVALID":                77,
+		"INVENTORY_NUM_INVALID":           78,
+		"INVENTORY_LOCKED":                79,
+		"INVENTORY_LOCK_FAILED":           80,
+		"INVENTORY_UNLOCK_FAILED":         81,
+		"INVENTORY_ALREADY_EXISTS":        82,
+		"INVENTORY_BATCH_FAILED":          83,
+		"INVENTORY_DATA_INCONSISTENT":     84,
+		"INVENTORY_INIT_FAILED":           85,
+		"INVENTORY_SYNC_FAILED":           86,
+		"INVENTORY_ROLLBACK_FAILED":       87,
+		"CART_NOT_FOUND":                  90,
+		"CART_EMPTY":                      91,
+		"CART_ITEM_NOT_FOUND":             92,
+		"CART_CREATE_FAILED":              93,
+		"CART_UPDATE_FAILED":              94,
+		"CART_DELETE_FAILED":              95,
+		"CART_ITEM_NUM_INVALID":           96,
+		"CART_ITEM_EXISTS":                97,
+		"CART_EXPIRED":                    98,
+		"CART_ITEM_LIMIT_EXCEEDED":        99,
+		"ORDER_NOT_FOUND":                 100,
+		"ORDER_CREATE_FAILED":             101,
+		"ORDER_UPDATE_FAILED":             102,
+		"ORDER_DELETE_FAILED":             103,
+		"ORDER_STATUS_INVALID":            104,
+		"ORDER_CANCELLED":                 105,
+		"ORDER_COMPLETED":                 106,
+		"ORDER_PAYMENT_FAILED":            107,
+		"ORDER_ALREADY_PAID":              108,
+		"ORDER_TIMEOUT":                   109,
+		"ORDER_AMOUNT_INVALID":            110,
+		"ORDER_GOODS_EMPTY":               111,
+		"ORDER_ADDRESS_INVALID":           112,
+		"ORDER_RECEIVER_INVALID":          113,
+		"ORDER_CANNOT_CANCEL":             114,
+		"ORDER_CANNOT_MODIFY":             115,
+		"ORDER_SHIPPED":                   116,
+		"ORDER_NOT_PAID":                  117,
+		"ORDER_REMARK_TOO_LONG":           118,
+		"ORDER_SUBMIT_FAILED":             119,
+		"SEARCH_UNAVAILABLE":              120,
+		"GOODS_REINDEX_RUNNING":           121,
+		"GOODS_INDEX_NO_PREVIOUS":         122,
+		"SEARCH_PAGE_TOKEN_INVALID":       123,
+		"GOODS_SYNONYM_INVALID":           124,
+		"GOODS_SKU_NOT_FOUND":             130,
+		"GOODS_SKU_CODE_EXISTS":           131,
+		"GOODS_SKU_SPEC_INVALID":          132,
+		"GOODS_SKU_SPEC_EXISTS":           133,
+		"CATEGORY_ATTRIBUTE_NOT_FOUND":    140,
+		"CATEGORY_ATTRIBUTE_EXISTS":       141,
+		"CATEGORY_ATTRIBUTE_INVALID":      142,
+		"GOODS_ATTRIBUTE_INVALID":         143,
+		"GOODS_STATUS_TRANSITION_INVALID": 150,
+		"GOODS_SCHEDULE_INVALID":          151,
+		"GOODS_IMPORT_FILE_INVALID":       160,
+		"GOODS_PRICE_CHANGE_TOO_LARGE":    170,
+		"GOODS_DESC_INVALID":              180,
+		"GOODS_DESC_VERSION_NOT_FOUND":    181,
+		"GOODS_DESC_VERSION_CONFLICT":     182,
+		"USER_FAV_NOT_FOUND":              190,
+		"GOODS_REVIEW_NOT_FOUND":          200,
+		"GOODS_REVIEW_NOT_ALLOWED":        201,
+		"GOODS_REVIEW_EXISTS":             202,
+		"GOODS_REVIEW_INVALID":            203,
 	}
 )
 
@@ -451,7 +560,7 @@ var File_error_reason_proto protoreflect.FileDescriptor
 
 const file_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x12error_reason.proto\x12\x04errx\x1a\x13errors/errors.proto*\xce\x16\n" +
+	"\x12error_reason.proto\x12\x04errx\x1a\x13errors/errors.proto*\xcd\x1d\n" +
 	"\vErrorReason\x12\x18\n" +
 	"\x0eINVALID_PARAMS\x10\x00\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eDATABASE_ERROR\x10\x01\x1a\x04\xa8E\xf4\x03\x12\x1a\n" +
@@ -548,7 +657,32 @@ const file_error_reason_proto_rawDesc = "" +
 	"\rORDER_SHIPPED\x10t\x1a\x04\xa8E\x99\x03\x12\x18\n" +
 	"\x0eORDER_NOT_PAID\x10u\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
 	"\x15ORDER_REMARK_TOO_LONG\x10v\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
-	"\x13ORDER_SUBMIT_FAILED\x10w\x1a\x04\xa8E\xf4\x03\x1a\x04\xa0E\xf4\x03B.\n" +
+	"\x13ORDER_SUBMIT_FAILED\x10w\x1a\x04\xa8E\xf4\x03\x12\x1c\n" +
+	"\x12SEARCH_UNAVAILABLE\x10x\x1a\x04\xa8E\xf7\x03\x12\x1f\n" +
+	"\x15GOODS_REINDEX_RUNNING\x10y\x1a\x04\xa8E\x99\x03\x12!\n" +
+	"\x17GOODS_INDEX_NO_PREVIOUS\x10z\x1a\x04\xa8E\x99\x03\x12#\n" +
+	"\x19SEARCH_PAGE_TOKEN_INVALID\x10{\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15GOODS_SYNONYM_INVALID\x10|\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
+	"\x13GOODS_SKU_NOT_FOUND\x10\x82\x01\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x15GOODS_SKU_CODE_EXISTS\x10\x83\x01\x1a\x04\xa8E\x99\x03\x12!\n" +
+	"\x16GOODS_SKU_SPEC_INVALID\x10\x84\x01\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x15GOODS_SKU_SPEC_EXISTS\x10\x85\x01\x1a\x04\xa8E\x99\x03\x12'\n" +
+	"\x1cCATEGORY_ATTRIBUTE_NOT_FOUND\x10\x8c\x01\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x19CATEGORY_ATTRIBUTE_EXISTS\x10\x8d\x01\x1a\x04\xa8E\x99\x03\x12%\n" +
+	"\x1aCATEGORY_ATTRIBUTE_INVALID\x10\x8e\x01\x1a\x04\xa8E\x90\x03\x12\"\n" +
+	"\x17GOODS_ATTRIBUTE_INVALID\x10\x8f\x01\x1a\x04\xa8E\x90\x03\x12*\n" +
+	"\x1fGOODS_STATUS_TRANSITION_INVALID\x10\x96\x01\x1a\x04\xa8E\x99\x03\x12!\n" +
+	"\x16GOODS_SCHEDULE_INVALID\x10\x97\x01\x1a\x04\xa8E\x90\x03\x12$\n" +
+	"\x19GOODS_IMPORT_FILE_INVALID\x10\xa0\x01\x1a\x04\xa8E\x90\x03\x12'\n" +
+	"\x1cGOODS_PRICE_CHANGE_TOO_LARGE\x10\xaa\x01\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x12GOODS_DESC_INVALID\x10\xb4\x01\x1a\x04\xa8E\x90\x03\x12'\n" +
+	"\x1cGOODS_DESC_VERSION_NOT_FOUND\x10\xb5\x01\x1a\x04\xa8E\x94\x03\x12&\n" +
+	"\x1bGOODS_DESC_VERSION_CONFLICT\x10\xb6\x01\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x12USER_FAV_NOT_FOUND\x10\xbe\x01\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x16GOODS_REVIEW_NOT_FOUND\x10\xc8\x01\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x18GOODS_REVIEW_NOT_ALLOWED\x10\xc9\x01\x1a\x04\xa8E\x93\x03\x12\x1e\n" +
+	"\x13GOODS_REVIEW_EXISTS\x10\xca\x01\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x14GOODS_REVIEW_INVALID\x10\xcb\x01\x1a\x04\xa8E\x90\x03\x1a\x04\xa0E\xf4\x03B.\n" +
 	"\x0ecom.mshop.errxP\x01Z\x13mshop/pkg/errx;errx\xa2\x02\x04ERRXb\x06proto3"
 
 var (
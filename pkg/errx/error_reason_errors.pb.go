@@ -1344,3 +1344,371 @@ func IsOrderSubmitFailed(err error) bool {
 func ErrorOrderSubmitFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, ErrorReason_ORDER_SUBMIT_FAILED.String(), fmt.Sprintf(format, args...))
 }
+
+// ============ 搜索错误 ============
+// 搜索服务不可用 - Service Unavailable
+func IsSearchUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SEARCH_UNAVAILABLE.String() && e.Code == 503
+}
+
+// ============ 搜索错误 ============
+// 搜索服务不可用 - Service Unavailable
+func ErrorSearchUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_SEARCH_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
+}
+
+// 索引重建进行中 - Conflict
+func IsGoodsReindexRunning(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_REINDEX_RUNNING.String() && e.Code == 409
+}
+
+// 索引重建进行中 - Conflict
+func ErrorGoodsReindexRunning(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_REINDEX_RUNNING.String(), fmt.Sprintf(format, args...))
+}
+
+// 没有可回滚的索引版本 - Conflict
+func IsGoodsIndexNoPrevious(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_INDEX_NO_PREVIOUS.String() && e.Code == 409
+}
+
+// 没有可回滚的索引版本 - Conflict
+func ErrorGoodsIndexNoPrevious(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_INDEX_NO_PREVIOUS.String(), fmt.Sprintf(format, args...))
+}
+
+// 分页游标无效 - Bad Request
+func IsSearchPageTokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SEARCH_PAGE_TOKEN_INVALID.String() && e.Code == 400
+}
+
+// 分页游标无效 - Bad Request
+func ErrorSearchPageTokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_SEARCH_PAGE_TOKEN_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 同义词规则无效 - Bad Request
+func IsGoodsSynonymInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SYNONYM_INVALID.String() && e.Code == 400
+}
+
+// 同义词规则无效 - Bad Request
+func ErrorGoodsSynonymInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_SYNONYM_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品 SKU 错误 ============
+// SKU 不存在 - Not Found
+func IsGoodsSkuNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SKU_NOT_FOUND.String() && e.Code == 404
+}
+
+// ============ 商品 SKU 错误 ============
+// SKU 不存在 - Not Found
+func ErrorGoodsSkuNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_GOODS_SKU_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// SKU 编码已存在 - Conflict
+func IsGoodsSkuCodeExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SKU_CODE_EXISTS.String() && e.Code == 409
+}
+
+// SKU 编码已存在 - Conflict
+func ErrorGoodsSkuCodeExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_SKU_CODE_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// SKU 规格无效 - Bad Request
+func IsGoodsSkuSpecInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SKU_SPEC_INVALID.String() && e.Code == 400
+}
+
+// SKU 规格无效 - Bad Request
+func ErrorGoodsSkuSpecInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_SKU_SPEC_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 相同规格的 SKU 已存在 - Conflict
+func IsGoodsSkuSpecExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SKU_SPEC_EXISTS.String() && e.Code == 409
+}
+
+// 相同规格的 SKU 已存在 - Conflict
+func ErrorGoodsSkuSpecExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_SKU_SPEC_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 分类属性模板错误 ============
+// 分类属性不存在 - Not Found
+func IsCategoryAttributeNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CATEGORY_ATTRIBUTE_NOT_FOUND.String() && e.Code == 404
+}
+
+// ============ 分类属性模板错误 ============
+// 分类属性不存在 - Not Found
+func ErrorCategoryAttributeNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_CATEGORY_ATTRIBUTE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 分类属性名称已存在（含上下级分类）- Conflict
+func IsCategoryAttributeExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CATEGORY_ATTRIBUTE_EXISTS.String() && e.Code == 409
+}
+
+// 分类属性名称已存在（含上下级分类）- Conflict
+func ErrorCategoryAttributeExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_CATEGORY_ATTRIBUTE_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// 分类属性定义无效 - Bad Request
+func IsCategoryAttributeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CATEGORY_ATTRIBUTE_INVALID.String() && e.Code == 400
+}
+
+// 分类属性定义无效 - Bad Request
+func ErrorCategoryAttributeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_CATEGORY_ATTRIBUTE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 商品属性值不符合分类属性模板 - Bad Request
+func IsGoodsAttributeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_ATTRIBUTE_INVALID.String() && e.Code == 400
+}
+
+// 商品属性值不符合分类属性模板 - Bad Request
+func ErrorGoodsAttributeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_ATTRIBUTE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品发布流程错误 ============
+// 商品状态流转不允许 - Conflict
+func IsGoodsStatusTransitionInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_STATUS_TRANSITION_INVALID.String() && e.Code == 409
+}
+
+// ============ 商品发布流程错误 ============
+// 商品状态流转不允许 - Conflict
+func ErrorGoodsStatusTransitionInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_STATUS_TRANSITION_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 上下架计划无效 - Bad Request
+func IsGoodsScheduleInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_SCHEDULE_INVALID.String() && e.Code == 400
+}
+
+// 上下架计划无效 - Bad Request
+func ErrorGoodsScheduleInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_SCHEDULE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品导入导出错误 ============
+// 导入文件无效（格式错误、缺少表头、超过行数限制等）- Bad Request
+func IsGoodsImportFileInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_IMPORT_FILE_INVALID.String() && e.Code == 400
+}
+
+// ============ 商品导入导出错误 ============
+// 导入文件无效（格式错误、缺少表头、超过行数限制等）- Bad Request
+func ErrorGoodsImportFileInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_IMPORT_FILE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品价格错误 ============
+// 价格变动幅度超过限制，需确认后强制修改 - Conflict
+func IsGoodsPriceChangeTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_PRICE_CHANGE_TOO_LARGE.String() && e.Code == 409
+}
+
+// ============ 商品价格错误 ============
+// 价格变动幅度超过限制，需确认后强制修改 - Conflict
+func ErrorGoodsPriceChangeTooLarge(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_PRICE_CHANGE_TOO_LARGE.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品描述错误 ============
+// 商品描述无效（格式不支持、超过大小限制、图片地址不合法等）- Bad Request
+func IsGoodsDescInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_DESC_INVALID.String() && e.Code == 400
+}
+
+// ============ 商品描述错误 ============
+// 商品描述无效（格式不支持、超过大小限制、图片地址不合法等）- Bad Request
+func ErrorGoodsDescInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_DESC_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// 商品描述版本不存在 - Not Found
+func IsGoodsDescVersionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_DESC_VERSION_NOT_FOUND.String() && e.Code == 404
+}
+
+// 商品描述版本不存在 - Not Found
+func ErrorGoodsDescVersionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_GOODS_DESC_VERSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 商品描述已被并发修改 - Conflict
+func IsGoodsDescVersionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_DESC_VERSION_CONFLICT.String() && e.Code == 409
+}
+
+// 商品描述已被并发修改 - Conflict
+func ErrorGoodsDescVersionConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_DESC_VERSION_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 用户收藏错误 ============
+// 收藏记录不存在 - Not Found
+func IsUserFavNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_FAV_NOT_FOUND.String() && e.Code == 404
+}
+
+// ============ 用户收藏错误 ============
+// 收藏记录不存在 - Not Found
+func ErrorUserFavNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_USER_FAV_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// ============ 商品评价错误 ============
+// 评价不存在 - Not Found
+func IsGoodsReviewNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_REVIEW_NOT_FOUND.String() && e.Code == 404
+}
+
+// ============ 商品评价错误 ============
+// 评价不存在 - Not Found
+func ErrorGoodsReviewNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_GOODS_REVIEW_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 订单未完成或不属于当前用户，不能评价 - Forbidden
+func IsGoodsReviewNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_REVIEW_NOT_ALLOWED.String() && e.Code == 403
+}
+
+// 订单未完成或不属于当前用户，不能评价 - Forbidden
+func ErrorGoodsReviewNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_GOODS_REVIEW_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
+// 该订单商品已评价 - Conflict
+func IsGoodsReviewExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_REVIEW_EXISTS.String() && e.Code == 409
+}
+
+// 该订单商品已评价 - Conflict
+func ErrorGoodsReviewExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_GOODS_REVIEW_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+// 评价内容无效（图片过多、图片地址不合法、审核状态不合法等）- Bad Request
+func IsGoodsReviewInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_GOODS_REVIEW_INVALID.String() && e.Code == 400
+}
+
+// 评价内容无效（图片过多、图片地址不合法、审核状态不合法等）- Bad Request
+func ErrorGoodsReviewInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_GOODS_REVIEW_INVALID.String(), fmt.Sprintf(format, args...))
+}
@@ -21,6 +21,181 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 分类属性类型
+type CategoryAttributeType int32
+
+const (
+	CategoryAttributeType_CATEGORY_ATTRIBUTE_TEXT   CategoryAttributeType = 0 // 文本
+	CategoryAttributeType_CATEGORY_ATTRIBUTE_NUMBER CategoryAttributeType = 1 // 数值
+	CategoryAttributeType_CATEGORY_ATTRIBUTE_ENUM   CategoryAttributeType = 2 // 枚举，取值限定为 options
+	CategoryAttributeType_CATEGORY_ATTRIBUTE_BOOL   CategoryAttributeType = 3 // 布尔，取值为 true 或 false
+)
+
+// Enum value maps for CategoryAttributeType.
+var (
+	CategoryAttributeType_name = map[int32]string{
+		0: "CATEGORY_ATTRIBUTE_TEXT",
+		1: "CATEGORY_ATTRIBUTE_NUMBER",
+		2: "CATEGORY_ATTRIBUTE_ENUM",
+		3: "CATEGORY_ATTRIBUTE_BOOL",
+	}
+	CategoryAttributeType_value = map[string]int32{
+		"CATEGORY_ATTRIBUTE_TEXT":   0,
+		"CATEGORY_ATTRIBUTE_NUMBER": 1,
+		"CATEGORY_ATTRIBUTE_ENUM":   2,
+		"CATEGORY_ATTRIBUTE_BOOL":   3,
+	}
+)
+
+func (x CategoryAttributeType) Enum() *CategoryAttributeType {
+	p := new(CategoryAttributeType)
+	*p = x
+	return p
+}
+
+func (x CategoryAttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategoryAttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_goods_v1_message_proto_enumTypes[0].Descriptor()
+}
+
+func (CategoryAttributeType) Type() protoreflect.EnumType {
+	return &file_goods_v1_message_proto_enumTypes[0]
+}
+
+func (x CategoryAttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategoryAttributeType.Descriptor instead.
+func (CategoryAttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+// 商品排序方式
+type GoodsSort int32
+
+const (
+	GoodsSort_GOODS_SORT_DEFAULT    GoodsSort = 0 // 相关度
+	GoodsSort_GOODS_SORT_PRICE_ASC  GoodsSort = 1 // 价格从低到高
+	GoodsSort_GOODS_SORT_PRICE_DESC GoodsSort = 2 // 价格从高到低
+	GoodsSort_GOODS_SORT_SALES      GoodsSort = 3 // 销量
+	GoodsSort_GOODS_SORT_NEWEST     GoodsSort = 4 // 上架时间
+	GoodsSort_GOODS_SORT_POPULAR    GoodsSort = 5 // 人气（点击数、收藏数）
+)
+
+// Enum value maps for GoodsSort.
+var (
+	GoodsSort_name = map[int32]string{
+		0: "GOODS_SORT_DEFAULT",
+		1: "GOODS_SORT_PRICE_ASC",
+		2: "GOODS_SORT_PRICE_DESC",
+		3: "GOODS_SORT_SALES",
+		4: "GOODS_SORT_NEWEST",
+		5: "GOODS_SORT_POPULAR",
+	}
+	GoodsSort_value = map[string]int32{
+		"GOODS_SORT_DEFAULT":    0,
+		"GOODS_SORT_PRICE_ASC":  1,
+		"GOODS_SORT_PRICE_DESC": 2,
+		"GOODS_SORT_SALES":      3,
+		"GOODS_SORT_NEWEST":     4,
+		"GOODS_SORT_POPULAR":    5,
+	}
+)
+
+func (x GoodsSort) Enum() *GoodsSort {
+	p := new(GoodsSort)
+	*p = x
+	return p
+}
+
+func (x GoodsSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoodsSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_goods_v1_message_proto_enumTypes[1].Descriptor()
+}
+
+func (GoodsSort) Type() protoreflect.EnumType {
+	return &file_goods_v1_message_proto_enumTypes[1]
+}
+
+func (x GoodsSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoodsSort.Descriptor instead.
+func (GoodsSort) EnumDescriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+// 商品发布状态
+// 草稿 → 待审核 → 已审核 → 上架 ⇄ 下架 → 归档，上架状态与 onSale 保持一致
+type GoodsStatus int32
+
+const (
+	GoodsStatus_GOODS_STATUS_UNSPECIFIED    GoodsStatus = 0 // 未指定，流程上线前创建的商品按 onSale 视为上架或下架
+	GoodsStatus_GOODS_STATUS_DRAFT          GoodsStatus = 1 // 草稿
+	GoodsStatus_GOODS_STATUS_PENDING_REVIEW GoodsStatus = 2 // 待审核
+	GoodsStatus_GOODS_STATUS_APPROVED       GoodsStatus = 3 // 已审核
+	GoodsStatus_GOODS_STATUS_ON_SALE        GoodsStatus = 4 // 上架
+	GoodsStatus_GOODS_STATUS_OFF_SALE       GoodsStatus = 5 // 下架
+	GoodsStatus_GOODS_STATUS_ARCHIVED       GoodsStatus = 6 // 归档
+)
+
+// Enum value maps for GoodsStatus.
+var (
+	GoodsStatus_name = map[int32]string{
+		0: "GOODS_STATUS_UNSPECIFIED",
+		1: "GOODS_STATUS_DRAFT",
+		2: "GOODS_STATUS_PENDING_REVIEW",
+		3: "GOODS_STATUS_APPROVED",
+		4: "GOODS_STATUS_ON_SALE",
+		5: "GOODS_STATUS_OFF_SALE",
+		6: "GOODS_STATUS_ARCHIVED",
+	}
+	GoodsStatus_value = map[string]int32{
+		"GOODS_STATUS_UNSPECIFIED":    0,
+		"GOODS_STATUS_DRAFT":          1,
+		"GOODS_STATUS_PENDING_REVIEW": 2,
+		"GOODS_STATUS_APPROVED":       3,
+		"GOODS_STATUS_ON_SALE":        4,
+		"GOODS_STATUS_OFF_SALE":       5,
+		"GOODS_STATUS_ARCHIVED":       6,
+	}
+)
+
+func (x GoodsStatus) Enum() *GoodsStatus {
+	p := new(GoodsStatus)
+	*p = x
+	return p
+}
+
+func (x GoodsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoodsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_goods_v1_message_proto_enumTypes[2].Descriptor()
+}
+
+func (GoodsStatus) Type() protoreflect.EnumType {
+	return &file_goods_v1_message_proto_enumTypes[2]
+}
+
+func (x GoodsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoodsStatus.Descriptor instead.
+func (GoodsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
 // Empty 消息类型，用于不需要返回数据的 RPC 调用
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -485,6 +660,160 @@ func (x *SubCategoryListResponse) GetSubCategorys() []*CategoryInfoResponse {
 	return nil
 }
 
+// 分类属性模板
+type CategoryAttributeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                           // 属性ID
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`                                           // 所属分类ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                        // 属性名称，例如 产地、保质期
+	Type          CategoryAttributeType  `protobuf:"varint,4,opt,name=type,proto3,enum=service.goods.api.goods.v1.CategoryAttributeType" json:"type,omitempty"` // 属性类型
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`                                               // 是否必填
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`                                                  // 枚举可选值，仅枚举类型使用
+	Unit          string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"`                                                        // 单位，例如 天、℃、g
+	Sort          int32                  `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`                                                       // 排序，数值小的在前
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributeInfo) Reset() {
+	*x = CategoryAttributeInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeInfo) ProtoMessage() {}
+
+func (x *CategoryAttributeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeInfo.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CategoryAttributeInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryAttributeInfo) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryAttributeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryAttributeInfo) GetType() CategoryAttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return CategoryAttributeType_CATEGORY_ATTRIBUTE_TEXT
+}
+
+func (x *CategoryAttributeInfo) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttributeInfo) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CategoryAttributeInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CategoryAttributeInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 分类属性模板列表响应
+type CategoryAttributeListResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Total         int32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*CategoryAttributeInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 属性模板列表，含从上级分类继承的属性
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributeListResponse) Reset() {
+	*x = CategoryAttributeListResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeListResponse) ProtoMessage() {}
+
+func (x *CategoryAttributeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeListResponse.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CategoryAttributeListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CategoryAttributeListResponse) GetData() []*CategoryAttributeInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // 品牌分类过滤请求
 type CategoryBrandFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -496,7 +825,7 @@ type CategoryBrandFilterRequest struct {
 
 func (x *CategoryBrandFilterRequest) Reset() {
 	*x = CategoryBrandFilterRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[8]
+	mi := &file_goods_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +837,7 @@ func (x *CategoryBrandFilterRequest) String() string {
 func (*CategoryBrandFilterRequest) ProtoMessage() {}
 
 func (x *CategoryBrandFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[8]
+	mi := &file_goods_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +850,7 @@ func (x *CategoryBrandFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandFilterRequest.ProtoReflect.Descriptor instead.
 func (*CategoryBrandFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CategoryBrandFilterRequest) GetPages() int32 {
@@ -549,7 +878,7 @@ type FilterRequest struct {
 
 func (x *FilterRequest) Reset() {
 	*x = FilterRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[9]
+	mi := &file_goods_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +890,7 @@ func (x *FilterRequest) String() string {
 func (*FilterRequest) ProtoMessage() {}
 
 func (x *FilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[9]
+	mi := &file_goods_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +903,7 @@ func (x *FilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
 func (*FilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{9}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FilterRequest) GetPages() int32 {
@@ -603,7 +932,7 @@ type CategoryBrandRequest struct {
 
 func (x *CategoryBrandRequest) Reset() {
 	*x = CategoryBrandRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[10]
+	mi := &file_goods_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +944,7 @@ func (x *CategoryBrandRequest) String() string {
 func (*CategoryBrandRequest) ProtoMessage() {}
 
 func (x *CategoryBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[10]
+	mi := &file_goods_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +957,7 @@ func (x *CategoryBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandRequest.ProtoReflect.Descriptor instead.
 func (*CategoryBrandRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{10}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CategoryBrandRequest) GetId() int32 {
@@ -664,7 +993,7 @@ type CategoryBrandResponse struct {
 
 func (x *CategoryBrandResponse) Reset() {
 	*x = CategoryBrandResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[11]
+	mi := &file_goods_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -676,7 +1005,7 @@ func (x *CategoryBrandResponse) String() string {
 func (*CategoryBrandResponse) ProtoMessage() {}
 
 func (x *CategoryBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[11]
+	mi := &file_goods_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +1018,7 @@ func (x *CategoryBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandResponse.ProtoReflect.Descriptor instead.
 func (*CategoryBrandResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{11}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CategoryBrandResponse) GetId() int32 {
@@ -726,7 +1055,7 @@ type BannerRequest struct {
 
 func (x *BannerRequest) Reset() {
 	*x = BannerRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[12]
+	mi := &file_goods_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +1067,7 @@ func (x *BannerRequest) String() string {
 func (*BannerRequest) ProtoMessage() {}
 
 func (x *BannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[12]
+	mi := &file_goods_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1080,7 @@ func (x *BannerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BannerRequest.ProtoReflect.Descriptor instead.
 func (*BannerRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{12}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BannerRequest) GetId() int32 {
@@ -795,7 +1124,7 @@ type BannerResponse struct {
 
 func (x *BannerResponse) Reset() {
 	*x = BannerResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[13]
+	mi := &file_goods_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +1136,7 @@ func (x *BannerResponse) String() string {
 func (*BannerResponse) ProtoMessage() {}
 
 func (x *BannerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[13]
+	mi := &file_goods_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1149,7 @@ func (x *BannerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BannerResponse.ProtoReflect.Descriptor instead.
 func (*BannerResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BannerResponse) GetId() int32 {
@@ -862,7 +1191,7 @@ type BannerListResponse struct {
 
 func (x *BannerListResponse) Reset() {
 	*x = BannerListResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[14]
+	mi := &file_goods_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +1203,7 @@ func (x *BannerListResponse) String() string {
 func (*BannerListResponse) ProtoMessage() {}
 
 func (x *BannerListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[14]
+	mi := &file_goods_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1216,7 @@ func (x *BannerListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BannerListResponse.ProtoReflect.Descriptor instead.
 func (*BannerListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BannerListResponse) GetTotal() int32 {
@@ -915,7 +1244,7 @@ type BrandFilterRequest struct {
 
 func (x *BrandFilterRequest) Reset() {
 	*x = BrandFilterRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[15]
+	mi := &file_goods_v1_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -927,7 +1256,7 @@ func (x *BrandFilterRequest) String() string {
 func (*BrandFilterRequest) ProtoMessage() {}
 
 func (x *BrandFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[15]
+	mi := &file_goods_v1_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +1269,7 @@ func (x *BrandFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrandFilterRequest.ProtoReflect.Descriptor instead.
 func (*BrandFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BrandFilterRequest) GetPages() int32 {
@@ -969,7 +1298,7 @@ type BrandRequest struct {
 
 func (x *BrandRequest) Reset() {
 	*x = BrandRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[16]
+	mi := &file_goods_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1310,7 @@ func (x *BrandRequest) String() string {
 func (*BrandRequest) ProtoMessage() {}
 
 func (x *BrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[16]
+	mi := &file_goods_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1323,7 @@ func (x *BrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrandRequest.ProtoReflect.Descriptor instead.
 func (*BrandRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{16}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BrandRequest) GetId() int32 {
@@ -1030,7 +1359,7 @@ type BrandInfoResponse struct {
 
 func (x *BrandInfoResponse) Reset() {
 	*x = BrandInfoResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[17]
+	mi := &file_goods_v1_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1042,7 +1371,7 @@ func (x *BrandInfoResponse) String() string {
 func (*BrandInfoResponse) ProtoMessage() {}
 
 func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[17]
+	mi := &file_goods_v1_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1384,7 @@ func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrandInfoResponse.ProtoReflect.Descriptor instead.
 func (*BrandInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{17}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BrandInfoResponse) GetId() int32 {
@@ -1090,7 +1419,7 @@ type BrandListResponse struct {
 
 func (x *BrandListResponse) Reset() {
 	*x = BrandListResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[18]
+	mi := &file_goods_v1_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1102,7 +1431,7 @@ func (x *BrandListResponse) String() string {
 func (*BrandListResponse) ProtoMessage() {}
 
 func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[18]
+	mi := &file_goods_v1_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1444,7 @@ func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrandListResponse.ProtoReflect.Descriptor instead.
 func (*BrandListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{18}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BrandListResponse) GetTotal() int32 {
@@ -1143,7 +1472,7 @@ type CategoryBrandListResponse struct {
 
 func (x *CategoryBrandListResponse) Reset() {
 	*x = CategoryBrandListResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[19]
+	mi := &file_goods_v1_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1155,7 +1484,7 @@ func (x *CategoryBrandListResponse) String() string {
 func (*CategoryBrandListResponse) ProtoMessage() {}
 
 func (x *CategoryBrandListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[19]
+	mi := &file_goods_v1_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1497,7 @@ func (x *CategoryBrandListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandListResponse.ProtoReflect.Descriptor instead.
 func (*CategoryBrandListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{19}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CategoryBrandListResponse) GetTotal() int32 {
@@ -1195,7 +1524,7 @@ type BatchGoodsIdInfo struct {
 
 func (x *BatchGoodsIdInfo) Reset() {
 	*x = BatchGoodsIdInfo{}
-	mi := &file_goods_v1_message_proto_msgTypes[20]
+	mi := &file_goods_v1_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1207,7 +1536,7 @@ func (x *BatchGoodsIdInfo) String() string {
 func (*BatchGoodsIdInfo) ProtoMessage() {}
 
 func (x *BatchGoodsIdInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[20]
+	mi := &file_goods_v1_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1549,7 @@ func (x *BatchGoodsIdInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGoodsIdInfo.ProtoReflect.Descriptor instead.
 func (*BatchGoodsIdInfo) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{20}
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BatchGoodsIdInfo) GetId() []int32 {
@@ -1230,29 +1559,2829 @@ func (x *BatchGoodsIdInfo) GetId() []int32 {
 	return nil
 }
 
-// 删除商品信息
-type DeleteGoodsInfo struct {
+// 按商品编号查询请求
+type GoodsSnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 要删除的商品ID
+	GoodsSn       string                 `protobuf:"bytes,1,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"` // 商品编号
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGoodsInfo) Reset() {
+func (x *GoodsSnRequest) Reset() {
+	*x = GoodsSnRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSnRequest) ProtoMessage() {}
+
+func (x *GoodsSnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSnRequest.ProtoReflect.Descriptor instead.
+func (*GoodsSnRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GoodsSnRequest) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+// 批量商品编号请求
+type BatchGoodsSnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsSn       []string               `protobuf:"bytes,1,rep,name=goodsSn,proto3" json:"goodsSn,omitempty"` // 商品编号列表，最多 1000 个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGoodsSnRequest) Reset() {
+	*x = BatchGoodsSnRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGoodsSnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGoodsSnRequest) ProtoMessage() {}
+
+func (x *BatchGoodsSnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGoodsSnRequest.ProtoReflect.Descriptor instead.
+func (*BatchGoodsSnRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchGoodsSnRequest) GetGoodsSn() []string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return nil
+}
+
+// 商品编号与商品ID
+type GoodsSnIdInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsSn       string                 `protobuf:"bytes,1,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"` // 商品编号
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`          // 商品ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSnIdInfo) Reset() {
+	*x = GoodsSnIdInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSnIdInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSnIdInfo) ProtoMessage() {}
+
+func (x *GoodsSnIdInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSnIdInfo.ProtoReflect.Descriptor instead.
+func (*GoodsSnIdInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GoodsSnIdInfo) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *GoodsSnIdInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 商品计数
+type GoodsCounterInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`   // 商品ID
+	ClickNum      int32                  `protobuf:"varint,2,opt,name=clickNum,proto3" json:"clickNum,omitempty"` // 点击数，累加时为增量，不能为负数
+	FavNum        int32                  `protobuf:"varint,3,opt,name=favNum,proto3" json:"favNum,omitempty"`     // 收藏数，累加时为增量，取消收藏为负数
+	SoldNum       int32                  `protobuf:"varint,4,opt,name=soldNum,proto3" json:"soldNum,omitempty"`   // 销量，累加时为增量，退款为负数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsCounterInfo) Reset() {
+	*x = GoodsCounterInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsCounterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsCounterInfo) ProtoMessage() {}
+
+func (x *GoodsCounterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsCounterInfo.ProtoReflect.Descriptor instead.
+func (*GoodsCounterInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GoodsCounterInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsCounterInfo) GetClickNum() int32 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
+}
+
+func (x *GoodsCounterInfo) GetFavNum() int32 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *GoodsCounterInfo) GetSoldNum() int32 {
+	if x != nil {
+		return x.SoldNum
+	}
+	return 0
+}
+
+// 商品计数累加请求
+type IncrGoodsCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*GoodsCounterInfo    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`         // 各商品的计数增量，最多 100 个
+	RequestId     string                 `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"` // 幂等键，相同幂等键 7 天内只计一次，例如 order-paid:{orderSn}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrGoodsCountersRequest) Reset() {
+	*x = IncrGoodsCountersRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrGoodsCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrGoodsCountersRequest) ProtoMessage() {}
+
+func (x *IncrGoodsCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrGoodsCountersRequest.ProtoReflect.Descriptor instead.
+func (*IncrGoodsCountersRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *IncrGoodsCountersRequest) GetItems() []*GoodsCounterInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *IncrGoodsCountersRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// 商品计数响应
+type GoodsCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*GoodsCounterInfo    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // 商品计数，按请求顺序排列，不含不存在的商品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsCountersResponse) Reset() {
+	*x = GoodsCountersResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsCountersResponse) ProtoMessage() {}
+
+func (x *GoodsCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsCountersResponse.ProtoReflect.Descriptor instead.
+func (*GoodsCountersResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GoodsCountersResponse) GetData() []*GoodsCounterInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 商品推荐请求
+type GoodsRecommendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsIds      []int32                `protobuf:"varint,1,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"` // 商品ID，详情页传当前商品，购物车页传购物车中的商品，最多50个
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`              // 推荐数量，默认10，最多50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRecommendRequest) Reset() {
+	*x = GoodsRecommendRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRecommendRequest) ProtoMessage() {}
+
+func (x *GoodsRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRecommendRequest.ProtoReflect.Descriptor instead.
+func (*GoodsRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GoodsRecommendRequest) GetGoodsIds() []int32 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+func (x *GoodsRecommendRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 推荐商品
+type RecommendGoodsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goods         *GoodsInfoResponse     `protobuf:"bytes,1,opt,name=goods,proto3" json:"goods,omitempty"`   // 商品信息
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 推荐来源：co_purchase(经常一起购买)、category_hot(同类热销)
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`  // 共同购买的订单数，同类热销为 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendGoodsInfo) Reset() {
+	*x = RecommendGoodsInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendGoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendGoodsInfo) ProtoMessage() {}
+
+func (x *RecommendGoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendGoodsInfo.ProtoReflect.Descriptor instead.
+func (*RecommendGoodsInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RecommendGoodsInfo) GetGoods() *GoodsInfoResponse {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *RecommendGoodsInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecommendGoodsInfo) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 商品推荐响应
+type GoodsRecommendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*RecommendGoodsInfo  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // 推荐商品，按推荐度排序，不含请求中的商品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRecommendResponse) Reset() {
+	*x = GoodsRecommendResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRecommendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRecommendResponse) ProtoMessage() {}
+
+func (x *GoodsRecommendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRecommendResponse.ProtoReflect.Descriptor instead.
+func (*GoodsRecommendResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GoodsRecommendResponse) GetData() []*RecommendGoodsInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 商品评分汇总，由订单服务在评价审核后同步
+type GoodsRatingInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`         // 商品ID
+	RatingAvg     float32                `protobuf:"fixed32,2,opt,name=ratingAvg,proto3" json:"ratingAvg,omitempty"`    // 平均评分
+	RatingCount   int32                  `protobuf:"varint,3,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"` // 审核通过的评价数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRatingInfo) Reset() {
+	*x = GoodsRatingInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRatingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRatingInfo) ProtoMessage() {}
+
+func (x *GoodsRatingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRatingInfo.ProtoReflect.Descriptor instead.
+func (*GoodsRatingInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GoodsRatingInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsRatingInfo) GetRatingAvg() float32 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *GoodsRatingInfo) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+// 批量商品编号解析响应
+type BatchGoodsSnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*GoodsSnIdInfo       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`         // 已找到的商品，按请求顺序排列
+	NotFound      []string               `protobuf:"bytes,2,rep,name=notFound,proto3" json:"notFound,omitempty"` // 不存在或已删除的商品编号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGoodsSnResponse) Reset() {
+	*x = BatchGoodsSnResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGoodsSnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGoodsSnResponse) ProtoMessage() {}
+
+func (x *BatchGoodsSnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGoodsSnResponse.ProtoReflect.Descriptor instead.
+func (*BatchGoodsSnResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchGoodsSnResponse) GetData() []*GoodsSnIdInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BatchGoodsSnResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// 删除商品信息
+type DeleteGoodsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 要删除的商品ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoodsInfo) Reset() {
 	*x = DeleteGoodsInfo{}
-	mi := &file_goods_v1_message_proto_msgTypes[21]
+	mi := &file_goods_v1_message_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoodsInfo) ProtoMessage() {}
+
+func (x *DeleteGoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoodsInfo.ProtoReflect.Descriptor instead.
+func (*DeleteGoodsInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteGoodsInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 分类简要信息响应
+type CategoryBriefInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`    // 分类ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 分类名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryBriefInfoResponse) Reset() {
+	*x = CategoryBriefInfoResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryBriefInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryBriefInfoResponse) ProtoMessage() {}
+
+func (x *CategoryBriefInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryBriefInfoResponse.ProtoReflect.Descriptor instead.
+func (*CategoryBriefInfoResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CategoryBriefInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryBriefInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 分类过滤请求
+type CategoryFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // 分类ID
+	IsTab         bool                   `protobuf:"varint,2,opt,name=isTab,proto3" json:"isTab,omitempty"` // 是否为标签页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFilterRequest) Reset() {
+	*x = CategoryFilterRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFilterRequest) ProtoMessage() {}
+
+func (x *CategoryFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFilterRequest.ProtoReflect.Descriptor instead.
+func (*CategoryFilterRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CategoryFilterRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryFilterRequest) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+// 商品信息请求
+type GoodInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 商品ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodInfoRequest) Reset() {
+	*x = GoodInfoRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodInfoRequest) ProtoMessage() {}
+
+func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodInfoRequest.ProtoReflect.Descriptor instead.
+func (*GoodInfoRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GoodInfoRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 创建商品信息
+type CreateGoodsInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // 商品ID
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // 商品名称
+	GoodsSn           string                 `protobuf:"bytes,3,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`                      // 商品编号
+	Stocks            int32                  `protobuf:"varint,7,opt,name=stocks,proto3" json:"stocks,omitempty"`                       // 库存数量
+	MarketPrice       float32                `protobuf:"fixed32,8,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`            // 市场价格
+	ShopPrice         float32                `protobuf:"fixed32,9,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`                // 店铺价格
+	GoodsBrief        string                 `protobuf:"bytes,10,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`               // 商品简介
+	GoodsDesc         string                 `protobuf:"bytes,11,opt,name=goodsDesc,proto3" json:"goodsDesc,omitempty"`                 // 商品详情描述，HTML 或 Markdown，更新时传入且内容变化则生成新版本
+	ShipFree          bool                   `protobuf:"varint,12,opt,name=shipFree,proto3" json:"shipFree,omitempty"`                  // 是否包邮
+	Images            []string               `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`                       // 商品图片列表
+	DescImages        []string               `protobuf:"bytes,14,rep,name=descImages,proto3" json:"descImages,omitempty"`               // 商品描述图片列表
+	GoodsFrontImage   string                 `protobuf:"bytes,15,opt,name=goodsFrontImage,proto3" json:"goodsFrontImage,omitempty"`     // 商品主图
+	IsNew             bool                   `protobuf:"varint,16,opt,name=isNew,proto3" json:"isNew,omitempty"`                        // 是否新品
+	IsHot             bool                   `protobuf:"varint,17,opt,name=isHot,proto3" json:"isHot,omitempty"`                        // 是否热销
+	OnSale            bool                   `protobuf:"varint,18,opt,name=onSale,proto3" json:"onSale,omitempty"`                      // 已废弃，上下架通过 UpdateGoodsStatus 流转
+	CategoryId        int32                  `protobuf:"varint,19,opt,name=categoryId,proto3" json:"categoryId,omitempty"`              // 分类ID
+	BrandId           int32                  `protobuf:"varint,20,opt,name=brandId,proto3" json:"brandId,omitempty"`                    // 品牌ID
+	Attrs             []*GoodsAttrValue      `protobuf:"bytes,21,rep,name=attrs,proto3" json:"attrs,omitempty"`                         // 分类属性值，按分类属性模板校验
+	Operator          string                 `protobuf:"bytes,22,opt,name=operator,proto3" json:"operator,omitempty"`                   // 操作人，修改价格时必填，记录到价格变动记录
+	PriceChangeReason string                 `protobuf:"bytes,23,opt,name=priceChangeReason,proto3" json:"priceChangeReason,omitempty"` // 改价原因
+	ForcePriceChange  bool                   `protobuf:"varint,24,opt,name=forcePriceChange,proto3" json:"forcePriceChange,omitempty"`  // 确认改价，价格变动幅度超过限制时需要传入
+	DescFormat        string                 `protobuf:"bytes,25,opt,name=descFormat,proto3" json:"descFormat,omitempty"`               // 描述格式 html 或 markdown，默认 html
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateGoodsInfo) Reset() {
+	*x = CreateGoodsInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsInfo) ProtoMessage() {}
+
+func (x *CreateGoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsInfo.ProtoReflect.Descriptor instead.
+func (*CreateGoodsInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateGoodsInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetStocks() int32 {
+	if x != nil {
+		return x.Stocks
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetMarketPrice() float32 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetShopPrice() float32 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetGoodsDesc() string {
+	if x != nil {
+		return x.GoodsDesc
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *CreateGoodsInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateGoodsInfo) GetDescImages() []string {
+	if x != nil {
+		return x.DescImages
+	}
+	return nil
+}
+
+func (x *CreateGoodsInfo) GetGoodsFrontImage() string {
+	if x != nil {
+		return x.GoodsFrontImage
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *CreateGoodsInfo) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *CreateGoodsInfo) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *CreateGoodsInfo) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *CreateGoodsInfo) GetAttrs() []*GoodsAttrValue {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *CreateGoodsInfo) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetPriceChangeReason() string {
+	if x != nil {
+		return x.PriceChangeReason
+	}
+	return ""
+}
+
+func (x *CreateGoodsInfo) GetForcePriceChange() bool {
+	if x != nil {
+		return x.ForcePriceChange
+	}
+	return false
+}
+
+func (x *CreateGoodsInfo) GetDescFormat() string {
+	if x != nil {
+		return x.DescFormat
+	}
+	return ""
+}
+
+// 商品属性值
+type GoodsAttrValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // 属性名称
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // 属性值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsAttrValue) Reset() {
+	*x = GoodsAttrValue{}
+	mi := &file_goods_v1_message_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsAttrValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsAttrValue) ProtoMessage() {}
+
+func (x *GoodsAttrValue) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsAttrValue.ProtoReflect.Descriptor instead.
+func (*GoodsAttrValue) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GoodsAttrValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsAttrValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// 商品减库存请求
+type GoodsReduceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=GoodsId,proto3" json:"GoodsId,omitempty"` // 商品ID
+	Nums          int32                  `protobuf:"varint,2,opt,name=nums,proto3" json:"nums,omitempty"`       // 减少数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReduceRequest) Reset() {
+	*x = GoodsReduceRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReduceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReduceRequest) ProtoMessage() {}
+
+func (x *GoodsReduceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReduceRequest.ProtoReflect.Descriptor instead.
+func (*GoodsReduceRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GoodsReduceRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsReduceRequest) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+// 批量分类信息请求
+type BatchCategoryInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []int32                `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`        // 分类ID列表
+	GoodsNums     int32                  `protobuf:"varint,2,opt,name=goodsNums,proto3" json:"goodsNums,omitempty"` // 商品数量
+	BrandNums     int32                  `protobuf:"varint,3,opt,name=brandNums,proto3" json:"brandNums,omitempty"` // 品牌数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCategoryInfoRequest) Reset() {
+	*x = BatchCategoryInfoRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCategoryInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCategoryInfoRequest) ProtoMessage() {}
+
+func (x *BatchCategoryInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCategoryInfoRequest.ProtoReflect.Descriptor instead.
+func (*BatchCategoryInfoRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchCategoryInfoRequest) GetId() []int32 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BatchCategoryInfoRequest) GetGoodsNums() int32 {
+	if x != nil {
+		return x.GoodsNums
+	}
+	return 0
+}
+
+func (x *BatchCategoryInfoRequest) GetBrandNums() int32 {
+	if x != nil {
+		return x.BrandNums
+	}
+	return 0
+}
+
+// 商品过滤请求
+type GoodsFilterRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PriceMin       int32                  `protobuf:"varint,1,opt,name=priceMin,proto3" json:"priceMin,omitempty"`                                    // 最低价格
+	PriceMax       int32                  `protobuf:"varint,2,opt,name=priceMax,proto3" json:"priceMax,omitempty"`                                    // 最高价格
+	IsHot          bool                   `protobuf:"varint,3,opt,name=isHot,proto3" json:"isHot,omitempty"`                                          // 是否热销
+	IsNew          bool                   `protobuf:"varint,4,opt,name=isNew,proto3" json:"isNew,omitempty"`                                          // 是否新品
+	IsTab          bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`                                          // 只返回标签页分类（含子分类）下的商品
+	TopCategory    int32                  `protobuf:"varint,6,opt,name=topCategory,proto3" json:"topCategory,omitempty"`                              // 分类ID，包含其所有子分类
+	Pages          int32                  `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`                                          // 页码
+	PagePerNums    int32                  `protobuf:"varint,8,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`                              // 每页数量
+	KeyWords       string                 `protobuf:"bytes,9,opt,name=keyWords,proto3" json:"keyWords,omitempty"`                                     // 关键词
+	Brand          int32                  `protobuf:"varint,10,opt,name=brand,proto3" json:"brand,omitempty"`                                         // 品牌ID
+	PriceInterval  int32                  `protobuf:"varint,11,opt,name=priceInterval,proto3" json:"priceInterval,omitempty"`                         // 价格聚合区间宽度，默认 50
+	Sort           GoodsSort              `protobuf:"varint,12,opt,name=sort,proto3,enum=service.goods.api.goods.v1.GoodsSort" json:"sort,omitempty"` // 排序方式
+	PageToken      string                 `protobuf:"bytes,13,opt,name=pageToken,proto3" json:"pageToken,omitempty"`                                  // 游标分页令牌，取上一页返回的 nextPageToken，传入时忽略 pages
+	Attrs          []*GoodsAttrValue      `protobuf:"bytes,14,rep,name=attrs,proto3" json:"attrs,omitempty"`                                          // 属性过滤，同名属性的多个值之间为或，不同属性之间为且
+	IncludeOffSale bool                   `protobuf:"varint,15,opt,name=includeOffSale,proto3" json:"includeOffSale,omitempty"`                       // 包含未上架的商品（草稿、待审核、已下架、已归档等），仅 AdminGoodsList 生效，GoodsList 始终只返回在售商品
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoodsFilterRequest) Reset() {
+	*x = GoodsFilterRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsFilterRequest) ProtoMessage() {}
+
+func (x *GoodsFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsFilterRequest.ProtoReflect.Descriptor instead.
+func (*GoodsFilterRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GoodsFilterRequest) GetPriceMin() int32 {
+	if x != nil {
+		return x.PriceMin
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPriceMax() int32 {
+	if x != nil {
+		return x.PriceMax
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetTopCategory() int32 {
+	if x != nil {
+		return x.TopCategory
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *GoodsFilterRequest) GetBrand() int32 {
+	if x != nil {
+		return x.Brand
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPriceInterval() int32 {
+	if x != nil {
+		return x.PriceInterval
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetSort() GoodsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return GoodsSort_GOODS_SORT_DEFAULT
+}
+
+func (x *GoodsFilterRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GoodsFilterRequest) GetAttrs() []*GoodsAttrValue {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *GoodsFilterRequest) GetIncludeOffSale() bool {
+	if x != nil {
+		return x.IncludeOffSale
+	}
+	return false
+}
+
+// 商品信息响应
+type GoodsInfoResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Id              int32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                      // 商品ID
+	CategoryId      int32                      `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`                                      // 分类ID
+	Name            string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                   // 商品名称
+	GoodsSn         string                     `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`                                             // 商品编号
+	ClickNum        int32                      `protobuf:"varint,5,opt,name=clickNum,proto3" json:"clickNum,omitempty"`                                          // 点击数量
+	SoldNum         int32                      `protobuf:"varint,6,opt,name=soldNum,proto3" json:"soldNum,omitempty"`                                            // 销售数量
+	FavNum          int32                      `protobuf:"varint,7,opt,name=favNum,proto3" json:"favNum,omitempty"`                                              // 收藏数量
+	MarketPrice     float32                    `protobuf:"fixed32,9,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`                                   // 市场价格
+	ShopPrice       float32                    `protobuf:"fixed32,10,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`                                      // 店铺价格
+	GoodsBrief      string                     `protobuf:"bytes,11,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`                                      // 商品简介
+	GoodsDesc       string                     `protobuf:"bytes,12,opt,name=goodsDesc,proto3" json:"goodsDesc,omitempty"`                                        // 商品详情描述，仅商品详情和创建商品返回
+	ShipFree        bool                       `protobuf:"varint,13,opt,name=shipFree,proto3" json:"shipFree,omitempty"`                                         // 是否包邮
+	Images          []string                   `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`                                              // 商品图片列表
+	DescImages      []string                   `protobuf:"bytes,15,rep,name=descImages,proto3" json:"descImages,omitempty"`                                      // 商品描述图片列表
+	GoodsFrontImage string                     `protobuf:"bytes,16,opt,name=goodsFrontImage,proto3" json:"goodsFrontImage,omitempty"`                            // 商品主图
+	IsNew           bool                       `protobuf:"varint,17,opt,name=isNew,proto3" json:"isNew,omitempty"`                                               // 是否新品
+	IsHot           bool                       `protobuf:"varint,18,opt,name=isHot,proto3" json:"isHot,omitempty"`                                               // 是否热销
+	OnSale          bool                       `protobuf:"varint,19,opt,name=onSale,proto3" json:"onSale,omitempty"`                                             // 是否上架
+	AddTime         int64                      `protobuf:"varint,20,opt,name=addTime,proto3" json:"addTime,omitempty"`                                           // 添加时间
+	Category        *CategoryBriefInfoResponse `protobuf:"bytes,21,opt,name=category,proto3" json:"category,omitempty"`                                          // 分类信息
+	Brand           *BrandInfoResponse         `protobuf:"bytes,22,opt,name=brand,proto3" json:"brand,omitempty"`                                                // 品牌信息
+	Highlight       *GoodsHighlight            `protobuf:"bytes,23,opt,name=highlight,proto3" json:"highlight,omitempty"`                                        // 关键词高亮片段，仅关键词搜索时返回
+	Skus            []*GoodsSkuInfo            `protobuf:"bytes,24,rep,name=skus,proto3" json:"skus,omitempty"`                                                  // SKU 列表，仅商品详情和批量查询返回
+	Attrs           []*GoodsAttrValue          `protobuf:"bytes,25,rep,name=attrs,proto3" json:"attrs,omitempty"`                                                // 分类属性值
+	Status          GoodsStatus                `protobuf:"varint,26,opt,name=status,proto3,enum=service.goods.api.goods.v1.GoodsStatus" json:"status,omitempty"` // 发布状态
+	OnSaleTime      int64                      `protobuf:"varint,27,opt,name=onSaleTime,proto3" json:"onSaleTime,omitempty"`                                     // 计划上架时间，0 表示未计划
+	OffSaleTime     int64                      `protobuf:"varint,28,opt,name=offSaleTime,proto3" json:"offSaleTime,omitempty"`                                   // 计划下架时间，0 表示未计划
+	Stocks          int32                      `protobuf:"varint,29,opt,name=stocks,proto3" json:"stocks,omitempty"`                                             // 库存
+	DescFormat      string                     `protobuf:"bytes,30,opt,name=descFormat,proto3" json:"descFormat,omitempty"`                                      // 描述格式 html 或 markdown
+	DescVersion     int32                      `protobuf:"varint,31,opt,name=descVersion,proto3" json:"descVersion,omitempty"`                                   // 描述当前版本，0 表示没有描述
+	RatingAvg       float32                    `protobuf:"fixed32,32,opt,name=ratingAvg,proto3" json:"ratingAvg,omitempty"`                                      // 平均评分，只统计审核通过的评价
+	RatingCount     int32                      `protobuf:"varint,33,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`                                   // 审核通过的评价数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GoodsInfoResponse) Reset() {
+	*x = GoodsInfoResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsInfoResponse) ProtoMessage() {}
+
+func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsInfoResponse.ProtoReflect.Descriptor instead.
+func (*GoodsInfoResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GoodsInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetClickNum() int32 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetSoldNum() int32 {
+	if x != nil {
+		return x.SoldNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetFavNum() int32 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetMarketPrice() float32 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetShopPrice() float32 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetGoodsDesc() string {
+	if x != nil {
+		return x.GoodsDesc
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetDescImages() []string {
+	if x != nil {
+		return x.DescImages
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetGoodsFrontImage() string {
+	if x != nil {
+		return x.GoodsFrontImage
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetCategory() *CategoryBriefInfoResponse {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetBrand() *BrandInfoResponse {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetHighlight() *GoodsHighlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetSkus() []*GoodsSkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetAttrs() []*GoodsAttrValue {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetStatus() GoodsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GoodsStatus_GOODS_STATUS_UNSPECIFIED
+}
+
+func (x *GoodsInfoResponse) GetOnSaleTime() int64 {
+	if x != nil {
+		return x.OnSaleTime
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetOffSaleTime() int64 {
+	if x != nil {
+		return x.OffSaleTime
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetStocks() int32 {
+	if x != nil {
+		return x.Stocks
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetDescFormat() string {
+	if x != nil {
+		return x.DescFormat
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetDescVersion() int32 {
+	if x != nil {
+		return x.DescVersion
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetRatingAvg() float32 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+// SKU 规格属性，例如 重量: 500g
+type GoodsSkuSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // 规格名
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // 规格值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSkuSpec) Reset() {
+	*x = GoodsSkuSpec{}
+	mi := &file_goods_v1_message_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSkuSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSkuSpec) ProtoMessage() {}
+
+func (x *GoodsSkuSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSkuSpec.ProtoReflect.Descriptor instead.
+func (*GoodsSkuSpec) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GoodsSkuSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsSkuSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SKU 信息
+type GoodsSkuInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // SKU ID
+	GoodsId           int32                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`                     // 商品ID
+	SkuCode           string                 `protobuf:"bytes,3,opt,name=skuCode,proto3" json:"skuCode,omitempty"`                      // SKU 编码
+	Specs             []*GoodsSkuSpec        `protobuf:"bytes,4,rep,name=specs,proto3" json:"specs,omitempty"`                          // 规格属性
+	ShopPrice         float32                `protobuf:"fixed32,5,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`                // 售价
+	MarketPrice       float32                `protobuf:"fixed32,6,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`            // 市场价
+	Images            []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`                        // SKU 图片，为空时使用商品图片
+	Stocks            *int32                 `protobuf:"varint,8,opt,name=stocks,proto3,oneof" json:"stocks,omitempty"`                 // 库存数量，更新时不传表示不修改
+	OnSale            *bool                  `protobuf:"varint,9,opt,name=onSale,proto3,oneof" json:"onSale,omitempty"`                 // 是否在售，更新时不传表示不修改
+	GoodsName         string                 `protobuf:"bytes,10,opt,name=goodsName,proto3" json:"goodsName,omitempty"`                 // 商品名称，仅响应返回
+	Operator          string                 `protobuf:"bytes,11,opt,name=operator,proto3" json:"operator,omitempty"`                   // 操作人，SKU 改价或商品售价随之变化时必填
+	PriceChangeReason string                 `protobuf:"bytes,12,opt,name=priceChangeReason,proto3" json:"priceChangeReason,omitempty"` // 改价原因
+	ForcePriceChange  bool                   `protobuf:"varint,13,opt,name=forcePriceChange,proto3" json:"forcePriceChange,omitempty"`  // 价格变动幅度超过限制时强制修改
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GoodsSkuInfo) Reset() {
+	*x = GoodsSkuInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSkuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSkuInfo) ProtoMessage() {}
+
+func (x *GoodsSkuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSkuInfo.ProtoReflect.Descriptor instead.
+func (*GoodsSkuInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GoodsSkuInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsSkuInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsSkuInfo) GetSkuCode() string {
+	if x != nil {
+		return x.SkuCode
+	}
+	return ""
+}
+
+func (x *GoodsSkuInfo) GetSpecs() []*GoodsSkuSpec {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *GoodsSkuInfo) GetShopPrice() float32 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *GoodsSkuInfo) GetMarketPrice() float32 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *GoodsSkuInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *GoodsSkuInfo) GetStocks() int32 {
+	if x != nil && x.Stocks != nil {
+		return *x.Stocks
+	}
+	return 0
+}
+
+func (x *GoodsSkuInfo) GetOnSale() bool {
+	if x != nil && x.OnSale != nil {
+		return *x.OnSale
+	}
+	return false
+}
+
+func (x *GoodsSkuInfo) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *GoodsSkuInfo) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *GoodsSkuInfo) GetPriceChangeReason() string {
+	if x != nil {
+		return x.PriceChangeReason
+	}
+	return ""
+}
+
+func (x *GoodsSkuInfo) GetForcePriceChange() bool {
+	if x != nil {
+		return x.ForcePriceChange
+	}
+	return false
+}
+
+// 批量 SKU ID 请求
+type BatchSkuIdInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []int32                `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"` // SKU ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSkuIdInfo) Reset() {
+	*x = BatchSkuIdInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSkuIdInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSkuIdInfo) ProtoMessage() {}
+
+func (x *BatchSkuIdInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSkuIdInfo.ProtoReflect.Descriptor instead.
+func (*BatchSkuIdInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchSkuIdInfo) GetId() []int32 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// SKU 列表响应
+type GoodsSkuListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*GoodsSkuInfo        `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // SKU 列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSkuListResponse) Reset() {
+	*x = GoodsSkuListResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSkuListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSkuListResponse) ProtoMessage() {}
+
+func (x *GoodsSkuListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSkuListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsSkuListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GoodsSkuListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsSkuListResponse) GetData() []*GoodsSkuInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 关键词高亮片段，命中的词以 <em></em> 包裹，其余内容已做 HTML 转义
+type GoodsHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          []string               `protobuf:"bytes,1,rep,name=name,proto3" json:"name,omitempty"`             // 商品名称
+	GoodsBrief    []string               `protobuf:"bytes,2,rep,name=goodsBrief,proto3" json:"goodsBrief,omitempty"` // 商品简介
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsHighlight) Reset() {
+	*x = GoodsHighlight{}
+	mi := &file_goods_v1_message_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsHighlight) ProtoMessage() {}
+
+func (x *GoodsHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsHighlight.ProtoReflect.Descriptor instead.
+func (*GoodsHighlight) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GoodsHighlight) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *GoodsHighlight) GetGoodsBrief() []string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return nil
+}
+
+// 聚合桶
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // 品牌或分类ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`    // 品牌或分类名称
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // 商品数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_goods_v1_message_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FacetBucket) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FacetBucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 价格区间聚合桶
+type PriceFacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          float32                `protobuf:"fixed32,1,opt,name=from,proto3" json:"from,omitempty"`  // 区间下限（含）
+	To            float32                `protobuf:"fixed32,2,opt,name=to,proto3" json:"to,omitempty"`      // 区间上限（不含）
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // 商品数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceFacetBucket) Reset() {
+	*x = PriceFacetBucket{}
+	mi := &file_goods_v1_message_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceFacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceFacetBucket) ProtoMessage() {}
+
+func (x *PriceFacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceFacetBucket.ProtoReflect.Descriptor instead.
+func (*PriceFacetBucket) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PriceFacetBucket) GetFrom() float32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PriceFacetBucket) GetTo() float32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *PriceFacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 商品搜索聚合结果
+type GoodsFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brands        []*FacetBucket         `protobuf:"bytes,1,rep,name=brands,proto3" json:"brands,omitempty"`         // 品牌聚合
+	Categories    []*FacetBucket         `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // 分类聚合
+	Prices        []*PriceFacetBucket    `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`         // 价格区间聚合
+	IsNew         int64                  `protobuf:"varint,4,opt,name=isNew,proto3" json:"isNew,omitempty"`          // 新品数量
+	IsHot         int64                  `protobuf:"varint,5,opt,name=isHot,proto3" json:"isHot,omitempty"`          // 热销数量
+	ShipFree      int64                  `protobuf:"varint,6,opt,name=shipFree,proto3" json:"shipFree,omitempty"`    // 包邮数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsFacets) Reset() {
+	*x = GoodsFacets{}
+	mi := &file_goods_v1_message_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsFacets) ProtoMessage() {}
+
+func (x *GoodsFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsFacets.ProtoReflect.Descriptor instead.
+func (*GoodsFacets) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GoodsFacets) GetBrands() []*FacetBucket {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *GoodsFacets) GetCategories() []*FacetBucket {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GoodsFacets) GetPrices() []*PriceFacetBucket {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GoodsFacets) GetIsNew() int64 {
+	if x != nil {
+		return x.IsNew
+	}
+	return 0
+}
+
+func (x *GoodsFacets) GetIsHot() int64 {
+	if x != nil {
+		return x.IsHot
+	}
+	return 0
+}
+
+func (x *GoodsFacets) GetShipFree() int64 {
+	if x != nil {
+		return x.ShipFree
+	}
+	return 0
+}
+
+// 商品列表响应
+type GoodsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                // 总数
+	Data          []*GoodsInfoResponse   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`                   // 商品数据列表
+	Facets        *GoodsFacets           `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`               // 搜索聚合结果，仅商品列表返回
+	Degraded      bool                   `protobuf:"varint,4,opt,name=degraded,proto3" json:"degraded,omitempty"`          // 搜索服务不可用时由数据库降级查询，无聚合结果
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` // 下一页游标，为空表示没有更多数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsListResponse) Reset() {
+	*x = GoodsListResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsListResponse) ProtoMessage() {}
+
+func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GoodsListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsListResponse) GetData() []*GoodsInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GoodsListResponse) GetFacets() *GoodsFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *GoodsListResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *GoodsListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 搜索联想请求
+type SuggestGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`        // 输入前缀
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // 商品联想数量，默认 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestGoodsRequest) Reset() {
+	*x = SuggestGoodsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGoodsRequest) ProtoMessage() {}
+
+func (x *SuggestGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGoodsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SuggestGoodsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SuggestGoodsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 商品名称联想
+type GoodsSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`    // 商品ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 商品名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSuggestion) Reset() {
+	*x = GoodsSuggestion{}
+	mi := &file_goods_v1_message_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSuggestion) ProtoMessage() {}
+
+func (x *GoodsSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSuggestion.ProtoReflect.Descriptor instead.
+func (*GoodsSuggestion) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GoodsSuggestion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 搜索联想响应
+type SuggestGoodsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Goods         []*GoodsSuggestion           `protobuf:"bytes,1,rep,name=goods,proto3" json:"goods,omitempty"`           // 商品名称补全
+	Categories    []*CategoryBriefInfoResponse `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // 匹配的分类
+	Brands        []*BrandInfoResponse         `protobuf:"bytes,3,rep,name=brands,proto3" json:"brands,omitempty"`         // 匹配的品牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestGoodsResponse) Reset() {
+	*x = SuggestGoodsResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGoodsResponse) ProtoMessage() {}
+
+func (x *SuggestGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGoodsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SuggestGoodsResponse) GetGoods() []*GoodsSuggestion {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *SuggestGoodsResponse) GetCategories() []*CategoryBriefInfoResponse {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SuggestGoodsResponse) GetBrands() []*BrandInfoResponse {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+// 重建索引请求
+type ReindexGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchSize     int32                  `protobuf:"varint,1,opt,name=batchSize,proto3" json:"batchSize,omitempty"` // 每批写入数量，默认 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexGoodsRequest) Reset() {
+	*x = ReindexGoodsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexGoodsRequest) ProtoMessage() {}
+
+func (x *ReindexGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ReindexGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReindexGoodsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// 索引重建进度响应
+type ReindexStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`                 // 状态 idle/running/succeeded/failed
+	Index         string                 `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`                 // 正在构建或已切换的新索引
+	PreviousIndex string                 `protobuf:"bytes,3,opt,name=previousIndex,proto3" json:"previousIndex,omitempty"` // 切换前的索引，可用于回滚
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                // 待写入商品总数
+	Indexed       int64                  `protobuf:"varint,5,opt,name=indexed,proto3" json:"indexed,omitempty"`            // 已写入商品数
+	StartTime     int64                  `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`        // 开始时间
+	FinishTime    int64                  `protobuf:"varint,7,opt,name=finishTime,proto3" json:"finishTime,omitempty"`      // 结束时间
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                 // 失败原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexStatusResponse) Reset() {
+	*x = ReindexStatusResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexStatusResponse) ProtoMessage() {}
+
+func (x *ReindexStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReindexStatusResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReindexStatusResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReindexStatusResponse) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *ReindexStatusResponse) GetPreviousIndex() string {
+	if x != nil {
+		return x.PreviousIndex
+	}
+	return ""
+}
+
+func (x *ReindexStatusResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReindexStatusResponse) GetIndexed() int64 {
+	if x != nil {
+		return x.Indexed
+	}
+	return 0
+}
+
+func (x *ReindexStatusResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ReindexStatusResponse) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+func (x *ReindexStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 商品索引别名响应
+type GoodsIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         string                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`                 // 别名当前指向的索引
+	PreviousIndex string                 `protobuf:"bytes,2,opt,name=previousIndex,proto3" json:"previousIndex,omitempty"` // 切换前的索引
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsIndexResponse) Reset() {
+	*x = GoodsIndexResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsIndexResponse) ProtoMessage() {}
+
+func (x *GoodsIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsIndexResponse.ProtoReflect.Descriptor instead.
+func (*GoodsIndexResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GoodsIndexResponse) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *GoodsIndexResponse) GetPreviousIndex() string {
+	if x != nil {
+		return x.PreviousIndex
+	}
+	return ""
+}
+
+// 同义词词典请求
+type GoodsSynonymsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Synonyms      []string               `protobuf:"bytes,1,rep,name=synonyms,proto3" json:"synonyms,omitempty"` // 同义词规则，如 "西红柿, 番茄" 或 "洋芋 => 土豆"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSynonymsRequest) Reset() {
+	*x = GoodsSynonymsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSynonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSynonymsRequest) ProtoMessage() {}
+
+func (x *GoodsSynonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSynonymsRequest.ProtoReflect.Descriptor instead.
+func (*GoodsSynonymsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GoodsSynonymsRequest) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+// 同义词词典响应
+type GoodsSynonymsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Synonyms      []string               `protobuf:"bytes,1,rep,name=synonyms,proto3" json:"synonyms,omitempty"` // 同义词规则
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSynonymsResponse) Reset() {
+	*x = GoodsSynonymsResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSynonymsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSynonymsResponse) ProtoMessage() {}
+
+func (x *GoodsSynonymsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSynonymsResponse.ProtoReflect.Descriptor instead.
+func (*GoodsSynonymsResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GoodsSynonymsResponse) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+// 搜索词统计请求
+type SearchKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"` // 统计最近多少小时，默认 24
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`   // 返回数量，默认 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchKeywordsRequest) Reset() {
+	*x = SearchKeywordsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchKeywordsRequest) ProtoMessage() {}
+
+func (x *SearchKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*SearchKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SearchKeywordsRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *SearchKeywordsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 搜索词及次数
+type SearchKeyword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 搜索词
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`    // 搜索次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchKeyword) Reset() {
+	*x = SearchKeyword{}
+	mi := &file_goods_v1_message_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchKeyword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchKeyword) ProtoMessage() {}
+
+func (x *SearchKeyword) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchKeyword.ProtoReflect.Descriptor instead.
+func (*SearchKeyword) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SearchKeyword) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchKeyword) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 搜索词统计响应
+type SearchKeywordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      []*SearchKeyword       `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"` // 按次数降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchKeywordsResponse) Reset() {
+	*x = SearchKeywordsResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGoodsInfo) String() string {
+func (x *SearchKeywordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGoodsInfo) ProtoMessage() {}
+func (*SearchKeywordsResponse) ProtoMessage() {}
 
-func (x *DeleteGoodsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[21]
+func (x *SearchKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,42 +4392,387 @@ func (x *DeleteGoodsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGoodsInfo.ProtoReflect.Descriptor instead.
-func (*DeleteGoodsInfo) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SearchKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*SearchKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteGoodsInfo) GetId() int32 {
+func (x *SearchKeywordsResponse) GetKeywords() []*SearchKeyword {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+// 热搜屏蔽词，包含屏蔽词的搜索词不会出现在热搜中
+type HotKeywordBlocklist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"` // 屏蔽词
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotKeywordBlocklist) Reset() {
+	*x = HotKeywordBlocklist{}
+	mi := &file_goods_v1_message_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotKeywordBlocklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotKeywordBlocklist) ProtoMessage() {}
+
+func (x *HotKeywordBlocklist) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotKeywordBlocklist.ProtoReflect.Descriptor instead.
+func (*HotKeywordBlocklist) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *HotKeywordBlocklist) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+// 商品状态流转请求
+type GoodsStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // 商品ID
+	Status        GoodsStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=service.goods.api.goods.v1.GoodsStatus" json:"status,omitempty"` // 目标状态
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                                          // 操作人
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                              // 原因，例如审核驳回原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsStatusRequest) Reset() {
+	*x = GoodsStatusRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsStatusRequest) ProtoMessage() {}
+
+func (x *GoodsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsStatusRequest.ProtoReflect.Descriptor instead.
+func (*GoodsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GoodsStatusRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// 分类简要信息响应
-type CategoryBriefInfoResponse struct {
+func (x *GoodsStatusRequest) GetStatus() GoodsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GoodsStatus_GOODS_STATUS_UNSPECIFIED
+}
+
+func (x *GoodsStatusRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *GoodsStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 商品上下架计划请求，时间为 Unix 秒，0 表示取消对应计划
+type GoodsScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`    // 分类ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 分类名称
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                   // 商品ID
+	OnSaleTime    int64                  `protobuf:"varint,2,opt,name=onSaleTime,proto3" json:"onSaleTime,omitempty"`   // 计划上架时间
+	OffSaleTime   int64                  `protobuf:"varint,3,opt,name=offSaleTime,proto3" json:"offSaleTime,omitempty"` // 计划下架时间
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`        // 操作人
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsScheduleRequest) Reset() {
+	*x = GoodsScheduleRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsScheduleRequest) ProtoMessage() {}
+
+func (x *GoodsScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GoodsScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GoodsScheduleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsScheduleRequest) GetOnSaleTime() int64 {
+	if x != nil {
+		return x.OnSaleTime
+	}
+	return 0
+}
+
+func (x *GoodsScheduleRequest) GetOffSaleTime() int64 {
+	if x != nil {
+		return x.OffSaleTime
+	}
+	return 0
+}
+
+func (x *GoodsScheduleRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// 商品发布状态响应
+type GoodsStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // 商品ID
+	Status        GoodsStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=service.goods.api.goods.v1.GoodsStatus" json:"status,omitempty"` // 当前状态
+	OnSaleTime    int64                  `protobuf:"varint,3,opt,name=onSaleTime,proto3" json:"onSaleTime,omitempty"`                                     // 计划上架时间，0 表示未计划
+	OffSaleTime   int64                  `protobuf:"varint,4,opt,name=offSaleTime,proto3" json:"offSaleTime,omitempty"`                                   // 计划下架时间，0 表示未计划
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsStatusResponse) Reset() {
+	*x = GoodsStatusResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsStatusResponse) ProtoMessage() {}
+
+func (x *GoodsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsStatusResponse.ProtoReflect.Descriptor instead.
+func (*GoodsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GoodsStatusResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsStatusResponse) GetStatus() GoodsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GoodsStatus_GOODS_STATUS_UNSPECIFIED
+}
+
+func (x *GoodsStatusResponse) GetOnSaleTime() int64 {
+	if x != nil {
+		return x.OnSaleTime
+	}
+	return 0
+}
+
+func (x *GoodsStatusResponse) GetOffSaleTime() int64 {
+	if x != nil {
+		return x.OffSaleTime
+	}
+	return 0
+}
+
+// 商品状态流转记录
+type GoodsStatusLogInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                             // 记录ID
+	GoodsId       int32                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`                                                   // 商品ID
+	FromStatus    GoodsStatus            `protobuf:"varint,3,opt,name=fromStatus,proto3,enum=service.goods.api.goods.v1.GoodsStatus" json:"fromStatus,omitempty"` // 原状态
+	ToStatus      GoodsStatus            `protobuf:"varint,4,opt,name=toStatus,proto3,enum=service.goods.api.goods.v1.GoodsStatus" json:"toStatus,omitempty"`     // 新状态
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`                                                  // 操作人，定时任务为 scheduler
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                                      // 原因
+	AddTime       int64                  `protobuf:"varint,7,opt,name=addTime,proto3" json:"addTime,omitempty"`                                                   // 流转时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsStatusLogInfo) Reset() {
+	*x = GoodsStatusLogInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsStatusLogInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsStatusLogInfo) ProtoMessage() {}
+
+func (x *GoodsStatusLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsStatusLogInfo.ProtoReflect.Descriptor instead.
+func (*GoodsStatusLogInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GoodsStatusLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsStatusLogInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsStatusLogInfo) GetFromStatus() GoodsStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return GoodsStatus_GOODS_STATUS_UNSPECIFIED
+}
+
+func (x *GoodsStatusLogInfo) GetToStatus() GoodsStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return GoodsStatus_GOODS_STATUS_UNSPECIFIED
+}
+
+func (x *GoodsStatusLogInfo) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *GoodsStatusLogInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GoodsStatusLogInfo) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+// 商品状态流转记录列表响应
+type GoodsStatusLogListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*GoodsStatusLogInfo  `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 流转记录，按时间倒序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryBriefInfoResponse) Reset() {
-	*x = CategoryBriefInfoResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[22]
+func (x *GoodsStatusLogListResponse) Reset() {
+	*x = GoodsStatusLogListResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryBriefInfoResponse) String() string {
+func (x *GoodsStatusLogListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryBriefInfoResponse) ProtoMessage() {}
+func (*GoodsStatusLogListResponse) ProtoMessage() {}
 
-func (x *CategoryBriefInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[22]
+func (x *GoodsStatusLogListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,49 +4783,50 @@ func (x *CategoryBriefInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryBriefInfoResponse.ProtoReflect.Descriptor instead.
-func (*CategoryBriefInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GoodsStatusLogListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsStatusLogListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CategoryBriefInfoResponse) GetId() int32 {
+func (x *GoodsStatusLogListResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CategoryBriefInfoResponse) GetName() string {
+func (x *GoodsStatusLogListResponse) GetData() []*GoodsStatusLogInfo {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// 分类过滤请求
-type CategoryFilterRequest struct {
+// 商品描述版本列表请求
+type GoodsDescVersionListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // 分类ID
-	IsTab         bool                   `protobuf:"varint,2,opt,name=isTab,proto3" json:"isTab,omitempty"` // 是否为标签页
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                   // 商品ID
+	Pages         int32                  `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`             // 页码
+	PagePerNums   int32                  `protobuf:"varint,3,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"` // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryFilterRequest) Reset() {
-	*x = CategoryFilterRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[23]
+func (x *GoodsDescVersionListRequest) Reset() {
+	*x = GoodsDescVersionListRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryFilterRequest) String() string {
+func (x *GoodsDescVersionListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryFilterRequest) ProtoMessage() {}
+func (*GoodsDescVersionListRequest) ProtoMessage() {}
 
-func (x *CategoryFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[23]
+func (x *GoodsDescVersionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,48 +4837,58 @@ func (x *CategoryFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryFilterRequest.ProtoReflect.Descriptor instead.
-func (*CategoryFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GoodsDescVersionListRequest.ProtoReflect.Descriptor instead.
+func (*GoodsDescVersionListRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CategoryFilterRequest) GetId() int32 {
+func (x *GoodsDescVersionListRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CategoryFilterRequest) GetIsTab() bool {
+func (x *GoodsDescVersionListRequest) GetPages() int32 {
 	if x != nil {
-		return x.IsTab
+		return x.Pages
 	}
-	return false
+	return 0
 }
 
-// 商品信息请求
-type GoodInfoRequest struct {
+func (x *GoodsDescVersionListRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+// 商品描述版本请求
+type GoodsDescVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 商品ID
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // 商品ID
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`  // 版本号
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人，回滚时必填
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`     // 回滚原因
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodInfoRequest) Reset() {
-	*x = GoodInfoRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[24]
+func (x *GoodsDescVersionRequest) Reset() {
+	*x = GoodsDescVersionRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodInfoRequest) String() string {
+func (x *GoodsDescVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoodInfoRequest) ProtoMessage() {}
+func (*GoodsDescVersionRequest) ProtoMessage() {}
 
-func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[24]
+func (x *GoodsDescVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,57 +4899,70 @@ func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoodInfoRequest.ProtoReflect.Descriptor instead.
-func (*GoodInfoRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GoodsDescVersionRequest.ProtoReflect.Descriptor instead.
+func (*GoodsDescVersionRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GoodInfoRequest) GetId() int32 {
+func (x *GoodsDescVersionRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// 创建商品信息
-type CreateGoodsInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                           // 商品ID
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                        // 商品名称
-	GoodsSn         string                 `protobuf:"bytes,3,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`                  // 商品编号
-	Stocks          int32                  `protobuf:"varint,7,opt,name=stocks,proto3" json:"stocks,omitempty"`                   // 库存数量
-	MarketPrice     float32                `protobuf:"fixed32,8,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`        // 市场价格
-	ShopPrice       float32                `protobuf:"fixed32,9,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`            // 店铺价格
-	GoodsBrief      string                 `protobuf:"bytes,10,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`           // 商品简介
-	GoodsDesc       string                 `protobuf:"bytes,11,opt,name=goodsDesc,proto3" json:"goodsDesc,omitempty"`             // 商品描述
-	ShipFree        bool                   `protobuf:"varint,12,opt,name=shipFree,proto3" json:"shipFree,omitempty"`              // 是否包邮
-	Images          []string               `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`                   // 商品图片列表
-	DescImages      []string               `protobuf:"bytes,14,rep,name=descImages,proto3" json:"descImages,omitempty"`           // 商品描述图片列表
-	GoodsFrontImage string                 `protobuf:"bytes,15,opt,name=goodsFrontImage,proto3" json:"goodsFrontImage,omitempty"` // 商品主图
-	IsNew           bool                   `protobuf:"varint,16,opt,name=isNew,proto3" json:"isNew,omitempty"`                    // 是否新品
-	IsHot           bool                   `protobuf:"varint,17,opt,name=isHot,proto3" json:"isHot,omitempty"`                    // 是否热销
-	OnSale          bool                   `protobuf:"varint,18,opt,name=onSale,proto3" json:"onSale,omitempty"`                  // 是否上架
-	CategoryId      int32                  `protobuf:"varint,19,opt,name=categoryId,proto3" json:"categoryId,omitempty"`          // 分类ID
-	BrandId         int32                  `protobuf:"varint,20,opt,name=brandId,proto3" json:"brandId,omitempty"`                // 品牌ID
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GoodsDescVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *CreateGoodsInfo) Reset() {
-	*x = CreateGoodsInfo{}
-	mi := &file_goods_v1_message_proto_msgTypes[25]
+func (x *GoodsDescVersionRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *GoodsDescVersionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 商品描述版本
+type GoodsDescVersionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`  // 商品ID
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`  // 版本号，从 1 开始递增
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`     // 描述格式 html 或 markdown
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`   // 描述内容，版本列表中不返回
+	Size          int32                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`        // 描述内容字节数
+	Operator      string                 `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"` // 操作人
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`     // 修改原因，回滚生成的版本记录回滚来源
+	AddTime       int64                  `protobuf:"varint,8,opt,name=addTime,proto3" json:"addTime,omitempty"`  // 创建时间
+	Current       bool                   `protobuf:"varint,9,opt,name=current,proto3" json:"current,omitempty"`  // 是否为当前版本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsDescVersionInfo) Reset() {
+	*x = GoodsDescVersionInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGoodsInfo) String() string {
+func (x *GoodsDescVersionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGoodsInfo) ProtoMessage() {}
+func (*GoodsDescVersionInfo) ProtoMessage() {}
 
-func (x *CreateGoodsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[25]
+func (x *GoodsDescVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,154 +4973,154 @@ func (x *CreateGoodsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGoodsInfo.ProtoReflect.Descriptor instead.
-func (*CreateGoodsInfo) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GoodsDescVersionInfo.ProtoReflect.Descriptor instead.
+func (*GoodsDescVersionInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CreateGoodsInfo) GetId() int32 {
+func (x *GoodsDescVersionInfo) GetGoodsId() int32 {
 	if x != nil {
-		return x.Id
+		return x.GoodsId
 	}
 	return 0
 }
 
-func (x *CreateGoodsInfo) GetName() string {
+func (x *GoodsDescVersionInfo) GetVersion() int32 {
 	if x != nil {
-		return x.Name
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGoodsInfo) GetGoodsSn() string {
+func (x *GoodsDescVersionInfo) GetFormat() string {
 	if x != nil {
-		return x.GoodsSn
+		return x.Format
 	}
 	return ""
 }
 
-func (x *CreateGoodsInfo) GetStocks() int32 {
+func (x *GoodsDescVersionInfo) GetContent() string {
 	if x != nil {
-		return x.Stocks
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGoodsInfo) GetMarketPrice() float32 {
+func (x *GoodsDescVersionInfo) GetSize() int32 {
 	if x != nil {
-		return x.MarketPrice
+		return x.Size
 	}
 	return 0
 }
 
-func (x *CreateGoodsInfo) GetShopPrice() float32 {
+func (x *GoodsDescVersionInfo) GetOperator() string {
 	if x != nil {
-		return x.ShopPrice
+		return x.Operator
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGoodsInfo) GetGoodsBrief() string {
+func (x *GoodsDescVersionInfo) GetReason() string {
 	if x != nil {
-		return x.GoodsBrief
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateGoodsInfo) GetGoodsDesc() string {
+func (x *GoodsDescVersionInfo) GetAddTime() int64 {
 	if x != nil {
-		return x.GoodsDesc
+		return x.AddTime
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGoodsInfo) GetShipFree() bool {
+func (x *GoodsDescVersionInfo) GetCurrent() bool {
 	if x != nil {
-		return x.ShipFree
+		return x.Current
 	}
 	return false
 }
 
-func (x *CreateGoodsInfo) GetImages() []string {
-	if x != nil {
-		return x.Images
-	}
-	return nil
+// 商品描述版本列表响应
+type GoodsDescVersionListResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*GoodsDescVersionInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 版本列表，按版本倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGoodsInfo) GetDescImages() []string {
-	if x != nil {
-		return x.DescImages
-	}
-	return nil
+func (x *GoodsDescVersionListResponse) Reset() {
+	*x = GoodsDescVersionListResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGoodsInfo) GetGoodsFrontImage() string {
-	if x != nil {
-		return x.GoodsFrontImage
-	}
-	return ""
+func (x *GoodsDescVersionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateGoodsInfo) GetIsNew() bool {
-	if x != nil {
-		return x.IsNew
-	}
-	return false
-}
+func (*GoodsDescVersionListResponse) ProtoMessage() {}
 
-func (x *CreateGoodsInfo) GetIsHot() bool {
+func (x *GoodsDescVersionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[73]
 	if x != nil {
-		return x.IsHot
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGoodsInfo) GetOnSale() bool {
-	if x != nil {
-		return x.OnSale
-	}
-	return false
+// Deprecated: Use GoodsDescVersionListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsDescVersionListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CreateGoodsInfo) GetCategoryId() int32 {
+func (x *GoodsDescVersionListResponse) GetTotal() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CreateGoodsInfo) GetBrandId() int32 {
+func (x *GoodsDescVersionListResponse) GetData() []*GoodsDescVersionInfo {
 	if x != nil {
-		return x.BrandId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// 商品减库存请求
-type GoodsReduceRequest struct {
+// 商品价格变动记录查询请求
+type GoodsPriceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GoodsId       int32                  `protobuf:"varint,1,opt,name=GoodsId,proto3" json:"GoodsId,omitempty"` // 商品ID
-	Nums          int32                  `protobuf:"varint,2,opt,name=nums,proto3" json:"nums,omitempty"`       // 减少数量
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                   // 商品ID
+	StartTime     int64                  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 开始时间，Unix 秒，0 表示不限
+	EndTime       int64                  `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间，Unix 秒，0 表示不限
+	Pages         int32                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`             // 页码
+	PagePerNums   int32                  `protobuf:"varint,5,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"` // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodsReduceRequest) Reset() {
-	*x = GoodsReduceRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[26]
+func (x *GoodsPriceHistoryRequest) Reset() {
+	*x = GoodsPriceHistoryRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodsReduceRequest) String() string {
+func (x *GoodsPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoodsReduceRequest) ProtoMessage() {}
+func (*GoodsPriceHistoryRequest) ProtoMessage() {}
 
-func (x *GoodsReduceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[26]
+func (x *GoodsPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,50 +5131,77 @@ func (x *GoodsReduceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoodsReduceRequest.ProtoReflect.Descriptor instead.
-func (*GoodsReduceRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GoodsPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GoodsPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GoodsReduceRequest) GetGoodsId() int32 {
+func (x *GoodsPriceHistoryRequest) GetId() int32 {
 	if x != nil {
-		return x.GoodsId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GoodsReduceRequest) GetNums() int32 {
+func (x *GoodsPriceHistoryRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Nums
+		return x.StartTime
 	}
 	return 0
 }
 
-// 批量分类信息请求
-type BatchCategoryInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            []int32                `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`        // 分类ID列表
-	GoodsNums     int32                  `protobuf:"varint,2,opt,name=goodsNums,proto3" json:"goodsNums,omitempty"` // 商品数量
-	BrandNums     int32                  `protobuf:"varint,3,opt,name=brandNums,proto3" json:"brandNums,omitempty"` // 品牌数量
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GoodsPriceHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
 }
 
-func (x *BatchCategoryInfoRequest) Reset() {
-	*x = BatchCategoryInfoRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[27]
+func (x *GoodsPriceHistoryRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsPriceHistoryRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+// 商品价格变动记录
+type GoodsPriceLogInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                          // 记录ID
+	GoodsId        int32                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`                // 商品ID
+	OldShopPrice   float32                `protobuf:"fixed32,3,opt,name=oldShopPrice,proto3" json:"oldShopPrice,omitempty"`     // 修改前售价
+	NewShopPrice   float32                `protobuf:"fixed32,4,opt,name=newShopPrice,proto3" json:"newShopPrice,omitempty"`     // 修改后售价
+	OldMarketPrice float32                `protobuf:"fixed32,5,opt,name=oldMarketPrice,proto3" json:"oldMarketPrice,omitempty"` // 修改前市场价
+	NewMarketPrice float32                `protobuf:"fixed32,6,opt,name=newMarketPrice,proto3" json:"newMarketPrice,omitempty"` // 修改后市场价
+	Operator       string                 `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`               // 操作人，SKU 价格汇总时为 sku
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`                   // 改价原因
+	AddTime        int64                  `protobuf:"varint,9,opt,name=addTime,proto3" json:"addTime,omitempty"`                // 修改时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoodsPriceLogInfo) Reset() {
+	*x = GoodsPriceLogInfo{}
+	mi := &file_goods_v1_message_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchCategoryInfoRequest) String() string {
+func (x *GoodsPriceLogInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCategoryInfoRequest) ProtoMessage() {}
+func (*GoodsPriceLogInfo) ProtoMessage() {}
 
-func (x *BatchCategoryInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[27]
+func (x *GoodsPriceLogInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,64 +5212,98 @@ func (x *BatchCategoryInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCategoryInfoRequest.ProtoReflect.Descriptor instead.
-func (*BatchCategoryInfoRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GoodsPriceLogInfo.ProtoReflect.Descriptor instead.
+func (*GoodsPriceLogInfo) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GoodsPriceLogInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsPriceLogInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsPriceLogInfo) GetOldShopPrice() float32 {
+	if x != nil {
+		return x.OldShopPrice
+	}
+	return 0
+}
+
+func (x *GoodsPriceLogInfo) GetNewShopPrice() float32 {
+	if x != nil {
+		return x.NewShopPrice
+	}
+	return 0
+}
+
+func (x *GoodsPriceLogInfo) GetOldMarketPrice() float32 {
+	if x != nil {
+		return x.OldMarketPrice
+	}
+	return 0
+}
+
+func (x *GoodsPriceLogInfo) GetNewMarketPrice() float32 {
+	if x != nil {
+		return x.NewMarketPrice
+	}
+	return 0
 }
 
-func (x *BatchCategoryInfoRequest) GetId() []int32 {
+func (x *GoodsPriceLogInfo) GetOperator() string {
 	if x != nil {
-		return x.Id
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchCategoryInfoRequest) GetGoodsNums() int32 {
+func (x *GoodsPriceLogInfo) GetReason() string {
 	if x != nil {
-		return x.GoodsNums
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchCategoryInfoRequest) GetBrandNums() int32 {
+func (x *GoodsPriceLogInfo) GetAddTime() int64 {
 	if x != nil {
-		return x.BrandNums
+		return x.AddTime
 	}
 	return 0
 }
 
-// 商品过滤请求
-type GoodsFilterRequest struct {
+// 商品价格变动记录响应，创建后未改价的商品没有记录
+type GoodsPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PriceMin      int32                  `protobuf:"varint,1,opt,name=priceMin,proto3" json:"priceMin,omitempty"`       // 最低价格
-	PriceMax      int32                  `protobuf:"varint,2,opt,name=priceMax,proto3" json:"priceMax,omitempty"`       // 最高价格
-	IsHot         bool                   `protobuf:"varint,3,opt,name=isHot,proto3" json:"isHot,omitempty"`             // 是否热销
-	IsNew         bool                   `protobuf:"varint,4,opt,name=isNew,proto3" json:"isNew,omitempty"`             // 是否新品
-	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`             // 是否为标签页
-	TopCategory   int32                  `protobuf:"varint,6,opt,name=topCategory,proto3" json:"topCategory,omitempty"` // 顶级分类
-	Pages         int32                  `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`             // 页码
-	PagePerNums   int32                  `protobuf:"varint,8,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"` // 每页数量
-	KeyWords      string                 `protobuf:"bytes,9,opt,name=keyWords,proto3" json:"keyWords,omitempty"`        // 关键词
-	Brand         int32                  `protobuf:"varint,10,opt,name=brand,proto3" json:"brand,omitempty"`            // 品牌ID
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*GoodsPriceLogInfo   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 变动记录，按时间倒序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodsFilterRequest) Reset() {
-	*x = GoodsFilterRequest{}
-	mi := &file_goods_v1_message_proto_msgTypes[28]
+func (x *GoodsPriceHistoryResponse) Reset() {
+	*x = GoodsPriceHistoryResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodsFilterRequest) String() string {
+func (x *GoodsPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoodsFilterRequest) ProtoMessage() {}
+func (*GoodsPriceHistoryResponse) ProtoMessage() {}
 
-func (x *GoodsFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[28]
+func (x *GoodsPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,124 +5314,148 @@ func (x *GoodsFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoodsFilterRequest.ProtoReflect.Descriptor instead.
-func (*GoodsFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GoodsPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GoodsPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GoodsFilterRequest) GetPriceMin() int32 {
+func (x *GoodsPriceHistoryResponse) GetTotal() int32 {
 	if x != nil {
-		return x.PriceMin
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GoodsFilterRequest) GetPriceMax() int32 {
+func (x *GoodsPriceHistoryResponse) GetData() []*GoodsPriceLogInfo {
 	if x != nil {
-		return x.PriceMax
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *GoodsFilterRequest) GetIsHot() bool {
+// 商品导入请求
+// 文件首行为表头，支持 name/商品名称、goodsSn/商品编号、category/分类、brand/品牌 等列，
+// 分类属性列以 attr: 或 属性: 为前缀，图片列多个地址以 | 分隔
+type ImportGoodsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	File             []byte                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                          // 文件内容，流式导入时按顺序分片传入
+	Format           string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                      // 文件格式 csv 或 xlsx，为空时按 fileName 扩展名判断
+	FileName         string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`                  // 文件名
+	DryRun           bool                   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                     // 只校验不写入
+	Upsert           bool                   `protobuf:"varint,5,opt,name=upsert,proto3" json:"upsert,omitempty"`                     // 商品编号已存在时更新该商品，否则报错
+	Operator         string                 `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`                  // 操作人，记录到价格变动记录，默认 import
+	ForcePriceChange bool                   `protobuf:"varint,7,opt,name=forcePriceChange,proto3" json:"forcePriceChange,omitempty"` // 确认改价，价格变动幅度超过限制的行不再报错
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportGoodsRequest) Reset() {
+	*x = ImportGoodsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGoodsRequest) ProtoMessage() {}
+
+func (x *ImportGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[77]
 	if x != nil {
-		return x.IsHot
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GoodsFilterRequest) GetIsNew() bool {
+// Deprecated: Use ImportGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ImportGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ImportGoodsRequest) GetFile() []byte {
 	if x != nil {
-		return x.IsNew
+		return x.File
 	}
-	return false
+	return nil
 }
 
-func (x *GoodsFilterRequest) GetIsTab() bool {
+func (x *ImportGoodsRequest) GetFormat() string {
 	if x != nil {
-		return x.IsTab
+		return x.Format
 	}
-	return false
+	return ""
 }
 
-func (x *GoodsFilterRequest) GetTopCategory() int32 {
+func (x *ImportGoodsRequest) GetFileName() string {
 	if x != nil {
-		return x.TopCategory
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *GoodsFilterRequest) GetPages() int32 {
+func (x *ImportGoodsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Pages
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *GoodsFilterRequest) GetPagePerNums() int32 {
+func (x *ImportGoodsRequest) GetUpsert() bool {
 	if x != nil {
-		return x.PagePerNums
+		return x.Upsert
 	}
-	return 0
+	return false
 }
 
-func (x *GoodsFilterRequest) GetKeyWords() string {
+func (x *ImportGoodsRequest) GetOperator() string {
 	if x != nil {
-		return x.KeyWords
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *GoodsFilterRequest) GetBrand() int32 {
+func (x *ImportGoodsRequest) GetForcePriceChange() bool {
 	if x != nil {
-		return x.Brand
+		return x.ForcePriceChange
 	}
-	return 0
+	return false
 }
 
-// 商品信息响应
-type GoodsInfoResponse struct {
-	state           protoimpl.MessageState     `protogen:"open.v1"`
-	Id              int32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                           // 商品ID
-	CategoryId      int32                      `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`           // 分类ID
-	Name            string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                        // 商品名称
-	GoodsSn         string                     `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`                  // 商品编号
-	ClickNum        int32                      `protobuf:"varint,5,opt,name=clickNum,proto3" json:"clickNum,omitempty"`               // 点击数量
-	SoldNum         int32                      `protobuf:"varint,6,opt,name=soldNum,proto3" json:"soldNum,omitempty"`                 // 销售数量
-	FavNum          int32                      `protobuf:"varint,7,opt,name=favNum,proto3" json:"favNum,omitempty"`                   // 收藏数量
-	MarketPrice     float32                    `protobuf:"fixed32,9,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`        // 市场价格
-	ShopPrice       float32                    `protobuf:"fixed32,10,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`           // 店铺价格
-	GoodsBrief      string                     `protobuf:"bytes,11,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`           // 商品简介
-	GoodsDesc       string                     `protobuf:"bytes,12,opt,name=goodsDesc,proto3" json:"goodsDesc,omitempty"`             // 商品描述
-	ShipFree        bool                       `protobuf:"varint,13,opt,name=shipFree,proto3" json:"shipFree,omitempty"`              // 是否包邮
-	Images          []string                   `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`                   // 商品图片列表
-	DescImages      []string                   `protobuf:"bytes,15,rep,name=descImages,proto3" json:"descImages,omitempty"`           // 商品描述图片列表
-	GoodsFrontImage string                     `protobuf:"bytes,16,opt,name=goodsFrontImage,proto3" json:"goodsFrontImage,omitempty"` // 商品主图
-	IsNew           bool                       `protobuf:"varint,17,opt,name=isNew,proto3" json:"isNew,omitempty"`                    // 是否新品
-	IsHot           bool                       `protobuf:"varint,18,opt,name=isHot,proto3" json:"isHot,omitempty"`                    // 是否热销
-	OnSale          bool                       `protobuf:"varint,19,opt,name=onSale,proto3" json:"onSale,omitempty"`                  // 是否上架
-	AddTime         int64                      `protobuf:"varint,20,opt,name=addTime,proto3" json:"addTime,omitempty"`                // 添加时间
-	Category        *CategoryBriefInfoResponse `protobuf:"bytes,21,opt,name=category,proto3" json:"category,omitempty"`               // 分类信息
-	Brand           *BrandInfoResponse         `protobuf:"bytes,22,opt,name=brand,proto3" json:"brand,omitempty"`                     // 品牌信息
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 商品导入单行结果
+type ImportGoodsRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`         // 行号，表头为第 1 行
+	GoodsSn       string                 `protobuf:"bytes,2,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`  // 商品编号
+	GoodsId       int32                  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"` // 商品ID，创建或更新成功时返回
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`    // create、update，失败时为空
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`    // 错误原因码
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`      // 错误信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodsInfoResponse) Reset() {
-	*x = GoodsInfoResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[29]
+func (x *ImportGoodsRowResult) Reset() {
+	*x = ImportGoodsRowResult{}
+	mi := &file_goods_v1_message_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodsInfoResponse) String() string {
+func (x *ImportGoodsRowResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoodsInfoResponse) ProtoMessage() {}
+func (*ImportGoodsRowResult) ProtoMessage() {}
 
-func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[29]
+func (x *ImportGoodsRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,182 +5466,224 @@ func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoodsInfoResponse.ProtoReflect.Descriptor instead.
-func (*GoodsInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ImportGoodsRowResult.ProtoReflect.Descriptor instead.
+func (*ImportGoodsRowResult) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GoodsInfoResponse) GetId() int32 {
+func (x *ImportGoodsRowResult) GetRow() int32 {
 	if x != nil {
-		return x.Id
+		return x.Row
 	}
 	return 0
 }
 
-func (x *GoodsInfoResponse) GetCategoryId() int32 {
+func (x *ImportGoodsRowResult) GetGoodsSn() string {
 	if x != nil {
-		return x.CategoryId
+		return x.GoodsSn
 	}
-	return 0
+	return ""
 }
 
-func (x *GoodsInfoResponse) GetName() string {
+func (x *ImportGoodsRowResult) GetGoodsId() int32 {
 	if x != nil {
-		return x.Name
+		return x.GoodsId
 	}
-	return ""
+	return 0
 }
 
-func (x *GoodsInfoResponse) GetGoodsSn() string {
+func (x *ImportGoodsRowResult) GetAction() string {
 	if x != nil {
-		return x.GoodsSn
+		return x.Action
 	}
 	return ""
 }
 
-func (x *GoodsInfoResponse) GetClickNum() int32 {
+func (x *ImportGoodsRowResult) GetReason() string {
 	if x != nil {
-		return x.ClickNum
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *GoodsInfoResponse) GetSoldNum() int32 {
+func (x *ImportGoodsRowResult) GetError() string {
 	if x != nil {
-		return x.SoldNum
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *GoodsInfoResponse) GetFavNum() int32 {
+// 商品导入响应
+type ImportGoodsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`     // 数据行数
+	Created       int32                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // 创建数量，试运行时为将要创建的数量
+	Updated       int32                   `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"` // 更新数量，试运行时为将要更新的数量
+	Failed        int32                   `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`   // 失败数量
+	DryRun        bool                    `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`   // 是否为试运行
+	Rows          []*ImportGoodsRowResult `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`        // 每行结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGoodsResponse) Reset() {
+	*x = ImportGoodsResponse{}
+	mi := &file_goods_v1_message_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGoodsResponse) ProtoMessage() {}
+
+func (x *ImportGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[79]
 	if x != nil {
-		return x.FavNum
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GoodsInfoResponse) GetMarketPrice() float32 {
+// Deprecated: Use ImportGoodsResponse.ProtoReflect.Descriptor instead.
+func (*ImportGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ImportGoodsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.MarketPrice
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GoodsInfoResponse) GetShopPrice() float32 {
+func (x *ImportGoodsResponse) GetCreated() int32 {
 	if x != nil {
-		return x.ShopPrice
+		return x.Created
 	}
 	return 0
 }
 
-func (x *GoodsInfoResponse) GetGoodsBrief() string {
+func (x *ImportGoodsResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.GoodsBrief
+		return x.Updated
 	}
-	return ""
+	return 0
 }
 
-func (x *GoodsInfoResponse) GetGoodsDesc() string {
+func (x *ImportGoodsResponse) GetFailed() int32 {
 	if x != nil {
-		return x.GoodsDesc
+		return x.Failed
 	}
-	return ""
+	return 0
 }
 
-func (x *GoodsInfoResponse) GetShipFree() bool {
+func (x *ImportGoodsResponse) GetDryRun() bool {
 	if x != nil {
-		return x.ShipFree
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *GoodsInfoResponse) GetImages() []string {
+func (x *ImportGoodsResponse) GetRows() []*ImportGoodsRowResult {
 	if x != nil {
-		return x.Images
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *GoodsInfoResponse) GetDescImages() []string {
-	if x != nil {
-		return x.DescImages
-	}
-	return nil
+// 商品导出请求
+type ExportGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *GoodsFilterRequest    `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`          // 过滤条件，与商品列表相同，忽略分页、排序和 includeOffSale
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`          // 文件格式 csv、xlsx 或 ndjson，默认 csv
+	OnSaleOnly    bool                   `protobuf:"varint,3,opt,name=onSaleOnly,proto3" json:"onSaleOnly,omitempty"` // 只导出在售商品，默认导出全部商品（含草稿、已下架等）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodsInfoResponse) GetGoodsFrontImage() string {
-	if x != nil {
-		return x.GoodsFrontImage
-	}
-	return ""
+func (x *ExportGoodsRequest) Reset() {
+	*x = ExportGoodsRequest{}
+	mi := &file_goods_v1_message_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodsInfoResponse) GetIsNew() bool {
-	if x != nil {
-		return x.IsNew
-	}
-	return false
+func (x *ExportGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GoodsInfoResponse) GetIsHot() bool {
+func (*ExportGoodsRequest) ProtoMessage() {}
+
+func (x *ExportGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[80]
 	if x != nil {
-		return x.IsHot
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GoodsInfoResponse) GetOnSale() bool {
-	if x != nil {
-		return x.OnSale
-	}
-	return false
+// Deprecated: Use ExportGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ExportGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GoodsInfoResponse) GetAddTime() int64 {
+func (x *ExportGoodsRequest) GetFilter() *GoodsFilterRequest {
 	if x != nil {
-		return x.AddTime
+		return x.Filter
 	}
-	return 0
+	return nil
 }
 
-func (x *GoodsInfoResponse) GetCategory() *CategoryBriefInfoResponse {
+func (x *ExportGoodsRequest) GetFormat() string {
 	if x != nil {
-		return x.Category
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-func (x *GoodsInfoResponse) GetBrand() *BrandInfoResponse {
+func (x *ExportGoodsRequest) GetOnSaleOnly() bool {
 	if x != nil {
-		return x.Brand
+		return x.OnSaleOnly
 	}
-	return nil
+	return false
 }
 
-// 商品列表响应
-type GoodsListResponse struct {
+// 商品导出文件分片
+type ExportGoodsChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
-	Data          []*GoodsInfoResponse   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 商品数据列表
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`               // 文件内容，按顺序拼接为完整文件
+	FileName      string                 `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`       // 文件名，仅首个分片返回
+	ContentType   string                 `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"` // 文件类型，仅首个分片返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoodsListResponse) Reset() {
-	*x = GoodsListResponse{}
-	mi := &file_goods_v1_message_proto_msgTypes[30]
+func (x *ExportGoodsChunk) Reset() {
+	*x = ExportGoodsChunk{}
+	mi := &file_goods_v1_message_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoodsListResponse) String() string {
+func (x *ExportGoodsChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoodsListResponse) ProtoMessage() {}
+func (*ExportGoodsChunk) ProtoMessage() {}
 
-func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_v1_message_proto_msgTypes[30]
+func (x *ExportGoodsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_v1_message_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,23 +5694,30 @@ func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoodsListResponse.ProtoReflect.Descriptor instead.
-func (*GoodsListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_v1_message_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ExportGoodsChunk.ProtoReflect.Descriptor instead.
+func (*ExportGoodsChunk) Descriptor() ([]byte, []int) {
+	return file_goods_v1_message_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GoodsListResponse) GetTotal() int32 {
+func (x *ExportGoodsChunk) GetData() []byte {
 	if x != nil {
-		return x.Total
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *GoodsListResponse) GetData() []*GoodsInfoResponse {
+func (x *ExportGoodsChunk) GetFileName() string {
 	if x != nil {
-		return x.Data
+		return x.FileName
 	}
-	return nil
+	return ""
+}
+
+func (x *ExportGoodsChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
 var File_goods_v1_message_proto protoreflect.FileDescriptor
@@ -2121,7 +5753,21 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\x17SubCategoryListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12D\n" +
 	"\x04info\x18\x02 \x01(\v20.service.goods.api.goods.v1.CategoryInfoResponseR\x04info\x12T\n" +
-	"\fsubCategorys\x18\x03 \x03(\v20.service.goods.api.goods.v1.CategoryInfoResponseR\fsubCategorys\"T\n" +
+	"\fsubCategorys\x18\x03 \x03(\v20.service.goods.api.goods.v1.CategoryInfoResponseR\fsubCategorys\"\x80\x02\n" +
+	"\x15CategoryAttributeInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12E\n" +
+	"\x04type\x18\x04 \x01(\x0e21.service.goods.api.goods.v1.CategoryAttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"|\n" +
+	"\x1dCategoryAttributeListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12E\n" +
+	"\x04data\x18\x02 \x03(\v21.service.goods.api.goods.v1.CategoryAttributeInfoR\x04data\"T\n" +
 	"\x1aCategoryBrandFilterRequest\x12\x14\n" +
 	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
 	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\"G\n" +
@@ -2169,7 +5815,40 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12E\n" +
 	"\x04data\x18\x02 \x03(\v21.service.goods.api.goods.v1.CategoryBrandResponseR\x04data\"\"\n" +
 	"\x10BatchGoodsIdInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x03(\x05R\x02id\"!\n" +
+	"\x02id\x18\x01 \x03(\x05R\x02id\"*\n" +
+	"\x0eGoodsSnRequest\x12\x18\n" +
+	"\agoodsSn\x18\x01 \x01(\tR\agoodsSn\"/\n" +
+	"\x13BatchGoodsSnRequest\x12\x18\n" +
+	"\agoodsSn\x18\x01 \x03(\tR\agoodsSn\"9\n" +
+	"\rGoodsSnIdInfo\x12\x18\n" +
+	"\agoodsSn\x18\x01 \x01(\tR\agoodsSn\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"z\n" +
+	"\x10GoodsCounterInfo\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x1a\n" +
+	"\bclickNum\x18\x02 \x01(\x05R\bclickNum\x12\x16\n" +
+	"\x06favNum\x18\x03 \x01(\x05R\x06favNum\x12\x18\n" +
+	"\asoldNum\x18\x04 \x01(\x05R\asoldNum\"|\n" +
+	"\x18IncrGoodsCountersRequest\x12B\n" +
+	"\x05items\x18\x01 \x03(\v2,.service.goods.api.goods.v1.GoodsCounterInfoR\x05items\x12\x1c\n" +
+	"\trequestId\x18\x02 \x01(\tR\trequestId\"Y\n" +
+	"\x15GoodsCountersResponse\x12@\n" +
+	"\x04data\x18\x01 \x03(\v2,.service.goods.api.goods.v1.GoodsCounterInfoR\x04data\"I\n" +
+	"\x15GoodsRecommendRequest\x12\x1a\n" +
+	"\bgoodsIds\x18\x01 \x03(\x05R\bgoodsIds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x87\x01\n" +
+	"\x12RecommendGoodsInfo\x12C\n" +
+	"\x05goods\x18\x01 \x01(\v2-.service.goods.api.goods.v1.GoodsInfoResponseR\x05goods\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\"\\\n" +
+	"\x16GoodsRecommendResponse\x12B\n" +
+	"\x04data\x18\x01 \x03(\v2..service.goods.api.goods.v1.RecommendGoodsInfoR\x04data\"k\n" +
+	"\x0fGoodsRatingInfo\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x1c\n" +
+	"\tratingAvg\x18\x02 \x01(\x02R\tratingAvg\x12 \n" +
+	"\vratingCount\x18\x03 \x01(\x05R\vratingCount\"q\n" +
+	"\x14BatchGoodsSnResponse\x12=\n" +
+	"\x04data\x18\x01 \x03(\v2).service.goods.api.goods.v1.GoodsSnIdInfoR\x04data\x12\x1a\n" +
+	"\bnotFound\x18\x02 \x03(\tR\bnotFound\"!\n" +
 	"\x0fDeleteGoodsInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
 	"\x19CategoryBriefInfoResponse\x12\x0e\n" +
@@ -2179,7 +5858,7 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05isTab\x18\x02 \x01(\bR\x05isTab\"!\n" +
 	"\x0fGoodInfoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe1\x03\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xb9\x05\n" +
 	"\x0fCreateGoodsInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -2204,14 +5883,24 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"categoryId\x18\x13 \x01(\x05R\n" +
 	"categoryId\x12\x18\n" +
-	"\abrandId\x18\x14 \x01(\x05R\abrandId\"B\n" +
+	"\abrandId\x18\x14 \x01(\x05R\abrandId\x12@\n" +
+	"\x05attrs\x18\x15 \x03(\v2*.service.goods.api.goods.v1.GoodsAttrValueR\x05attrs\x12\x1a\n" +
+	"\boperator\x18\x16 \x01(\tR\boperator\x12,\n" +
+	"\x11priceChangeReason\x18\x17 \x01(\tR\x11priceChangeReason\x12*\n" +
+	"\x10forcePriceChange\x18\x18 \x01(\bR\x10forcePriceChange\x12\x1e\n" +
+	"\n" +
+	"descFormat\x18\x19 \x01(\tR\n" +
+	"descFormat\":\n" +
+	"\x0eGoodsAttrValue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"B\n" +
 	"\x12GoodsReduceRequest\x12\x18\n" +
 	"\aGoodsId\x18\x01 \x01(\x05R\aGoodsId\x12\x12\n" +
 	"\x04nums\x18\x02 \x01(\x05R\x04nums\"f\n" +
 	"\x18BatchCategoryInfoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x03(\x05R\x02id\x12\x1c\n" +
 	"\tgoodsNums\x18\x02 \x01(\x05R\tgoodsNums\x12\x1c\n" +
-	"\tbrandNums\x18\x03 \x01(\x05R\tbrandNums\"\x9a\x02\n" +
+	"\tbrandNums\x18\x03 \x01(\x05R\tbrandNums\"\x83\x04\n" +
 	"\x12GoodsFilterRequest\x12\x1a\n" +
 	"\bpriceMin\x18\x01 \x01(\x05R\bpriceMin\x12\x1a\n" +
 	"\bpriceMax\x18\x02 \x01(\x05R\bpriceMax\x12\x14\n" +
@@ -2223,7 +5912,12 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\vpagePerNums\x18\b \x01(\x05R\vpagePerNums\x12\x1a\n" +
 	"\bkeyWords\x18\t \x01(\tR\bkeyWords\x12\x14\n" +
 	"\x05brand\x18\n" +
-	" \x01(\x05R\x05brand\"\xb1\x05\n" +
+	" \x01(\x05R\x05brand\x12$\n" +
+	"\rpriceInterval\x18\v \x01(\x05R\rpriceInterval\x129\n" +
+	"\x04sort\x18\f \x01(\x0e2%.service.goods.api.goods.v1.GoodsSortR\x04sort\x12\x1c\n" +
+	"\tpageToken\x18\r \x01(\tR\tpageToken\x12@\n" +
+	"\x05attrs\x18\x0e \x03(\v2*.service.goods.api.goods.v1.GoodsAttrValueR\x05attrs\x12&\n" +
+	"\x0eincludeOffSale\x18\x0f \x01(\bR\x0eincludeOffSale\"\x98\t\n" +
 	"\x11GoodsInfoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
 	"\n" +
@@ -2252,10 +5946,242 @@ const file_goods_v1_message_proto_rawDesc = "" +
 	"\x06onSale\x18\x13 \x01(\bR\x06onSale\x12\x18\n" +
 	"\aaddTime\x18\x14 \x01(\x03R\aaddTime\x12Q\n" +
 	"\bcategory\x18\x15 \x01(\v25.service.goods.api.goods.v1.CategoryBriefInfoResponseR\bcategory\x12C\n" +
-	"\x05brand\x18\x16 \x01(\v2-.service.goods.api.goods.v1.BrandInfoResponseR\x05brand\"l\n" +
+	"\x05brand\x18\x16 \x01(\v2-.service.goods.api.goods.v1.BrandInfoResponseR\x05brand\x12H\n" +
+	"\thighlight\x18\x17 \x01(\v2*.service.goods.api.goods.v1.GoodsHighlightR\thighlight\x12<\n" +
+	"\x04skus\x18\x18 \x03(\v2(.service.goods.api.goods.v1.GoodsSkuInfoR\x04skus\x12@\n" +
+	"\x05attrs\x18\x19 \x03(\v2*.service.goods.api.goods.v1.GoodsAttrValueR\x05attrs\x12?\n" +
+	"\x06status\x18\x1a \x01(\x0e2'.service.goods.api.goods.v1.GoodsStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"onSaleTime\x18\x1b \x01(\x03R\n" +
+	"onSaleTime\x12 \n" +
+	"\voffSaleTime\x18\x1c \x01(\x03R\voffSaleTime\x12\x16\n" +
+	"\x06stocks\x18\x1d \x01(\x05R\x06stocks\x12\x1e\n" +
+	"\n" +
+	"descFormat\x18\x1e \x01(\tR\n" +
+	"descFormat\x12 \n" +
+	"\vdescVersion\x18\x1f \x01(\x05R\vdescVersion\x12\x1c\n" +
+	"\tratingAvg\x18  \x01(\x02R\tratingAvg\x12 \n" +
+	"\vratingCount\x18! \x01(\x05R\vratingCount\"8\n" +
+	"\fGoodsSkuSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xce\x03\n" +
+	"\fGoodsSkuInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x05R\agoodsId\x12\x18\n" +
+	"\askuCode\x18\x03 \x01(\tR\askuCode\x12>\n" +
+	"\x05specs\x18\x04 \x03(\v2(.service.goods.api.goods.v1.GoodsSkuSpecR\x05specs\x12\x1c\n" +
+	"\tshopPrice\x18\x05 \x01(\x02R\tshopPrice\x12 \n" +
+	"\vmarketPrice\x18\x06 \x01(\x02R\vmarketPrice\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\x12\x1b\n" +
+	"\x06stocks\x18\b \x01(\x05H\x00R\x06stocks\x88\x01\x01\x12\x1b\n" +
+	"\x06onSale\x18\t \x01(\bH\x01R\x06onSale\x88\x01\x01\x12\x1c\n" +
+	"\tgoodsName\x18\n" +
+	" \x01(\tR\tgoodsName\x12\x1a\n" +
+	"\boperator\x18\v \x01(\tR\boperator\x12,\n" +
+	"\x11priceChangeReason\x18\f \x01(\tR\x11priceChangeReason\x12*\n" +
+	"\x10forcePriceChange\x18\r \x01(\bR\x10forcePriceChangeB\t\n" +
+	"\a_stocksB\t\n" +
+	"\a_onSale\" \n" +
+	"\x0eBatchSkuIdInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x03(\x05R\x02id\"j\n" +
+	"\x14GoodsSkuListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12<\n" +
+	"\x04data\x18\x02 \x03(\v2(.service.goods.api.goods.v1.GoodsSkuInfoR\x04data\"D\n" +
+	"\x0eGoodsHighlight\x12\x12\n" +
+	"\x04name\x18\x01 \x03(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\x02 \x03(\tR\n" +
+	"goodsBrief\"G\n" +
+	"\vFacetBucket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"L\n" +
+	"\x10PriceFacetBucket\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x02R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x02R\x02to\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xa5\x02\n" +
+	"\vGoodsFacets\x12?\n" +
+	"\x06brands\x18\x01 \x03(\v2'.service.goods.api.goods.v1.FacetBucketR\x06brands\x12G\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2'.service.goods.api.goods.v1.FacetBucketR\n" +
+	"categories\x12D\n" +
+	"\x06prices\x18\x03 \x03(\v2,.service.goods.api.goods.v1.PriceFacetBucketR\x06prices\x12\x14\n" +
+	"\x05isNew\x18\x04 \x01(\x03R\x05isNew\x12\x14\n" +
+	"\x05isHot\x18\x05 \x01(\x03R\x05isHot\x12\x1a\n" +
+	"\bshipFree\x18\x06 \x01(\x03R\bshipFree\"\xef\x01\n" +
 	"\x11GoodsListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12A\n" +
-	"\x04data\x18\x02 \x03(\v2-.service.goods.api.goods.v1.GoodsInfoResponseR\x04dataBC\n" +
+	"\x04data\x18\x02 \x03(\v2-.service.goods.api.goods.v1.GoodsInfoResponseR\x04data\x12?\n" +
+	"\x06facets\x18\x03 \x01(\v2'.service.goods.api.goods.v1.GoodsFacetsR\x06facets\x12\x1a\n" +
+	"\bdegraded\x18\x04 \x01(\bR\bdegraded\x12$\n" +
+	"\rnextPageToken\x18\x05 \x01(\tR\rnextPageToken\"7\n" +
+	"\x13SuggestGoodsRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"5\n" +
+	"\x0fGoodsSuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xf7\x01\n" +
+	"\x14SuggestGoodsResponse\x12A\n" +
+	"\x05goods\x18\x01 \x03(\v2+.service.goods.api.goods.v1.GoodsSuggestionR\x05goods\x12U\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v25.service.goods.api.goods.v1.CategoryBriefInfoResponseR\n" +
+	"categories\x12E\n" +
+	"\x06brands\x18\x03 \x03(\v2-.service.goods.api.goods.v1.BrandInfoResponseR\x06brands\"3\n" +
+	"\x13ReindexGoodsRequest\x12\x1c\n" +
+	"\tbatchSize\x18\x01 \x01(\x05R\tbatchSize\"\xed\x01\n" +
+	"\x15ReindexStatusResponse\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\tR\x05index\x12$\n" +
+	"\rpreviousIndex\x18\x03 \x01(\tR\rpreviousIndex\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x18\n" +
+	"\aindexed\x18\x05 \x01(\x03R\aindexed\x12\x1c\n" +
+	"\tstartTime\x18\x06 \x01(\x03R\tstartTime\x12\x1e\n" +
+	"\n" +
+	"finishTime\x18\a \x01(\x03R\n" +
+	"finishTime\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"P\n" +
+	"\x12GoodsIndexResponse\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\tR\x05index\x12$\n" +
+	"\rpreviousIndex\x18\x02 \x01(\tR\rpreviousIndex\"2\n" +
+	"\x14GoodsSynonymsRequest\x12\x1a\n" +
+	"\bsynonyms\x18\x01 \x03(\tR\bsynonyms\"3\n" +
+	"\x15GoodsSynonymsResponse\x12\x1a\n" +
+	"\bsynonyms\x18\x01 \x03(\tR\bsynonyms\"A\n" +
+	"\x15SearchKeywordsRequest\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"?\n" +
+	"\rSearchKeyword\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"_\n" +
+	"\x16SearchKeywordsResponse\x12E\n" +
+	"\bkeywords\x18\x01 \x03(\v2).service.goods.api.goods.v1.SearchKeywordR\bkeywords\"+\n" +
+	"\x13HotKeywordBlocklist\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\"\x99\x01\n" +
+	"\x12GoodsStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12?\n" +
+	"\x06status\x18\x02 \x01(\x0e2'.service.goods.api.goods.v1.GoodsStatusR\x06status\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x14GoodsScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"onSaleTime\x18\x02 \x01(\x03R\n" +
+	"onSaleTime\x12 \n" +
+	"\voffSaleTime\x18\x03 \x01(\x03R\voffSaleTime\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\"\xa8\x01\n" +
+	"\x13GoodsStatusResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12?\n" +
+	"\x06status\x18\x02 \x01(\x0e2'.service.goods.api.goods.v1.GoodsStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"onSaleTime\x18\x03 \x01(\x03R\n" +
+	"onSaleTime\x12 \n" +
+	"\voffSaleTime\x18\x04 \x01(\x03R\voffSaleTime\"\x9a\x02\n" +
+	"\x12GoodsStatusLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x05R\agoodsId\x12G\n" +
+	"\n" +
+	"fromStatus\x18\x03 \x01(\x0e2'.service.goods.api.goods.v1.GoodsStatusR\n" +
+	"fromStatus\x12C\n" +
+	"\btoStatus\x18\x04 \x01(\x0e2'.service.goods.api.goods.v1.GoodsStatusR\btoStatus\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x18\n" +
+	"\aaddTime\x18\a \x01(\x03R\aaddTime\"v\n" +
+	"\x1aGoodsStatusLogListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12B\n" +
+	"\x04data\x18\x02 \x03(\v2..service.goods.api.goods.v1.GoodsStatusLogInfoR\x04data\"e\n" +
+	"\x1bGoodsDescVersionListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05pages\x18\x02 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x03 \x01(\x05R\vpagePerNums\"w\n" +
+	"\x17GoodsDescVersionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xf8\x01\n" +
+	"\x14GoodsDescVersionInfo\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\x12\x1a\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x18\n" +
+	"\aaddTime\x18\b \x01(\x03R\aaddTime\x12\x18\n" +
+	"\acurrent\x18\t \x01(\bR\acurrent\"z\n" +
+	"\x1cGoodsDescVersionListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12D\n" +
+	"\x04data\x18\x02 \x03(\v20.service.goods.api.goods.v1.GoodsDescVersionInfoR\x04data\"\x9a\x01\n" +
+	"\x18GoodsPriceHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\x03R\aendTime\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x05 \x01(\x05R\vpagePerNums\"\xa3\x02\n" +
+	"\x11GoodsPriceLogInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x05R\agoodsId\x12\"\n" +
+	"\foldShopPrice\x18\x03 \x01(\x02R\foldShopPrice\x12\"\n" +
+	"\fnewShopPrice\x18\x04 \x01(\x02R\fnewShopPrice\x12&\n" +
+	"\x0eoldMarketPrice\x18\x05 \x01(\x02R\x0eoldMarketPrice\x12&\n" +
+	"\x0enewMarketPrice\x18\x06 \x01(\x02R\x0enewMarketPrice\x12\x1a\n" +
+	"\boperator\x18\a \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x18\n" +
+	"\aaddTime\x18\t \x01(\x03R\aaddTime\"t\n" +
+	"\x19GoodsPriceHistoryResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12A\n" +
+	"\x04data\x18\x02 \x03(\v2-.service.goods.api.goods.v1.GoodsPriceLogInfoR\x04data\"\xd4\x01\n" +
+	"\x12ImportGoodsRequest\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\fR\x04file\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12\x16\n" +
+	"\x06dryRun\x18\x04 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06upsert\x18\x05 \x01(\bR\x06upsert\x12\x1a\n" +
+	"\boperator\x18\x06 \x01(\tR\boperator\x12*\n" +
+	"\x10forcePriceChange\x18\a \x01(\bR\x10forcePriceChange\"\xa2\x01\n" +
+	"\x14ImportGoodsRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\agoodsSn\x18\x02 \x01(\tR\agoodsSn\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x05R\agoodsId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xd5\x01\n" +
+	"\x13ImportGoodsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x16\n" +
+	"\x06dryRun\x18\x05 \x01(\bR\x06dryRun\x12D\n" +
+	"\x04rows\x18\x06 \x03(\v20.service.goods.api.goods.v1.ImportGoodsRowResultR\x04rows\"\x94\x01\n" +
+	"\x12ExportGoodsRequest\x12F\n" +
+	"\x06filter\x18\x01 \x01(\v2..service.goods.api.goods.v1.GoodsFilterRequestR\x06filter\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1e\n" +
+	"\n" +
+	"onSaleOnly\x18\x03 \x01(\bR\n" +
+	"onSaleOnly\"d\n" +
+	"\x10ExportGoodsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfileName\x18\x02 \x01(\tR\bfileName\x12 \n" +
+	"\vcontentType\x18\x03 \x01(\tR\vcontentType*\x8d\x01\n" +
+	"\x15CategoryAttributeType\x12\x1b\n" +
+	"\x17CATEGORY_ATTRIBUTE_TEXT\x10\x00\x12\x1d\n" +
+	"\x19CATEGORY_ATTRIBUTE_NUMBER\x10\x01\x12\x1b\n" +
+	"\x17CATEGORY_ATTRIBUTE_ENUM\x10\x02\x12\x1b\n" +
+	"\x17CATEGORY_ATTRIBUTE_BOOL\x10\x03*\x9d\x01\n" +
+	"\tGoodsSort\x12\x16\n" +
+	"\x12GOODS_SORT_DEFAULT\x10\x00\x12\x18\n" +
+	"\x14GOODS_SORT_PRICE_ASC\x10\x01\x12\x19\n" +
+	"\x15GOODS_SORT_PRICE_DESC\x10\x02\x12\x14\n" +
+	"\x10GOODS_SORT_SALES\x10\x03\x12\x15\n" +
+	"\x11GOODS_SORT_NEWEST\x10\x04\x12\x16\n" +
+	"\x12GOODS_SORT_POPULAR\x10\x05*\xcf\x01\n" +
+	"\vGoodsStatus\x12\x1c\n" +
+	"\x18GOODS_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12GOODS_STATUS_DRAFT\x10\x01\x12\x1f\n" +
+	"\x1bGOODS_STATUS_PENDING_REVIEW\x10\x02\x12\x19\n" +
+	"\x15GOODS_STATUS_APPROVED\x10\x03\x12\x18\n" +
+	"\x14GOODS_STATUS_ON_SALE\x10\x04\x12\x19\n" +
+	"\x15GOODS_STATUS_OFF_SALE\x10\x05\x12\x19\n" +
+	"\x15GOODS_STATUS_ARCHIVED\x10\x06BC\n" +
 	"\x1aservice.goods.api.goods.v1P\x01Z#mshop/service/goods/api/goods/v1;v1b\x06proto3"
 
 var (
@@ -2270,57 +6196,145 @@ func file_goods_v1_message_proto_rawDescGZIP() []byte {
 	return file_goods_v1_message_proto_rawDescData
 }
 
-var file_goods_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_goods_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_goods_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_goods_v1_message_proto_goTypes = []any{
-	(*Empty)(nil),                      // 0: service.goods.api.goods.v1.Empty
-	(*CategoryListRequest)(nil),        // 1: service.goods.api.goods.v1.CategoryListRequest
-	(*CategoryInfoRequest)(nil),        // 2: service.goods.api.goods.v1.CategoryInfoRequest
-	(*DeleteCategoryRequest)(nil),      // 3: service.goods.api.goods.v1.DeleteCategoryRequest
-	(*QueryCategoryRequest)(nil),       // 4: service.goods.api.goods.v1.QueryCategoryRequest
-	(*CategoryInfoResponse)(nil),       // 5: service.goods.api.goods.v1.CategoryInfoResponse
-	(*CategoryListResponse)(nil),       // 6: service.goods.api.goods.v1.CategoryListResponse
-	(*SubCategoryListResponse)(nil),    // 7: service.goods.api.goods.v1.SubCategoryListResponse
-	(*CategoryBrandFilterRequest)(nil), // 8: service.goods.api.goods.v1.CategoryBrandFilterRequest
-	(*FilterRequest)(nil),              // 9: service.goods.api.goods.v1.FilterRequest
-	(*CategoryBrandRequest)(nil),       // 10: service.goods.api.goods.v1.CategoryBrandRequest
-	(*CategoryBrandResponse)(nil),      // 11: service.goods.api.goods.v1.CategoryBrandResponse
-	(*BannerRequest)(nil),              // 12: service.goods.api.goods.v1.BannerRequest
-	(*BannerResponse)(nil),             // 13: service.goods.api.goods.v1.BannerResponse
-	(*BannerListResponse)(nil),         // 14: service.goods.api.goods.v1.BannerListResponse
-	(*BrandFilterRequest)(nil),         // 15: service.goods.api.goods.v1.BrandFilterRequest
-	(*BrandRequest)(nil),               // 16: service.goods.api.goods.v1.BrandRequest
-	(*BrandInfoResponse)(nil),          // 17: service.goods.api.goods.v1.BrandInfoResponse
-	(*BrandListResponse)(nil),          // 18: service.goods.api.goods.v1.BrandListResponse
-	(*CategoryBrandListResponse)(nil),  // 19: service.goods.api.goods.v1.CategoryBrandListResponse
-	(*BatchGoodsIdInfo)(nil),           // 20: service.goods.api.goods.v1.BatchGoodsIdInfo
-	(*DeleteGoodsInfo)(nil),            // 21: service.goods.api.goods.v1.DeleteGoodsInfo
-	(*CategoryBriefInfoResponse)(nil),  // 22: service.goods.api.goods.v1.CategoryBriefInfoResponse
-	(*CategoryFilterRequest)(nil),      // 23: service.goods.api.goods.v1.CategoryFilterRequest
-	(*GoodInfoRequest)(nil),            // 24: service.goods.api.goods.v1.GoodInfoRequest
-	(*CreateGoodsInfo)(nil),            // 25: service.goods.api.goods.v1.CreateGoodsInfo
-	(*GoodsReduceRequest)(nil),         // 26: service.goods.api.goods.v1.GoodsReduceRequest
-	(*BatchCategoryInfoRequest)(nil),   // 27: service.goods.api.goods.v1.BatchCategoryInfoRequest
-	(*GoodsFilterRequest)(nil),         // 28: service.goods.api.goods.v1.GoodsFilterRequest
-	(*GoodsInfoResponse)(nil),          // 29: service.goods.api.goods.v1.GoodsInfoResponse
-	(*GoodsListResponse)(nil),          // 30: service.goods.api.goods.v1.GoodsListResponse
+	(CategoryAttributeType)(0),            // 0: service.goods.api.goods.v1.CategoryAttributeType
+	(GoodsSort)(0),                        // 1: service.goods.api.goods.v1.GoodsSort
+	(GoodsStatus)(0),                      // 2: service.goods.api.goods.v1.GoodsStatus
+	(*Empty)(nil),                         // 3: service.goods.api.goods.v1.Empty
+	(*CategoryListRequest)(nil),           // 4: service.goods.api.goods.v1.CategoryListRequest
+	(*CategoryInfoRequest)(nil),           // 5: service.goods.api.goods.v1.CategoryInfoRequest
+	(*DeleteCategoryRequest)(nil),         // 6: service.goods.api.goods.v1.DeleteCategoryRequest
+	(*QueryCategoryRequest)(nil),          // 7: service.goods.api.goods.v1.QueryCategoryRequest
+	(*CategoryInfoResponse)(nil),          // 8: service.goods.api.goods.v1.CategoryInfoResponse
+	(*CategoryListResponse)(nil),          // 9: service.goods.api.goods.v1.CategoryListResponse
+	(*SubCategoryListResponse)(nil),       // 10: service.goods.api.goods.v1.SubCategoryListResponse
+	(*CategoryAttributeInfo)(nil),         // 11: service.goods.api.goods.v1.CategoryAttributeInfo
+	(*CategoryAttributeListResponse)(nil), // 12: service.goods.api.goods.v1.CategoryAttributeListResponse
+	(*CategoryBrandFilterRequest)(nil),    // 13: service.goods.api.goods.v1.CategoryBrandFilterRequest
+	(*FilterRequest)(nil),                 // 14: service.goods.api.goods.v1.FilterRequest
+	(*CategoryBrandRequest)(nil),          // 15: service.goods.api.goods.v1.CategoryBrandRequest
+	(*CategoryBrandResponse)(nil),         // 16: service.goods.api.goods.v1.CategoryBrandResponse
+	(*BannerRequest)(nil),                 // 17: service.goods.api.goods.v1.BannerRequest
+	(*BannerResponse)(nil),                // 18: service.goods.api.goods.v1.BannerResponse
+	(*BannerListResponse)(nil),            // 19: service.goods.api.goods.v1.BannerListResponse
+	(*BrandFilterRequest)(nil),            // 20: service.goods.api.goods.v1.BrandFilterRequest
+	(*BrandRequest)(nil),                  // 21: service.goods.api.goods.v1.BrandRequest
+	(*BrandInfoResponse)(nil),             // 22: service.goods.api.goods.v1.BrandInfoResponse
+	(*BrandListResponse)(nil),             // 23: service.goods.api.goods.v1.BrandListResponse
+	(*CategoryBrandListResponse)(nil),     // 24: service.goods.api.goods.v1.CategoryBrandListResponse
+	(*BatchGoodsIdInfo)(nil),              // 25: service.goods.api.goods.v1.BatchGoodsIdInfo
+	(*GoodsSnRequest)(nil),                // 26: service.goods.api.goods.v1.GoodsSnRequest
+	(*BatchGoodsSnRequest)(nil),           // 27: service.goods.api.goods.v1.BatchGoodsSnRequest
+	(*GoodsSnIdInfo)(nil),                 // 28: service.goods.api.goods.v1.GoodsSnIdInfo
+	(*GoodsCounterInfo)(nil),              // 29: service.goods.api.goods.v1.GoodsCounterInfo
+	(*IncrGoodsCountersRequest)(nil),      // 30: service.goods.api.goods.v1.IncrGoodsCountersRequest
+	(*GoodsCountersResponse)(nil),         // 31: service.goods.api.goods.v1.GoodsCountersResponse
+	(*GoodsRecommendRequest)(nil),         // 32: service.goods.api.goods.v1.GoodsRecommendRequest
+	(*RecommendGoodsInfo)(nil),            // 33: service.goods.api.goods.v1.RecommendGoodsInfo
+	(*GoodsRecommendResponse)(nil),        // 34: service.goods.api.goods.v1.GoodsRecommendResponse
+	(*GoodsRatingInfo)(nil),               // 35: service.goods.api.goods.v1.GoodsRatingInfo
+	(*BatchGoodsSnResponse)(nil),          // 36: service.goods.api.goods.v1.BatchGoodsSnResponse
+	(*DeleteGoodsInfo)(nil),               // 37: service.goods.api.goods.v1.DeleteGoodsInfo
+	(*CategoryBriefInfoResponse)(nil),     // 38: service.goods.api.goods.v1.CategoryBriefInfoResponse
+	(*CategoryFilterRequest)(nil),         // 39: service.goods.api.goods.v1.CategoryFilterRequest
+	(*GoodInfoRequest)(nil),               // 40: service.goods.api.goods.v1.GoodInfoRequest
+	(*CreateGoodsInfo)(nil),               // 41: service.goods.api.goods.v1.CreateGoodsInfo
+	(*GoodsAttrValue)(nil),                // 42: service.goods.api.goods.v1.GoodsAttrValue
+	(*GoodsReduceRequest)(nil),            // 43: service.goods.api.goods.v1.GoodsReduceRequest
+	(*BatchCategoryInfoRequest)(nil),      // 44: service.goods.api.goods.v1.BatchCategoryInfoRequest
+	(*GoodsFilterRequest)(nil),            // 45: service.goods.api.goods.v1.GoodsFilterRequest
+	(*GoodsInfoResponse)(nil),             // 46: service.goods.api.goods.v1.GoodsInfoResponse
+	(*GoodsSkuSpec)(nil),                  // 47: service.goods.api.goods.v1.GoodsSkuSpec
+	(*GoodsSkuInfo)(nil),                  // 48: service.goods.api.goods.v1.GoodsSkuInfo
+	(*BatchSkuIdInfo)(nil),                // 49: service.goods.api.goods.v1.BatchSkuIdInfo
+	(*GoodsSkuListResponse)(nil),          // 50: service.goods.api.goods.v1.GoodsSkuListResponse
+	(*GoodsHighlight)(nil),                // 51: service.goods.api.goods.v1.GoodsHighlight
+	(*FacetBucket)(nil),                   // 52: service.goods.api.goods.v1.FacetBucket
+	(*PriceFacetBucket)(nil),              // 53: service.goods.api.goods.v1.PriceFacetBucket
+	(*GoodsFacets)(nil),                   // 54: service.goods.api.goods.v1.GoodsFacets
+	(*GoodsListResponse)(nil),             // 55: service.goods.api.goods.v1.GoodsListResponse
+	(*SuggestGoodsRequest)(nil),           // 56: service.goods.api.goods.v1.SuggestGoodsRequest
+	(*GoodsSuggestion)(nil),               // 57: service.goods.api.goods.v1.GoodsSuggestion
+	(*SuggestGoodsResponse)(nil),          // 58: service.goods.api.goods.v1.SuggestGoodsResponse
+	(*ReindexGoodsRequest)(nil),           // 59: service.goods.api.goods.v1.ReindexGoodsRequest
+	(*ReindexStatusResponse)(nil),         // 60: service.goods.api.goods.v1.ReindexStatusResponse
+	(*GoodsIndexResponse)(nil),            // 61: service.goods.api.goods.v1.GoodsIndexResponse
+	(*GoodsSynonymsRequest)(nil),          // 62: service.goods.api.goods.v1.GoodsSynonymsRequest
+	(*GoodsSynonymsResponse)(nil),         // 63: service.goods.api.goods.v1.GoodsSynonymsResponse
+	(*SearchKeywordsRequest)(nil),         // 64: service.goods.api.goods.v1.SearchKeywordsRequest
+	(*SearchKeyword)(nil),                 // 65: service.goods.api.goods.v1.SearchKeyword
+	(*SearchKeywordsResponse)(nil),        // 66: service.goods.api.goods.v1.SearchKeywordsResponse
+	(*HotKeywordBlocklist)(nil),           // 67: service.goods.api.goods.v1.HotKeywordBlocklist
+	(*GoodsStatusRequest)(nil),            // 68: service.goods.api.goods.v1.GoodsStatusRequest
+	(*GoodsScheduleRequest)(nil),          // 69: service.goods.api.goods.v1.GoodsScheduleRequest
+	(*GoodsStatusResponse)(nil),           // 70: service.goods.api.goods.v1.GoodsStatusResponse
+	(*GoodsStatusLogInfo)(nil),            // 71: service.goods.api.goods.v1.GoodsStatusLogInfo
+	(*GoodsStatusLogListResponse)(nil),    // 72: service.goods.api.goods.v1.GoodsStatusLogListResponse
+	(*GoodsDescVersionListRequest)(nil),   // 73: service.goods.api.goods.v1.GoodsDescVersionListRequest
+	(*GoodsDescVersionRequest)(nil),       // 74: service.goods.api.goods.v1.GoodsDescVersionRequest
+	(*GoodsDescVersionInfo)(nil),          // 75: service.goods.api.goods.v1.GoodsDescVersionInfo
+	(*GoodsDescVersionListResponse)(nil),  // 76: service.goods.api.goods.v1.GoodsDescVersionListResponse
+	(*GoodsPriceHistoryRequest)(nil),      // 77: service.goods.api.goods.v1.GoodsPriceHistoryRequest
+	(*GoodsPriceLogInfo)(nil),             // 78: service.goods.api.goods.v1.GoodsPriceLogInfo
+	(*GoodsPriceHistoryResponse)(nil),     // 79: service.goods.api.goods.v1.GoodsPriceHistoryResponse
+	(*ImportGoodsRequest)(nil),            // 80: service.goods.api.goods.v1.ImportGoodsRequest
+	(*ImportGoodsRowResult)(nil),          // 81: service.goods.api.goods.v1.ImportGoodsRowResult
+	(*ImportGoodsResponse)(nil),           // 82: service.goods.api.goods.v1.ImportGoodsResponse
+	(*ExportGoodsRequest)(nil),            // 83: service.goods.api.goods.v1.ExportGoodsRequest
+	(*ExportGoodsChunk)(nil),              // 84: service.goods.api.goods.v1.ExportGoodsChunk
 }
 var file_goods_v1_message_proto_depIdxs = []int32{
-	5,  // 0: service.goods.api.goods.v1.CategoryListResponse.data:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
-	5,  // 1: service.goods.api.goods.v1.SubCategoryListResponse.info:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
-	5,  // 2: service.goods.api.goods.v1.SubCategoryListResponse.subCategorys:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
-	17, // 3: service.goods.api.goods.v1.CategoryBrandResponse.brand:type_name -> service.goods.api.goods.v1.BrandInfoResponse
-	5,  // 4: service.goods.api.goods.v1.CategoryBrandResponse.category:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
-	13, // 5: service.goods.api.goods.v1.BannerListResponse.data:type_name -> service.goods.api.goods.v1.BannerResponse
-	17, // 6: service.goods.api.goods.v1.BrandListResponse.data:type_name -> service.goods.api.goods.v1.BrandInfoResponse
-	11, // 7: service.goods.api.goods.v1.CategoryBrandListResponse.data:type_name -> service.goods.api.goods.v1.CategoryBrandResponse
-	22, // 8: service.goods.api.goods.v1.GoodsInfoResponse.category:type_name -> service.goods.api.goods.v1.CategoryBriefInfoResponse
-	17, // 9: service.goods.api.goods.v1.GoodsInfoResponse.brand:type_name -> service.goods.api.goods.v1.BrandInfoResponse
-	29, // 10: service.goods.api.goods.v1.GoodsListResponse.data:type_name -> service.goods.api.goods.v1.GoodsInfoResponse
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	8,  // 0: service.goods.api.goods.v1.CategoryListResponse.data:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
+	8,  // 1: service.goods.api.goods.v1.SubCategoryListResponse.info:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
+	8,  // 2: service.goods.api.goods.v1.SubCategoryListResponse.subCategorys:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
+	0,  // 3: service.goods.api.goods.v1.CategoryAttributeInfo.type:type_name -> service.goods.api.goods.v1.CategoryAttributeType
+	11, // 4: service.goods.api.goods.v1.CategoryAttributeListResponse.data:type_name -> service.goods.api.goods.v1.CategoryAttributeInfo
+	22, // 5: service.goods.api.goods.v1.CategoryBrandResponse.brand:type_name -> service.goods.api.goods.v1.BrandInfoResponse
+	8,  // 6: service.goods.api.goods.v1.CategoryBrandResponse.category:type_name -> service.goods.api.goods.v1.CategoryInfoResponse
+	18, // 7: service.goods.api.goods.v1.BannerListResponse.data:type_name -> service.goods.api.goods.v1.BannerResponse
+	22, // 8: service.goods.api.goods.v1.BrandListResponse.data:type_name -> service.goods.api.goods.v1.BrandInfoResponse
+	16, // 9: service.goods.api.goods.v1.CategoryBrandListResponse.data:type_name -> service.goods.api.goods.v1.CategoryBrandResponse
+	29, // 10: service.goods.api.goods.v1.IncrGoodsCountersRequest.items:type_name -> service.goods.api.goods.v1.GoodsCounterInfo
+	29, // 11: service.goods.api.goods.v1.GoodsCountersResponse.data:type_name -> service.goods.api.goods.v1.GoodsCounterInfo
+	46, // 12: service.goods.api.goods.v1.RecommendGoodsInfo.goods:type_name -> service.goods.api.goods.v1.GoodsInfoResponse
+	33, // 13: service.goods.api.goods.v1.GoodsRecommendResponse.data:type_name -> service.goods.api.goods.v1.RecommendGoodsInfo
+	28, // 14: service.goods.api.goods.v1.BatchGoodsSnResponse.data:type_name -> service.goods.api.goods.v1.GoodsSnIdInfo
+	42, // 15: service.goods.api.goods.v1.CreateGoodsInfo.attrs:type_name -> service.goods.api.goods.v1.GoodsAttrValue
+	1,  // 16: service.goods.api.goods.v1.GoodsFilterRequest.sort:type_name -> service.goods.api.goods.v1.GoodsSort
+	42, // 17: service.goods.api.goods.v1.GoodsFilterRequest.attrs:type_name -> service.goods.api.goods.v1.GoodsAttrValue
+	38, // 18: service.goods.api.goods.v1.GoodsInfoResponse.category:type_name -> service.goods.api.goods.v1.CategoryBriefInfoResponse
+	22, // 19: service.goods.api.goods.v1.GoodsInfoResponse.brand:type_name -> service.goods.api.goods.v1.BrandInfoResponse
+	51, // 20: service.goods.api.goods.v1.GoodsInfoResponse.highlight:type_name -> service.goods.api.goods.v1.GoodsHighlight
+	48, // 21: service.goods.api.goods.v1.GoodsInfoResponse.skus:type_name -> service.goods.api.goods.v1.GoodsSkuInfo
+	42, // 22: service.goods.api.goods.v1.GoodsInfoResponse.attrs:type_name -> service.goods.api.goods.v1.GoodsAttrValue
+	2,  // 23: service.goods.api.goods.v1.GoodsInfoResponse.status:type_name -> service.goods.api.goods.v1.GoodsStatus
+	47, // 24: service.goods.api.goods.v1.GoodsSkuInfo.specs:type_name -> service.goods.api.goods.v1.GoodsSkuSpec
+	48, // 25: service.goods.api.goods.v1.GoodsSkuListResponse.data:type_name -> service.goods.api.goods.v1.GoodsSkuInfo
+	52, // 26: service.goods.api.goods.v1.GoodsFacets.brands:type_name -> service.goods.api.goods.v1.FacetBucket
+	52, // 27: service.goods.api.goods.v1.GoodsFacets.categories:type_name -> service.goods.api.goods.v1.FacetBucket
+	53, // 28: service.goods.api.goods.v1.GoodsFacets.prices:type_name -> service.goods.api.goods.v1.PriceFacetBucket
+	46, // 29: service.goods.api.goods.v1.GoodsListResponse.data:type_name -> service.goods.api.goods.v1.GoodsInfoResponse
+	54, // 30: service.goods.api.goods.v1.GoodsListResponse.facets:type_name -> service.goods.api.goods.v1.GoodsFacets
+	57, // 31: service.goods.api.goods.v1.SuggestGoodsResponse.goods:type_name -> service.goods.api.goods.v1.GoodsSuggestion
+	38, // 32: service.goods.api.goods.v1.SuggestGoodsResponse.categories:type_name -> service.goods.api.goods.v1.CategoryBriefInfoResponse
+	22, // 33: service.goods.api.goods.v1.SuggestGoodsResponse.brands:type_name -> service.goods.api.goods.v1.BrandInfoResponse
+	65, // 34: service.goods.api.goods.v1.SearchKeywordsResponse.keywords:type_name -> service.goods.api.goods.v1.SearchKeyword
+	2,  // 35: service.goods.api.goods.v1.GoodsStatusRequest.status:type_name -> service.goods.api.goods.v1.GoodsStatus
+	2,  // 36: service.goods.api.goods.v1.GoodsStatusResponse.status:type_name -> service.goods.api.goods.v1.GoodsStatus
+	2,  // 37: service.goods.api.goods.v1.GoodsStatusLogInfo.fromStatus:type_name -> service.goods.api.goods.v1.GoodsStatus
+	2,  // 38: service.goods.api.goods.v1.GoodsStatusLogInfo.toStatus:type_name -> service.goods.api.goods.v1.GoodsStatus
+	71, // 39: service.goods.api.goods.v1.GoodsStatusLogListResponse.data:type_name -> service.goods.api.goods.v1.GoodsStatusLogInfo
+	75, // 40: service.goods.api.goods.v1.GoodsDescVersionListResponse.data:type_name -> service.goods.api.goods.v1.GoodsDescVersionInfo
+	78, // 41: service.goods.api.goods.v1.GoodsPriceHistoryResponse.data:type_name -> service.goods.api.goods.v1.GoodsPriceLogInfo
+	81, // 42: service.goods.api.goods.v1.ImportGoodsResponse.rows:type_name -> service.goods.api.goods.v1.ImportGoodsRowResult
+	45, // 43: service.goods.api.goods.v1.ExportGoodsRequest.filter:type_name -> service.goods.api.goods.v1.GoodsFilterRequest
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_goods_v1_message_proto_init() }
@@ -2328,18 +6342,20 @@ func file_goods_v1_message_proto_init() {
 	if File_goods_v1_message_proto != nil {
 		return
 	}
+	file_goods_v1_message_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goods_v1_message_proto_rawDesc), len(file_goods_v1_message_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   31,
+			NumEnums:      3,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_goods_v1_message_proto_goTypes,
 		DependencyIndexes: file_goods_v1_message_proto_depIdxs,
+		EnumInfos:         file_goods_v1_message_proto_enumTypes,
 		MessageInfos:      file_goods_v1_message_proto_msgTypes,
 	}.Build()
 	File_goods_v1_message_proto = out.File
@@ -25,19 +25,55 @@ var File_goods_v1_service_proto protoreflect.FileDescriptor
 
 const file_goods_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16goods/v1/service.proto\x12\x1aservice.goods.api.goods.v1\x1a\x16goods/v1/message.proto\x1a\x1cgoogle/api/annotations.proto2\x98\x19\n" +
+	"\x16goods/v1/service.proto\x12\x1aservice.goods.api.goods.v1\x1a\x16goods/v1/message.proto\x1a\x1cgoogle/api/annotations.proto2\xd3C\n" +
 	"\x05Goods\x12}\n" +
 	"\tGoodsList\x12..service.goods.api.goods.v1.GoodsFilterRequest\x1a-.service.goods.api.goods.v1.GoodsListResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/goods\x12\x88\x01\n" +
-	"\rBatchGetGoods\x12,.service.goods.api.goods.v1.BatchGoodsIdInfo\x1a-.service.goods.api.goods.v1.GoodsListResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/goods/batch\x12\x7f\n" +
+	"\x0eAdminGoodsList\x12..service.goods.api.goods.v1.GoodsFilterRequest\x1a-.service.goods.api.goods.v1.GoodsListResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/goods\x12\x8c\x01\n" +
+	"\fSuggestGoods\x12/.service.goods.api.goods.v1.SuggestGoodsRequest\x1a0.service.goods.api.goods.v1.SuggestGoodsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/goods/suggest\x12\x94\x01\n" +
+	"\x0eRecommendGoods\x121.service.goods.api.goods.v1.GoodsRecommendRequest\x1a2.service.goods.api.goods.v1.GoodsRecommendResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/goods/recommend\x12\x88\x01\n" +
+	"\rBatchGetGoods\x12,.service.goods.api.goods.v1.BatchGoodsIdInfo\x1a-.service.goods.api.goods.v1.GoodsListResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/goods/batch\x12\x89\x01\n" +
+	"\fGetGoodsBySn\x12*.service.goods.api.goods.v1.GoodsSnRequest\x1a-.service.goods.api.goods.v1.GoodsInfoResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/goods/sn/{goodsSn}\x12\x97\x01\n" +
+	"\x13BatchResolveGoodsSn\x12/.service.goods.api.goods.v1.BatchGoodsSnRequest\x1a0.service.goods.api.goods.v1.BatchGoodsSnResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/goods/sn/batch\x12\x8b\x01\n" +
+	"\x11IncrGoodsCounters\x124.service.goods.api.goods.v1.IncrGoodsCountersRequest\x1a!.service.goods.api.goods.v1.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/goods/counters\x12\x98\x01\n" +
+	"\x10GetGoodsCounters\x12,.service.goods.api.goods.v1.BatchGoodsIdInfo\x1a1.service.goods.api.goods.v1.GoodsCountersResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/goods/counters/batch\x12\x8a\x01\n" +
+	"\x11UpdateGoodsRating\x12+.service.goods.api.goods.v1.GoodsRatingInfo\x1a!.service.goods.api.goods.v1.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/goods/{goodsId}/rating\x12\x7f\n" +
 	"\vCreateGoods\x12+.service.goods.api.goods.v1.CreateGoodsInfo\x1a-.service.goods.api.goods.v1.GoodsInfoResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/goods\x12u\n" +
 	"\vDeleteGoods\x12+.service.goods.api.goods.v1.DeleteGoodsInfo\x1a!.service.goods.api.goods.v1.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/goods/{id}\x12x\n" +
 	"\vUpdateGoods\x12+.service.goods.api.goods.v1.CreateGoodsInfo\x1a!.service.goods.api.goods.v1.Empty\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/goods/{id}\x12\x84\x01\n" +
-	"\x0eGetGoodsDetail\x12+.service.goods.api.goods.v1.GoodInfoRequest\x1a-.service.goods.api.goods.v1.GoodsInfoResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/goods/{id}\x12\x82\x01\n" +
+	"\x0eGetGoodsDetail\x12+.service.goods.api.goods.v1.GoodInfoRequest\x1a-.service.goods.api.goods.v1.GoodsInfoResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/goods/{id}\x12\x8b\x01\n" +
+	"\vImportGoods\x12..service.goods.api.goods.v1.ImportGoodsRequest\x1a/.service.goods.api.goods.v1.ImportGoodsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/goods/import\x12v\n" +
+	"\x11ImportGoodsStream\x12..service.goods.api.goods.v1.ImportGoodsRequest\x1a/.service.goods.api.goods.v1.ImportGoodsResponse(\x01\x12m\n" +
+	"\vExportGoods\x12..service.goods.api.goods.v1.ExportGoodsRequest\x1a,.service.goods.api.goods.v1.ExportGoodsChunk0\x01\x12\x96\x01\n" +
+	"\x11UpdateGoodsStatus\x12..service.goods.api.goods.v1.GoodsStatusRequest\x1a/.service.goods.api.goods.v1.GoodsStatusResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/goods/{id}/status\x12\x9a\x01\n" +
+	"\x11ScheduleGoodsSale\x120.service.goods.api.goods.v1.GoodsScheduleRequest\x1a/.service.goods.api.goods.v1.GoodsStatusResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/goods/{id}/schedule\x12\x9a\x01\n" +
+	"\x0fGoodsStatusLogs\x12+.service.goods.api.goods.v1.GoodInfoRequest\x1a6.service.goods.api.goods.v1.GoodsStatusLogListResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/goods/{id}/status-logs\x12\xa6\x01\n" +
+	"\x11GoodsPriceHistory\x124.service.goods.api.goods.v1.GoodsPriceHistoryRequest\x1a5.service.goods.api.goods.v1.GoodsPriceHistoryResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/goods/{id}/price-history\x12\xac\x01\n" +
+	"\x11GoodsDescVersions\x127.service.goods.api.goods.v1.GoodsDescVersionListRequest\x1a8.service.goods.api.goods.v1.GoodsDescVersionListResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/goods/{id}/desc-versions\x12\xac\x01\n" +
+	"\x13GetGoodsDescVersion\x123.service.goods.api.goods.v1.GoodsDescVersionRequest\x1a0.service.goods.api.goods.v1.GoodsDescVersionInfo\".\x82\xd3\xe4\x93\x02(\x12&/v1/goods/{id}/desc-versions/{version}\x12\xb6\x01\n" +
+	"\x11RollbackGoodsDesc\x123.service.goods.api.goods.v1.GoodsDescVersionRequest\x1a0.service.goods.api.goods.v1.GoodsDescVersionInfo\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/goods/{id}/desc-versions/{version}/rollback\x12\x8a\x01\n" +
+	"\fGoodsSkuList\x12+.service.goods.api.goods.v1.GoodInfoRequest\x1a0.service.goods.api.goods.v1.GoodsSkuListResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/goods/{id}/skus\x12\x8d\x01\n" +
+	"\fBatchGetSkus\x12*.service.goods.api.goods.v1.BatchSkuIdInfo\x1a0.service.goods.api.goods.v1.GoodsSkuListResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/goods/skus/batch\x12\x89\x01\n" +
+	"\x0eCreateGoodsSku\x12(.service.goods.api.goods.v1.GoodsSkuInfo\x1a(.service.goods.api.goods.v1.GoodsSkuInfo\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/goods/{goodsId}/skus\x12}\n" +
+	"\x0eUpdateGoodsSku\x12(.service.goods.api.goods.v1.GoodsSkuInfo\x1a!.service.goods.api.goods.v1.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/goods/skus/{id}\x12z\n" +
+	"\x0eDeleteGoodsSku\x12(.service.goods.api.goods.v1.GoodsSkuInfo\x1a!.service.goods.api.goods.v1.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/goods/skus/{id}\x12\x96\x01\n" +
+	"\fReindexGoods\x12/.service.goods.api.goods.v1.ReindexGoodsRequest\x1a1.service.goods.api.goods.v1.ReindexStatusResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/goods/index/reindex\x12\x89\x01\n" +
+	"\x10GetReindexStatus\x12!.service.goods.api.goods.v1.Empty\x1a1.service.goods.api.goods.v1.ReindexStatusResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/goods/index/reindex\x12\x8c\x01\n" +
+	"\x12RollbackGoodsIndex\x12!.service.goods.api.goods.v1.Empty\x1a..service.goods.api.goods.v1.GoodsIndexResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/goods/index/rollback\x12\x8a\x01\n" +
+	"\x10GetGoodsSynonyms\x12!.service.goods.api.goods.v1.Empty\x1a1.service.goods.api.goods.v1.GoodsSynonymsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/goods/index/synonyms\x12\x9f\x01\n" +
+	"\x13UpdateGoodsSynonyms\x120.service.goods.api.goods.v1.GoodsSynonymsRequest\x1a1.service.goods.api.goods.v1.GoodsSynonymsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/goods/index/synonyms\x12\x9b\x01\n" +
+	"\vHotKeywords\x121.service.goods.api.goods.v1.SearchKeywordsRequest\x1a2.service.goods.api.goods.v1.SearchKeywordsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/goods/search/hot-keywords\x12\xaa\x01\n" +
+	"\x12ZeroResultKeywords\x121.service.goods.api.goods.v1.SearchKeywordsRequest\x1a2.service.goods.api.goods.v1.SearchKeywordsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/goods/search/zero-result-keywords\x12\x90\x01\n" +
+	"\x16GetHotKeywordBlocklist\x12!.service.goods.api.goods.v1.Empty\x1a/.service.goods.api.goods.v1.HotKeywordBlocklist\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/goods/search/blocklist\x12\xa4\x01\n" +
+	"\x19UpdateHotKeywordBlocklist\x12/.service.goods.api.goods.v1.HotKeywordBlocklist\x1a/.service.goods.api.goods.v1.HotKeywordBlocklist\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/goods/search/blocklist\x12\x82\x01\n" +
 	"\x13GetAllCategorysList\x12!.service.goods.api.goods.v1.Empty\x1a0.service.goods.api.goods.v1.CategoryListResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12\x97\x01\n" +
 	"\x0eGetSubCategory\x12/.service.goods.api.goods.v1.CategoryListRequest\x1a3.service.goods.api.goods.v1.SubCategoryListResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/categories/{id}/sub\x12\x8e\x01\n" +
 	"\x0eCreateCategory\x12/.service.goods.api.goods.v1.CategoryInfoRequest\x1a0.service.goods.api.goods.v1.CategoryInfoResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/categories\x12\x83\x01\n" +
 	"\x0eDeleteCategory\x121.service.goods.api.goods.v1.DeleteCategoryRequest\x1a!.service.goods.api.goods.v1.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/categories/{id}\x12\x84\x01\n" +
-	"\x0eUpdateCategory\x12/.service.goods.api.goods.v1.CategoryInfoRequest\x1a!.service.goods.api.goods.v1.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/categories/{id}\x12~\n" +
+	"\x0eUpdateCategory\x12/.service.goods.api.goods.v1.CategoryInfoRequest\x1a!.service.goods.api.goods.v1.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/categories/{id}\x12\xab\x01\n" +
+	"\x15CategoryAttributeList\x12/.service.goods.api.goods.v1.CategoryListRequest\x1a9.service.goods.api.goods.v1.CategoryAttributeListResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/categories/{id}/attributes\x12\xb2\x01\n" +
+	"\x17CreateCategoryAttribute\x121.service.goods.api.goods.v1.CategoryAttributeInfo\x1a1.service.goods.api.goods.v1.CategoryAttributeInfo\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/categories/{categoryId}/attributes\x12\x9a\x01\n" +
+	"\x17UpdateCategoryAttribute\x121.service.goods.api.goods.v1.CategoryAttributeInfo\x1a!.service.goods.api.goods.v1.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/categories/attributes/{id}\x12\x97\x01\n" +
+	"\x17DeleteCategoryAttribute\x121.service.goods.api.goods.v1.CategoryAttributeInfo\x1a!.service.goods.api.goods.v1.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/categories/attributes/{id}\x12~\n" +
 	"\tBrandList\x12..service.goods.api.goods.v1.BrandFilterRequest\x1a-.service.goods.api.goods.v1.BrandListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/brands\x12}\n" +
 	"\vCreateBrand\x12(.service.goods.api.goods.v1.BrandRequest\x1a-.service.goods.api.goods.v1.BrandInfoResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
@@ -57,83 +93,192 @@ const file_goods_v1_service_proto_rawDesc = "" +
 	"\x1aservice.goods.api.goods.v1P\x01Z#mshop/service/goods/api/goods/v1;v1b\x06proto3"
 
 var file_goods_v1_service_proto_goTypes = []any{
-	(*GoodsFilterRequest)(nil),         // 0: service.goods.api.goods.v1.GoodsFilterRequest
-	(*BatchGoodsIdInfo)(nil),           // 1: service.goods.api.goods.v1.BatchGoodsIdInfo
-	(*CreateGoodsInfo)(nil),            // 2: service.goods.api.goods.v1.CreateGoodsInfo
-	(*DeleteGoodsInfo)(nil),            // 3: service.goods.api.goods.v1.DeleteGoodsInfo
-	(*GoodInfoRequest)(nil),            // 4: service.goods.api.goods.v1.GoodInfoRequest
-	(*Empty)(nil),                      // 5: service.goods.api.goods.v1.Empty
-	(*CategoryListRequest)(nil),        // 6: service.goods.api.goods.v1.CategoryListRequest
-	(*CategoryInfoRequest)(nil),        // 7: service.goods.api.goods.v1.CategoryInfoRequest
-	(*DeleteCategoryRequest)(nil),      // 8: service.goods.api.goods.v1.DeleteCategoryRequest
-	(*BrandFilterRequest)(nil),         // 9: service.goods.api.goods.v1.BrandFilterRequest
-	(*BrandRequest)(nil),               // 10: service.goods.api.goods.v1.BrandRequest
-	(*BannerRequest)(nil),              // 11: service.goods.api.goods.v1.BannerRequest
-	(*CategoryBrandFilterRequest)(nil), // 12: service.goods.api.goods.v1.CategoryBrandFilterRequest
-	(*CategoryBrandRequest)(nil),       // 13: service.goods.api.goods.v1.CategoryBrandRequest
-	(*GoodsListResponse)(nil),          // 14: service.goods.api.goods.v1.GoodsListResponse
-	(*GoodsInfoResponse)(nil),          // 15: service.goods.api.goods.v1.GoodsInfoResponse
-	(*CategoryListResponse)(nil),       // 16: service.goods.api.goods.v1.CategoryListResponse
-	(*SubCategoryListResponse)(nil),    // 17: service.goods.api.goods.v1.SubCategoryListResponse
-	(*CategoryInfoResponse)(nil),       // 18: service.goods.api.goods.v1.CategoryInfoResponse
-	(*BrandListResponse)(nil),          // 19: service.goods.api.goods.v1.BrandListResponse
-	(*BrandInfoResponse)(nil),          // 20: service.goods.api.goods.v1.BrandInfoResponse
-	(*BannerListResponse)(nil),         // 21: service.goods.api.goods.v1.BannerListResponse
-	(*BannerResponse)(nil),             // 22: service.goods.api.goods.v1.BannerResponse
-	(*CategoryBrandListResponse)(nil),  // 23: service.goods.api.goods.v1.CategoryBrandListResponse
-	(*CategoryBrandResponse)(nil),      // 24: service.goods.api.goods.v1.CategoryBrandResponse
+	(*GoodsFilterRequest)(nil),            // 0: service.goods.api.goods.v1.GoodsFilterRequest
+	(*SuggestGoodsRequest)(nil),           // 1: service.goods.api.goods.v1.SuggestGoodsRequest
+	(*GoodsRecommendRequest)(nil),         // 2: service.goods.api.goods.v1.GoodsRecommendRequest
+	(*BatchGoodsIdInfo)(nil),              // 3: service.goods.api.goods.v1.BatchGoodsIdInfo
+	(*GoodsSnRequest)(nil),                // 4: service.goods.api.goods.v1.GoodsSnRequest
+	(*BatchGoodsSnRequest)(nil),           // 5: service.goods.api.goods.v1.BatchGoodsSnRequest
+	(*IncrGoodsCountersRequest)(nil),      // 6: service.goods.api.goods.v1.IncrGoodsCountersRequest
+	(*GoodsRatingInfo)(nil),               // 7: service.goods.api.goods.v1.GoodsRatingInfo
+	(*CreateGoodsInfo)(nil),               // 8: service.goods.api.goods.v1.CreateGoodsInfo
+	(*DeleteGoodsInfo)(nil),               // 9: service.goods.api.goods.v1.DeleteGoodsInfo
+	(*GoodInfoRequest)(nil),               // 10: service.goods.api.goods.v1.GoodInfoRequest
+	(*ImportGoodsRequest)(nil),            // 11: service.goods.api.goods.v1.ImportGoodsRequest
+	(*ExportGoodsRequest)(nil),            // 12: service.goods.api.goods.v1.ExportGoodsRequest
+	(*GoodsStatusRequest)(nil),            // 13: service.goods.api.goods.v1.GoodsStatusRequest
+	(*GoodsScheduleRequest)(nil),          // 14: service.goods.api.goods.v1.GoodsScheduleRequest
+	(*GoodsPriceHistoryRequest)(nil),      // 15: service.goods.api.goods.v1.GoodsPriceHistoryRequest
+	(*GoodsDescVersionListRequest)(nil),   // 16: service.goods.api.goods.v1.GoodsDescVersionListRequest
+	(*GoodsDescVersionRequest)(nil),       // 17: service.goods.api.goods.v1.GoodsDescVersionRequest
+	(*BatchSkuIdInfo)(nil),                // 18: service.goods.api.goods.v1.BatchSkuIdInfo
+	(*GoodsSkuInfo)(nil),                  // 19: service.goods.api.goods.v1.GoodsSkuInfo
+	(*ReindexGoodsRequest)(nil),           // 20: service.goods.api.goods.v1.ReindexGoodsRequest
+	(*Empty)(nil),                         // 21: service.goods.api.goods.v1.Empty
+	(*GoodsSynonymsRequest)(nil),          // 22: service.goods.api.goods.v1.GoodsSynonymsRequest
+	(*SearchKeywordsRequest)(nil),         // 23: service.goods.api.goods.v1.SearchKeywordsRequest
+	(*HotKeywordBlocklist)(nil),           // 24: service.goods.api.goods.v1.HotKeywordBlocklist
+	(*CategoryListRequest)(nil),           // 25: service.goods.api.goods.v1.CategoryListRequest
+	(*CategoryInfoRequest)(nil),           // 26: service.goods.api.goods.v1.CategoryInfoRequest
+	(*DeleteCategoryRequest)(nil),         // 27: service.goods.api.goods.v1.DeleteCategoryRequest
+	(*CategoryAttributeInfo)(nil),         // 28: service.goods.api.goods.v1.CategoryAttributeInfo
+	(*BrandFilterRequest)(nil),            // 29: service.goods.api.goods.v1.BrandFilterRequest
+	(*BrandRequest)(nil),                  // 30: service.goods.api.goods.v1.BrandRequest
+	(*BannerRequest)(nil),                 // 31: service.goods.api.goods.v1.BannerRequest
+	(*CategoryBrandFilterRequest)(nil),    // 32: service.goods.api.goods.v1.CategoryBrandFilterRequest
+	(*CategoryBrandRequest)(nil),          // 33: service.goods.api.goods.v1.CategoryBrandRequest
+	(*GoodsListResponse)(nil),             // 34: service.goods.api.goods.v1.GoodsListResponse
+	(*SuggestGoodsResponse)(nil),          // 35: service.goods.api.goods.v1.SuggestGoodsResponse
+	(*GoodsRecommendResponse)(nil),        // 36: service.goods.api.goods.v1.GoodsRecommendResponse
+	(*GoodsInfoResponse)(nil),             // 37: service.goods.api.goods.v1.GoodsInfoResponse
+	(*BatchGoodsSnResponse)(nil),          // 38: service.goods.api.goods.v1.BatchGoodsSnResponse
+	(*GoodsCountersResponse)(nil),         // 39: service.goods.api.goods.v1.GoodsCountersResponse
+	(*ImportGoodsResponse)(nil),           // 40: service.goods.api.goods.v1.ImportGoodsResponse
+	(*ExportGoodsChunk)(nil),              // 41: service.goods.api.goods.v1.ExportGoodsChunk
+	(*GoodsStatusResponse)(nil),           // 42: service.goods.api.goods.v1.GoodsStatusResponse
+	(*GoodsStatusLogListResponse)(nil),    // 43: service.goods.api.goods.v1.GoodsStatusLogListResponse
+	(*GoodsPriceHistoryResponse)(nil),     // 44: service.goods.api.goods.v1.GoodsPriceHistoryResponse
+	(*GoodsDescVersionListResponse)(nil),  // 45: service.goods.api.goods.v1.GoodsDescVersionListResponse
+	(*GoodsDescVersionInfo)(nil),          // 46: service.goods.api.goods.v1.GoodsDescVersionInfo
+	(*GoodsSkuListResponse)(nil),          // 47: service.goods.api.goods.v1.GoodsSkuListResponse
+	(*ReindexStatusResponse)(nil),         // 48: service.goods.api.goods.v1.ReindexStatusResponse
+	(*GoodsIndexResponse)(nil),            // 49: service.goods.api.goods.v1.GoodsIndexResponse
+	(*GoodsSynonymsResponse)(nil),         // 50: service.goods.api.goods.v1.GoodsSynonymsResponse
+	(*SearchKeywordsResponse)(nil),        // 51: service.goods.api.goods.v1.SearchKeywordsResponse
+	(*CategoryListResponse)(nil),          // 52: service.goods.api.goods.v1.CategoryListResponse
+	(*SubCategoryListResponse)(nil),       // 53: service.goods.api.goods.v1.SubCategoryListResponse
+	(*CategoryInfoResponse)(nil),          // 54: service.goods.api.goods.v1.CategoryInfoResponse
+	(*CategoryAttributeListResponse)(nil), // 55: service.goods.api.goods.v1.CategoryAttributeListResponse
+	(*BrandListResponse)(nil),             // 56: service.goods.api.goods.v1.BrandListResponse
+	(*BrandInfoResponse)(nil),             // 57: service.goods.api.goods.v1.BrandInfoResponse
+	(*BannerListResponse)(nil),            // 58: service.goods.api.goods.v1.BannerListResponse
+	(*BannerResponse)(nil),                // 59: service.goods.api.goods.v1.BannerResponse
+	(*CategoryBrandListResponse)(nil),     // 60: service.goods.api.goods.v1.CategoryBrandListResponse
+	(*CategoryBrandResponse)(nil),         // 61: service.goods.api.goods.v1.CategoryBrandResponse
 }
 var file_goods_v1_service_proto_depIdxs = []int32{
 	0,  // 0: service.goods.api.goods.v1.Goods.GoodsList:input_type -> service.goods.api.goods.v1.GoodsFilterRequest
-	1,  // 1: service.goods.api.goods.v1.Goods.BatchGetGoods:input_type -> service.goods.api.goods.v1.BatchGoodsIdInfo
-	2,  // 2: service.goods.api.goods.v1.Goods.CreateGoods:input_type -> service.goods.api.goods.v1.CreateGoodsInfo
-	3,  // 3: service.goods.api.goods.v1.Goods.DeleteGoods:input_type -> service.goods.api.goods.v1.DeleteGoodsInfo
-	2,  // 4: service.goods.api.goods.v1.Goods.UpdateGoods:input_type -> service.goods.api.goods.v1.CreateGoodsInfo
-	4,  // 5: service.goods.api.goods.v1.Goods.GetGoodsDetail:input_type -> service.goods.api.goods.v1.GoodInfoRequest
-	5,  // 6: service.goods.api.goods.v1.Goods.GetAllCategorysList:input_type -> service.goods.api.goods.v1.Empty
-	6,  // 7: service.goods.api.goods.v1.Goods.GetSubCategory:input_type -> service.goods.api.goods.v1.CategoryListRequest
-	7,  // 8: service.goods.api.goods.v1.Goods.CreateCategory:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
-	8,  // 9: service.goods.api.goods.v1.Goods.DeleteCategory:input_type -> service.goods.api.goods.v1.DeleteCategoryRequest
-	7,  // 10: service.goods.api.goods.v1.Goods.UpdateCategory:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
-	9,  // 11: service.goods.api.goods.v1.Goods.BrandList:input_type -> service.goods.api.goods.v1.BrandFilterRequest
-	10, // 12: service.goods.api.goods.v1.Goods.CreateBrand:input_type -> service.goods.api.goods.v1.BrandRequest
-	10, // 13: service.goods.api.goods.v1.Goods.DeleteBrand:input_type -> service.goods.api.goods.v1.BrandRequest
-	10, // 14: service.goods.api.goods.v1.Goods.UpdateBrand:input_type -> service.goods.api.goods.v1.BrandRequest
-	5,  // 15: service.goods.api.goods.v1.Goods.BannerList:input_type -> service.goods.api.goods.v1.Empty
-	11, // 16: service.goods.api.goods.v1.Goods.CreateBanner:input_type -> service.goods.api.goods.v1.BannerRequest
-	11, // 17: service.goods.api.goods.v1.Goods.DeleteBanner:input_type -> service.goods.api.goods.v1.BannerRequest
-	11, // 18: service.goods.api.goods.v1.Goods.UpdateBanner:input_type -> service.goods.api.goods.v1.BannerRequest
-	12, // 19: service.goods.api.goods.v1.Goods.CategoryBrandList:input_type -> service.goods.api.goods.v1.CategoryBrandFilterRequest
-	7,  // 20: service.goods.api.goods.v1.Goods.GetCategoryBrandList:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
-	13, // 21: service.goods.api.goods.v1.Goods.CreateCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
-	13, // 22: service.goods.api.goods.v1.Goods.DeleteCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
-	13, // 23: service.goods.api.goods.v1.Goods.UpdateCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
-	14, // 24: service.goods.api.goods.v1.Goods.GoodsList:output_type -> service.goods.api.goods.v1.GoodsListResponse
-	14, // 25: service.goods.api.goods.v1.Goods.BatchGetGoods:output_type -> service.goods.api.goods.v1.GoodsListResponse
-	15, // 26: service.goods.api.goods.v1.Goods.CreateGoods:output_type -> service.goods.api.goods.v1.GoodsInfoResponse
-	5,  // 27: service.goods.api.goods.v1.Goods.DeleteGoods:output_type -> service.goods.api.goods.v1.Empty
-	5,  // 28: service.goods.api.goods.v1.Goods.UpdateGoods:output_type -> service.goods.api.goods.v1.Empty
-	15, // 29: service.goods.api.goods.v1.Goods.GetGoodsDetail:output_type -> service.goods.api.goods.v1.GoodsInfoResponse
-	16, // 30: service.goods.api.goods.v1.Goods.GetAllCategorysList:output_type -> service.goods.api.goods.v1.CategoryListResponse
-	17, // 31: service.goods.api.goods.v1.Goods.GetSubCategory:output_type -> service.goods.api.goods.v1.SubCategoryListResponse
-	18, // 32: service.goods.api.goods.v1.Goods.CreateCategory:output_type -> service.goods.api.goods.v1.CategoryInfoResponse
-	5,  // 33: service.goods.api.goods.v1.Goods.DeleteCategory:output_type -> service.goods.api.goods.v1.Empty
-	5,  // 34: service.goods.api.goods.v1.Goods.UpdateCategory:output_type -> service.goods.api.goods.v1.Empty
-	19, // 35: service.goods.api.goods.v1.Goods.BrandList:output_type -> service.goods.api.goods.v1.BrandListResponse
-	20, // 36: service.goods.api.goods.v1.Goods.CreateBrand:output_type -> service.goods.api.goods.v1.BrandInfoResponse
-	5,  // 37: service.goods.api.goods.v1.Goods.DeleteBrand:output_type -> service.goods.api.goods.v1.Empty
-	5,  // 38: service.goods.api.goods.v1.Goods.UpdateBrand:output_type -> service.goods.api.goods.v1.Empty
-	21, // 39: service.goods.api.goods.v1.Goods.BannerList:output_type -> service.goods.api.goods.v1.BannerListResponse
-	22, // 40: service.goods.api.goods.v1.Goods.CreateBanner:output_type -> service.goods.api.goods.v1.BannerResponse
-	5,  // 41: service.goods.api.goods.v1.Goods.DeleteBanner:output_type -> service.goods.api.goods.v1.Empty
-	5,  // 42: service.goods.api.goods.v1.Goods.UpdateBanner:output_type -> service.goods.api.goods.v1.Empty
-	23, // 43: service.goods.api.goods.v1.Goods.CategoryBrandList:output_type -> service.goods.api.goods.v1.CategoryBrandListResponse
-	19, // 44: service.goods.api.goods.v1.Goods.GetCategoryBrandList:output_type -> service.goods.api.goods.v1.BrandListResponse
-	24, // 45: service.goods.api.goods.v1.Goods.CreateCategoryBrand:output_type -> service.goods.api.goods.v1.CategoryBrandResponse
-	5,  // 46: service.goods.api.goods.v1.Goods.DeleteCategoryBrand:output_type -> service.goods.api.goods.v1.Empty
-	5,  // 47: service.goods.api.goods.v1.Goods.UpdateCategoryBrand:output_type -> service.goods.api.goods.v1.Empty
-	24, // [24:48] is the sub-list for method output_type
-	0,  // [0:24] is the sub-list for method input_type
+	0,  // 1: service.goods.api.goods.v1.Goods.AdminGoodsList:input_type -> service.goods.api.goods.v1.GoodsFilterRequest
+	1,  // 2: service.goods.api.goods.v1.Goods.SuggestGoods:input_type -> service.goods.api.goods.v1.SuggestGoodsRequest
+	2,  // 3: service.goods.api.goods.v1.Goods.RecommendGoods:input_type -> service.goods.api.goods.v1.GoodsRecommendRequest
+	3,  // 4: service.goods.api.goods.v1.Goods.BatchGetGoods:input_type -> service.goods.api.goods.v1.BatchGoodsIdInfo
+	4,  // 5: service.goods.api.goods.v1.Goods.GetGoodsBySn:input_type -> service.goods.api.goods.v1.GoodsSnRequest
+	5,  // 6: service.goods.api.goods.v1.Goods.BatchResolveGoodsSn:input_type -> service.goods.api.goods.v1.BatchGoodsSnRequest
+	6,  // 7: service.goods.api.goods.v1.Goods.IncrGoodsCounters:input_type -> service.goods.api.goods.v1.IncrGoodsCountersRequest
+	3,  // 8: service.goods.api.goods.v1.Goods.GetGoodsCounters:input_type -> service.goods.api.goods.v1.BatchGoodsIdInfo
+	7,  // 9: service.goods.api.goods.v1.Goods.UpdateGoodsRating:input_type -> service.goods.api.goods.v1.GoodsRatingInfo
+	8,  // 10: service.goods.api.goods.v1.Goods.CreateGoods:input_type -> service.goods.api.goods.v1.CreateGoodsInfo
+	9,  // 11: service.goods.api.goods.v1.Goods.DeleteGoods:input_type -> service.goods.api.goods.v1.DeleteGoodsInfo
+	8,  // 12: service.goods.api.goods.v1.Goods.UpdateGoods:input_type -> service.goods.api.goods.v1.CreateGoodsInfo
+	10, // 13: service.goods.api.goods.v1.Goods.GetGoodsDetail:input_type -> service.goods.api.goods.v1.GoodInfoRequest
+	11, // 14: service.goods.api.goods.v1.Goods.ImportGoods:input_type -> service.goods.api.goods.v1.ImportGoodsRequest
+	11, // 15: service.goods.api.goods.v1.Goods.ImportGoodsStream:input_type -> service.goods.api.goods.v1.ImportGoodsRequest
+	12, // 16: service.goods.api.goods.v1.Goods.ExportGoods:input_type -> service.goods.api.goods.v1.ExportGoodsRequest
+	13, // 17: service.goods.api.goods.v1.Goods.UpdateGoodsStatus:input_type -> service.goods.api.goods.v1.GoodsStatusRequest
+	14, // 18: service.goods.api.goods.v1.Goods.ScheduleGoodsSale:input_type -> service.goods.api.goods.v1.GoodsScheduleRequest
+	10, // 19: service.goods.api.goods.v1.Goods.GoodsStatusLogs:input_type -> service.goods.api.goods.v1.GoodInfoRequest
+	15, // 20: service.goods.api.goods.v1.Goods.GoodsPriceHistory:input_type -> service.goods.api.goods.v1.GoodsPriceHistoryRequest
+	16, // 21: service.goods.api.goods.v1.Goods.GoodsDescVersions:input_type -> service.goods.api.goods.v1.GoodsDescVersionListRequest
+	17, // 22: service.goods.api.goods.v1.Goods.GetGoodsDescVersion:input_type -> service.goods.api.goods.v1.GoodsDescVersionRequest
+	17, // 23: service.goods.api.goods.v1.Goods.RollbackGoodsDesc:input_type -> service.goods.api.goods.v1.GoodsDescVersionRequest
+	10, // 24: service.goods.api.goods.v1.Goods.GoodsSkuList:input_type -> service.goods.api.goods.v1.GoodInfoRequest
+	18, // 25: service.goods.api.goods.v1.Goods.BatchGetSkus:input_type -> service.goods.api.goods.v1.BatchSkuIdInfo
+	19, // 26: service.goods.api.goods.v1.Goods.CreateGoodsSku:input_type -> service.goods.api.goods.v1.GoodsSkuInfo
+	19, // 27: service.goods.api.goods.v1.Goods.UpdateGoodsSku:input_type -> service.goods.api.goods.v1.GoodsSkuInfo
+	19, // 28: service.goods.api.goods.v1.Goods.DeleteGoodsSku:input_type -> service.goods.api.goods.v1.GoodsSkuInfo
+	20, // 29: service.goods.api.goods.v1.Goods.ReindexGoods:input_type -> service.goods.api.goods.v1.ReindexGoodsRequest
+	21, // 30: service.goods.api.goods.v1.Goods.GetReindexStatus:input_type -> service.goods.api.goods.v1.Empty
+	21, // 31: service.goods.api.goods.v1.Goods.RollbackGoodsIndex:input_type -> service.goods.api.goods.v1.Empty
+	21, // 32: service.goods.api.goods.v1.Goods.GetGoodsSynonyms:input_type -> service.goods.api.goods.v1.Empty
+	22, // 33: service.goods.api.goods.v1.Goods.UpdateGoodsSynonyms:input_type -> service.goods.api.goods.v1.GoodsSynonymsRequest
+	23, // 34: service.goods.api.goods.v1.Goods.HotKeywords:input_type -> service.goods.api.goods.v1.SearchKeywordsRequest
+	23, // 35: service.goods.api.goods.v1.Goods.ZeroResultKeywords:input_type -> service.goods.api.goods.v1.SearchKeywordsRequest
+	21, // 36: service.goods.api.goods.v1.Goods.GetHotKeywordBlocklist:input_type -> service.goods.api.goods.v1.Empty
+	24, // 37: service.goods.api.goods.v1.Goods.UpdateHotKeywordBlocklist:input_type -> service.goods.api.goods.v1.HotKeywordBlocklist
+	21, // 38: service.goods.api.goods.v1.Goods.GetAllCategorysList:input_type -> service.goods.api.goods.v1.Empty
+	25, // 39: service.goods.api.goods.v1.Goods.GetSubCategory:input_type -> service.goods.api.goods.v1.CategoryListRequest
+	26, // 40: service.goods.api.goods.v1.Goods.CreateCategory:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
+	27, // 41: service.goods.api.goods.v1.Goods.DeleteCategory:input_type -> service.goods.api.goods.v1.DeleteCategoryRequest
+	26, // 42: service.goods.api.goods.v1.Goods.UpdateCategory:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
+	25, // 43: service.goods.api.goods.v1.Goods.CategoryAttributeList:input_type -> service.goods.api.goods.v1.CategoryListRequest
+	28, // 44: service.goods.api.goods.v1.Goods.CreateCategoryAttribute:input_type -> service.goods.api.goods.v1.CategoryAttributeInfo
+	28, // 45: service.goods.api.goods.v1.Goods.UpdateCategoryAttribute:input_type -> service.goods.api.goods.v1.CategoryAttributeInfo
+	28, // 46: service.goods.api.goods.v1.Goods.DeleteCategoryAttribute:input_type -> service.goods.api.goods.v1.CategoryAttributeInfo
+	29, // 47: service.goods.api.goods.v1.Goods.BrandList:input_type -> service.goods.api.goods.v1.BrandFilterRequest
+	30, // 48: service.goods.api.goods.v1.Goods.CreateBrand:input_type -> service.goods.api.goods.v1.BrandRequest
+	30, // 49: service.goods.api.goods.v1.Goods.DeleteBrand:input_type -> service.goods.api.goods.v1.BrandRequest
+	30, // 50: service.goods.api.goods.v1.Goods.UpdateBrand:input_type -> service.goods.api.goods.v1.BrandRequest
+	21, // 51: service.goods.api.goods.v1.Goods.BannerList:input_type -> service.goods.api.goods.v1.Empty
+	31, // 52: service.goods.api.goods.v1.Goods.CreateBanner:input_type -> service.goods.api.goods.v1.BannerRequest
+	31, // 53: service.goods.api.goods.v1.Goods.DeleteBanner:input_type -> service.goods.api.goods.v1.BannerRequest
+	31, // 54: service.goods.api.goods.v1.Goods.UpdateBanner:input_type -> service.goods.api.goods.v1.BannerRequest
+	32, // 55: service.goods.api.goods.v1.Goods.CategoryBrandList:input_type -> service.goods.api.goods.v1.CategoryBrandFilterRequest
+	26, // 56: service.goods.api.goods.v1.Goods.GetCategoryBrandList:input_type -> service.goods.api.goods.v1.CategoryInfoRequest
+	33, // 57: service.goods.api.goods.v1.Goods.CreateCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
+	33, // 58: service.goods.api.goods.v1.Goods.DeleteCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
+	33, // 59: service.goods.api.goods.v1.Goods.UpdateCategoryBrand:input_type -> service.goods.api.goods.v1.CategoryBrandRequest
+	34, // 60: service.goods.api.goods.v1.Goods.GoodsList:output_type -> service.goods.api.goods.v1.GoodsListResponse
+	34, // 61: service.goods.api.goods.v1.Goods.AdminGoodsList:output_type -> service.goods.api.goods.v1.GoodsListResponse
+	35, // 62: service.goods.api.goods.v1.Goods.SuggestGoods:output_type -> service.goods.api.goods.v1.SuggestGoodsResponse
+	36, // 63: service.goods.api.goods.v1.Goods.RecommendGoods:output_type -> service.goods.api.goods.v1.GoodsRecommendResponse
+	34, // 64: service.goods.api.goods.v1.Goods.BatchGetGoods:output_type -> service.goods.api.goods.v1.GoodsListResponse
+	37, // 65: service.goods.api.goods.v1.Goods.GetGoodsBySn:output_type -> service.goods.api.goods.v1.GoodsInfoResponse
+	38, // 66: service.goods.api.goods.v1.Goods.BatchResolveGoodsSn:output_type -> service.goods.api.goods.v1.BatchGoodsSnResponse
+	21, // 67: service.goods.api.goods.v1.Goods.IncrGoodsCounters:output_type -> service.goods.api.goods.v1.Empty
+	39, // 68: service.goods.api.goods.v1.Goods.GetGoodsCounters:output_type -> service.goods.api.goods.v1.GoodsCountersResponse
+	21, // 69: service.goods.api.goods.v1.Goods.UpdateGoodsRating:output_type -> service.goods.api.goods.v1.Empty
+	37, // 70: service.goods.api.goods.v1.Goods.CreateGoods:output_type -> service.goods.api.goods.v1.GoodsInfoResponse
+	21, // 71: service.goods.api.goods.v1.Goods.DeleteGoods:output_type -> service.goods.api.goods.v1.Empty
+	21, // 72: service.goods.api.goods.v1.Goods.UpdateGoods:output_type -> service.goods.api.goods.v1.Empty
+	37, // 73: service.goods.api.goods.v1.Goods.GetGoodsDetail:output_type -> service.goods.api.goods.v1.GoodsInfoResponse
+	40, // 74: service.goods.api.goods.v1.Goods.ImportGoods:output_type -> service.goods.api.goods.v1.ImportGoodsResponse
+	40, // 75: service.goods.api.goods.v1.Goods.ImportGoodsStream:output_type -> service.goods.api.goods.v1.ImportGoodsResponse
+	41, // 76: service.goods.api.goods.v1.Goods.ExportGoods:output_type -> service.goods.api.goods.v1.ExportGoodsChunk
+	42, // 77: service.goods.api.goods.v1.Goods.UpdateGoodsStatus:output_type -> service.goods.api.goods.v1.GoodsStatusResponse
+	42, // 78: service.goods.api.goods.v1.Goods.ScheduleGoodsSale:output_type -> service.goods.api.goods.v1.GoodsStatusResponse
+	43, // 79: service.goods.api.goods.v1.Goods.GoodsStatusLogs:output_type -> service.goods.api.goods.v1.GoodsStatusLogListResponse
+	44, // 80: service.goods.api.goods.v1.Goods.GoodsPriceHistory:output_type -> service.goods.api.goods.v1.GoodsPriceHistoryResponse
+	45, // 81: service.goods.api.goods.v1.Goods.GoodsDescVersions:output_type -> service.goods.api.goods.v1.GoodsDescVersionListResponse
+	46, // 82: service.goods.api.goods.v1.Goods.GetGoodsDescVersion:output_type -> service.goods.api.goods.v1.GoodsDescVersionInfo
+	46, // 83: service.goods.api.goods.v1.Goods.RollbackGoodsDesc:output_type -> service.goods.api.goods.v1.GoodsDescVersionInfo
+	47, // 84: service.goods.api.goods.v1.Goods.GoodsSkuList:output_type -> service.goods.api.goods.v1.GoodsSkuListResponse
+	47, // 85: service.goods.api.goods.v1.Goods.BatchGetSkus:output_type -> service.goods.api.goods.v1.GoodsSkuListResponse
+	19, // 86: service.goods.api.goods.v1.Goods.CreateGoodsSku:output_type -> service.goods.api.goods.v1.GoodsSkuInfo
+	21, // 87: service.goods.api.goods.v1.Goods.UpdateGoodsSku:output_type -> service.goods.api.goods.v1.Empty
+	21, // 88: service.goods.api.goods.v1.Goods.DeleteGoodsSku:output_type -> service.goods.api.goods.v1.Empty
+	48, // 89: service.goods.api.goods.v1.Goods.ReindexGoods:output_type -> service.goods.api.goods.v1.ReindexStatusResponse
+	48, // 90: service.goods.api.goods.v1.Goods.GetReindexStatus:output_type -> service.goods.api.goods.v1.ReindexStatusResponse
+	49, // 91: service.goods.api.goods.v1.Goods.RollbackGoodsIndex:output_type -> service.goods.api.goods.v1.GoodsIndexResponse
+	50, // 92: service.goods.api.goods.v1.Goods.GetGoodsSynonyms:output_type -> service.goods.api.goods.v1.GoodsSynonymsResponse
+	50, // 93: service.goods.api.goods.v1.Goods.UpdateGoodsSynonyms:output_type -> service.goods.api.goods.v1.GoodsSynonymsResponse
+	51, // 94: service.goods.api.goods.v1.Goods.HotKeywords:output_type -> service.goods.api.goods.v1.SearchKeywordsResponse
+	51, // 95: service.goods.api.goods.v1.Goods.ZeroResultKeywords:output_type -> service.goods.api.goods.v1.SearchKeywordsResponse
+	24, // 96: service.goods.api.goods.v1.Goods.GetHotKeywordBlocklist:output_type -> service.goods.api.goods.v1.HotKeywordBlocklist
+	24, // 97: service.goods.api.goods.v1.Goods.UpdateHotKeywordBlocklist:output_type -> service.goods.api.goods.v1.HotKeywordBlocklist
+	52, // 98: service.goods.api.goods.v1.Goods.GetAllCategorysList:output_type -> service.goods.api.goods.v1.CategoryListResponse
+	53, // 99: service.goods.api.goods.v1.Goods.GetSubCategory:output_type -> service.goods.api.goods.v1.SubCategoryListResponse
+	54, // 100: service.goods.api.goods.v1.Goods.CreateCategory:output_type -> service.goods.api.goods.v1.CategoryInfoResponse
+	21, // 101: service.goods.api.goods.v1.Goods.DeleteCategory:output_type -> service.goods.api.goods.v1.Empty
+	21, // 102: service.goods.api.goods.v1.Goods.UpdateCategory:output_type -> service.goods.api.goods.v1.Empty
+	55, // 103: service.goods.api.goods.v1.Goods.CategoryAttributeList:output_type -> service.goods.api.goods.v1.CategoryAttributeListResponse
+	28, // 104: service.goods.api.goods.v1.Goods.CreateCategoryAttribute:output_type -> service.goods.api.goods.v1.CategoryAttributeInfo
+	21, // 105: service.goods.api.goods.v1.Goods.UpdateCategoryAttribute:output_type -> service.goods.api.goods.v1.Empty
+	21, // 106: service.goods.api.goods.v1.Goods.DeleteCategoryAttribute:output_type -> service.goods.api.goods.v1.Empty
+	56, // 107: service.goods.api.goods.v1.Goods.BrandList:output_type -> service.goods.api.goods.v1.BrandListResponse
+	57, // 108: service.goods.api.goods.v1.Goods.CreateBrand:output_type -> service.goods.api.goods.v1.BrandInfoResponse
+	21, // 109: service.goods.api.goods.v1.Goods.DeleteBrand:output_type -> service.goods.api.goods.v1.Empty
+	21, // 110: service.goods.api.goods.v1.Goods.UpdateBrand:output_type -> service.goods.api.goods.v1.Empty
+	58, // 111: service.goods.api.goods.v1.Goods.BannerList:output_type -> service.goods.api.goods.v1.BannerListResponse
+	59, // 112: service.goods.api.goods.v1.Goods.CreateBanner:output_type -> service.goods.api.goods.v1.BannerResponse
+	21, // 113: service.goods.api.goods.v1.Goods.DeleteBanner:output_type -> service.goods.api.goods.v1.Empty
+	21, // 114: service.goods.api.goods.v1.Goods.UpdateBanner:output_type -> service.goods.api.goods.v1.Empty
+	60, // 115: service.goods.api.goods.v1.Goods.CategoryBrandList:output_type -> service.goods.api.goods.v1.CategoryBrandListResponse
+	56, // 116: service.goods.api.goods.v1.Goods.GetCategoryBrandList:output_type -> service.goods.api.goods.v1.BrandListResponse
+	61, // 117: service.goods.api.goods.v1.Goods.CreateCategoryBrand:output_type -> service.goods.api.goods.v1.CategoryBrandResponse
+	21, // 118: service.goods.api.goods.v1.Goods.DeleteCategoryBrand:output_type -> service.goods.api.goods.v1.Empty
+	21, // 119: service.goods.api.goods.v1.Goods.UpdateCategoryBrand:output_type -> service.goods.api.goods.v1.Empty
+	60, // [60:120] is the sub-list for method output_type
+	0,  // [0:60] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
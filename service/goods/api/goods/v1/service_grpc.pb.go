@@ -19,30 +19,66 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Goods_GoodsList_FullMethodName            = "/service.goods.api.goods.v1.Goods/GoodsList"
-	Goods_BatchGetGoods_FullMethodName        = "/service.goods.api.goods.v1.Goods/BatchGetGoods"
-	Goods_CreateGoods_FullMethodName          = "/service.goods.api.goods.v1.Goods/CreateGoods"
-	Goods_DeleteGoods_FullMethodName          = "/service.goods.api.goods.v1.Goods/DeleteGoods"
-	Goods_UpdateGoods_FullMethodName          = "/service.goods.api.goods.v1.Goods/UpdateGoods"
-	Goods_GetGoodsDetail_FullMethodName       = "/service.goods.api.goods.v1.Goods/GetGoodsDetail"
-	Goods_GetAllCategorysList_FullMethodName  = "/service.goods.api.goods.v1.Goods/GetAllCategorysList"
-	Goods_GetSubCategory_FullMethodName       = "/service.goods.api.goods.v1.Goods/GetSubCategory"
-	Goods_CreateCategory_FullMethodName       = "/service.goods.api.goods.v1.Goods/CreateCategory"
-	Goods_DeleteCategory_FullMethodName       = "/service.goods.api.goods.v1.Goods/DeleteCategory"
-	Goods_UpdateCategory_FullMethodName       = "/service.goods.api.goods.v1.Goods/UpdateCategory"
-	Goods_BrandList_FullMethodName            = "/service.goods.api.goods.v1.Goods/BrandList"
-	Goods_CreateBrand_FullMethodName          = "/service.goods.api.goods.v1.Goods/CreateBrand"
-	Goods_DeleteBrand_FullMethodName          = "/service.goods.api.goods.v1.Goods/DeleteBrand"
-	Goods_UpdateBrand_FullMethodName          = "/service.goods.api.goods.v1.Goods/UpdateBrand"
-	Goods_BannerList_FullMethodName           = "/service.goods.api.goods.v1.Goods/BannerList"
-	Goods_CreateBanner_FullMethodName         = "/service.goods.api.goods.v1.Goods/CreateBanner"
-	Goods_DeleteBanner_FullMethodName         = "/service.goods.api.goods.v1.Goods/DeleteBanner"
-	Goods_UpdateBanner_FullMethodName         = "/service.goods.api.goods.v1.Goods/UpdateBanner"
-	Goods_CategoryBrandList_FullMethodName    = "/service.goods.api.goods.v1.Goods/CategoryBrandList"
-	Goods_GetCategoryBrandList_FullMethodName = "/service.goods.api.goods.v1.Goods/GetCategoryBrandList"
-	Goods_CreateCategoryBrand_FullMethodName  = "/service.goods.api.goods.v1.Goods/CreateCategoryBrand"
-	Goods_DeleteCategoryBrand_FullMethodName  = "/service.goods.api.goods.v1.Goods/DeleteCategoryBrand"
-	Goods_UpdateCategoryBrand_FullMethodName  = "/service.goods.api.goods.v1.Goods/UpdateCategoryBrand"
+	Goods_GoodsList_FullMethodName                 = "/service.goods.api.goods.v1.Goods/GoodsList"
+	Goods_AdminGoodsList_FullMethodName            = "/service.goods.api.goods.v1.Goods/AdminGoodsList"
+	Goods_SuggestGoods_FullMethodName              = "/service.goods.api.goods.v1.Goods/SuggestGoods"
+	Goods_RecommendGoods_FullMethodName            = "/service.goods.api.goods.v1.Goods/RecommendGoods"
+	Goods_BatchGetGoods_FullMethodName             = "/service.goods.api.goods.v1.Goods/BatchGetGoods"
+	Goods_GetGoodsBySn_FullMethodName              = "/service.goods.api.goods.v1.Goods/GetGoodsBySn"
+	Goods_BatchResolveGoodsSn_FullMethodName       = "/service.goods.api.goods.v1.Goods/BatchResolveGoodsSn"
+	Goods_IncrGoodsCounters_FullMethodName         = "/service.goods.api.goods.v1.Goods/IncrGoodsCounters"
+	Goods_GetGoodsCounters_FullMethodName          = "/service.goods.api.goods.v1.Goods/GetGoodsCounters"
+	Goods_UpdateGoodsRating_FullMethodName         = "/service.goods.api.goods.v1.Goods/UpdateGoodsRating"
+	Goods_CreateGoods_FullMethodName               = "/service.goods.api.goods.v1.Goods/CreateGoods"
+	Goods_DeleteGoods_FullMethodName               = "/service.goods.api.goods.v1.Goods/DeleteGoods"
+	Goods_UpdateGoods_FullMethodName               = "/service.goods.api.goods.v1.Goods/UpdateGoods"
+	Goods_GetGoodsDetail_FullMethodName            = "/service.goods.api.goods.v1.Goods/GetGoodsDetail"
+	Goods_ImportGoods_FullMethodName               = "/service.goods.api.goods.v1.Goods/ImportGoods"
+	Goods_ImportGoodsStream_FullMethodName         = "/service.goods.api.goods.v1.Goods/ImportGoodsStream"
+	Goods_ExportGoods_FullMethodName               = "/service.goods.api.goods.v1.Goods/ExportGoods"
+	Goods_UpdateGoodsStatus_FullMethodName         = "/service.goods.api.goods.v1.Goods/UpdateGoodsStatus"
+	Goods_ScheduleGoodsSale_FullMethodName         = "/service.goods.api.goods.v1.Goods/ScheduleGoodsSale"
+	Goods_GoodsStatusLogs_FullMethodName           = "/service.goods.api.goods.v1.Goods/GoodsStatusLogs"
+	Goods_GoodsPriceHistory_FullMethodName         = "/service.goods.api.goods.v1.Goods/GoodsPriceHistory"
+	Goods_GoodsDescVersions_FullMethodName         = "/service.goods.api.goods.v1.Goods/GoodsDescVersions"
+	Goods_GetGoodsDescVersion_FullMethodName       = "/service.goods.api.goods.v1.Goods/GetGoodsDescVersion"
+	Goods_RollbackGoodsDesc_FullMethodName         = "/service.goods.api.goods.v1.Goods/RollbackGoodsDesc"
+	Goods_GoodsSkuList_FullMethodName              = "/service.goods.api.goods.v1.Goods/GoodsSkuList"
+	Goods_BatchGetSkus_FullMethodName              = "/service.goods.api.goods.v1.Goods/BatchGetSkus"
+	Goods_CreateGoodsSku_FullMethodName            = "/service.goods.api.goods.v1.Goods/CreateGoodsSku"
+	Goods_UpdateGoodsSku_FullMethodName            = "/service.goods.api.goods.v1.Goods/UpdateGoodsSku"
+	Goods_DeleteGoodsSku_FullMethodName            = "/service.goods.api.goods.v1.Goods/DeleteGoodsSku"
+	Goods_ReindexGoods_FullMethodName              = "/service.goods.api.goods.v1.Goods/ReindexGoods"
+	Goods_GetReindexStatus_FullMethodName          = "/service.goods.api.goods.v1.Goods/GetReindexStatus"
+	Goods_RollbackGoodsIndex_FullMethodName        = "/service.goods.api.goods.v1.Goods/RollbackGoodsIndex"
+	Goods_GetGoodsSynonyms_FullMethodName          = "/service.goods.api.goods.v1.Goods/GetGoodsSynonyms"
+	Goods_UpdateGoodsSynonyms_FullMethodName       = "/service.goods.api.goods.v1.Goods/UpdateGoodsSynonyms"
+	Goods_HotKeywords_FullMethodName               = "/service.goods.api.goods.v1.Goods/HotKeywords"
+	Goods_ZeroResultKeywords_FullMethodName        = "/service.goods.api.goods.v1.Goods/ZeroResultKeywords"
+	Goods_GetHotKeywordBlocklist_FullMethodName    = "/service.goods.api.goods.v1.Goods/GetHotKeywordBlocklist"
+	Goods_UpdateHotKeywordBlocklist_FullMethodName = "/service.goods.api.goods.v1.Goods/UpdateHotKeywordBlocklist"
+	Goods_GetAllCategorysList_FullMethodName       = "/service.goods.api.goods.v1.Goods/GetAllCategorysList"
+	Goods_GetSubCategory_FullMethodName            = "/service.goods.api.goods.v1.Goods/GetSubCategory"
+	Goods_CreateCategory_FullMethodName            = "/service.goods.api.goods.v1.Goods/CreateCategory"
+	Goods_DeleteCategory_FullMethodName            = "/service.goods.api.goods.v1.Goods/DeleteCategory"
+	Goods_UpdateCategory_FullMethodName            = "/service.goods.api.goods.v1.Goods/UpdateCategory"
+	Goods_CategoryAttributeList_FullMethodName     = "/service.goods.api.goods.v1.Goods/CategoryAttributeList"
+	Goods_CreateCategoryAttribute_FullMethodName   = "/service.goods.api.goods.v1.Goods/CreateCategoryAttribute"
+	Goods_UpdateCategoryAttribute_FullMethodName   = "/service.goods.api.goods.v1.Goods/UpdateCategoryAttribute"
+	Goods_DeleteCategoryAttribute_FullMethodName   = "/service.goods.api.goods.v1.Goods/DeleteCategoryAttribute"
+	Goods_BrandList_FullMethodName                 = "/service.goods.api.goods.v1.Goods/BrandList"
+	Goods_CreateBrand_FullMethodName               = "/service.goods.api.goods.v1.Goods/CreateBrand"
+	Goods_DeleteBrand_FullMethodName               = "/service.goods.api.goods.v1.Goods/DeleteBrand"
+	Goods_UpdateBrand_FullMethodName               = "/service.goods.api.goods.v1.Goods/UpdateBrand"
+	Goods_BannerList_FullMethodName                = "/service.goods.api.goods.v1.Goods/BannerList"
+	Goods_CreateBanner_FullMethodName              = "/service.goods.api.goods.v1.Goods/CreateBanner"
+	Goods_DeleteBanner_FullMethodName              = "/service.goods.api.goods.v1.Goods/DeleteBanner"
+	Goods_UpdateBanner_FullMethodName              = "/service.goods.api.goods.v1.Goods/UpdateBanner"
+	Goods_CategoryBrandList_FullMethodName         = "/service.goods.api.goods.v1.Goods/CategoryBrandList"
+	Goods_GetCategoryBrandList_FullMethodName      = "/service.goods.api.goods.v1.Goods/GetCategoryBrandList"
+	Goods_CreateCategoryBrand_FullMethodName       = "/service.goods.api.goods.v1.Goods/CreateCategoryBrand"
+	Goods_DeleteCategoryBrand_FullMethodName       = "/service.goods.api.goods.v1.Goods/DeleteCategoryBrand"
+	Goods_UpdateCategoryBrand_FullMethodName       = "/service.goods.api.goods.v1.Goods/UpdateCategoryBrand"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -54,8 +90,24 @@ const (
 type GoodsClient interface {
 	// 获取商品列表
 	GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	// 管理后台商品列表 - 与商品列表相同，设置 includeOffSale 时包含未上架的商品
+	AdminGoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	// 搜索联想 - 输入时返回商品名称补全以及匹配的分类、品牌
+	SuggestGoods(ctx context.Context, in *SuggestGoodsRequest, opts ...grpc.CallOption) (*SuggestGoodsResponse, error)
+	// 商品推荐：经常一起购买的在售商品，数据不足时补充同类热销商品
+	RecommendGoods(ctx context.Context, in *GoodsRecommendRequest, opts ...grpc.CallOption) (*GoodsRecommendResponse, error)
 	// 批量获取商品信息 - 用于订单提交时批量查询商品信息
 	BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	// 按商品编号获取商品详情 - 用于仓储、供应商系统对接
+	GetGoodsBySn(ctx context.Context, in *GoodsSnRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
+	// 批量将商品编号解析为商品ID
+	BatchResolveGoodsSn(ctx context.Context, in *BatchGoodsSnRequest, opts ...grpc.CallOption) (*BatchGoodsSnResponse, error)
+	// 累加商品点击、收藏、销量计数，增量先缓存在 Redis 中定时写入数据库和搜索索引
+	IncrGoodsCounters(ctx context.Context, in *IncrGoodsCountersRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 批量获取商品实时计数，包含尚未写入数据库的增量
+	GetGoodsCounters(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsCountersResponse, error)
+	// 更新商品评分汇总，以传入的汇总值覆盖
+	UpdateGoodsRating(ctx context.Context, in *GoodsRatingInfo, opts ...grpc.CallOption) (*Empty, error)
 	// 创建商品
 	CreateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
 	// 删除商品
@@ -64,6 +116,56 @@ type GoodsClient interface {
 	UpdateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...grpc.CallOption) (*Empty, error)
 	// 获取商品详情
 	GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
+	// 从 CSV/XLSX 批量导入商品，支持试运行和按商品编号更新
+	ImportGoods(ctx context.Context, in *ImportGoodsRequest, opts ...grpc.CallOption) (*ImportGoodsResponse, error)
+	// 流式导入商品，用于大文件，首个消息携带导入选项，文件内容按顺序分片传入，仅 gRPC
+	ImportGoodsStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportGoodsRequest, ImportGoodsResponse], error)
+	// 按过滤条件导出商品，文件内容分片返回，仅 gRPC；HTTP 下载地址为 GET /v1/goods/export
+	ExportGoods(ctx context.Context, in *ExportGoodsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGoodsChunk], error)
+	// 商品状态流转：提交审核、审核通过或驳回、上架、下架、归档
+	UpdateGoodsStatus(ctx context.Context, in *GoodsStatusRequest, opts ...grpc.CallOption) (*GoodsStatusResponse, error)
+	// 设置计划上下架时间，由后台定时任务执行
+	ScheduleGoodsSale(ctx context.Context, in *GoodsScheduleRequest, opts ...grpc.CallOption) (*GoodsStatusResponse, error)
+	// 获取商品状态流转记录
+	GoodsStatusLogs(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsStatusLogListResponse, error)
+	// 获取商品价格变动记录，按时间倒序
+	GoodsPriceHistory(ctx context.Context, in *GoodsPriceHistoryRequest, opts ...grpc.CallOption) (*GoodsPriceHistoryResponse, error)
+	// 获取商品描述的历史版本，按版本倒序，不含描述内容
+	GoodsDescVersions(ctx context.Context, in *GoodsDescVersionListRequest, opts ...grpc.CallOption) (*GoodsDescVersionListResponse, error)
+	// 获取商品描述的指定版本，含描述内容
+	GetGoodsDescVersion(ctx context.Context, in *GoodsDescVersionRequest, opts ...grpc.CallOption) (*GoodsDescVersionInfo, error)
+	// 将商品描述回滚到指定版本，以该版本内容生成新版本
+	RollbackGoodsDesc(ctx context.Context, in *GoodsDescVersionRequest, opts ...grpc.CallOption) (*GoodsDescVersionInfo, error)
+	// 获取商品的 SKU 列表
+	GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsSkuListResponse, error)
+	// 批量获取 SKU 信息 - 用于购物车、订单按 SKU 查询价格
+	BatchGetSkus(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*GoodsSkuListResponse, error)
+	// 创建 SKU
+	CreateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*GoodsSkuInfo, error)
+	// 更新 SKU
+	UpdateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*Empty, error)
+	// 删除 SKU
+	DeleteGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*Empty, error)
+	// 全量重建商品索引
+	// 从 MySQL 分批写入新的版本索引（goods_v2...），完成后原子切换 goods 别名，旧索引保留用于回滚
+	ReindexGoods(ctx context.Context, in *ReindexGoodsRequest, opts ...grpc.CallOption) (*ReindexStatusResponse, error)
+	// 查询索引重建进度
+	GetReindexStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReindexStatusResponse, error)
+	// 回滚商品索引
+	// 将 goods 别名切回上一个版本索引
+	RollbackGoodsIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GoodsIndexResponse, error)
+	// 获取商品搜索同义词词典
+	GetGoodsSynonyms(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GoodsSynonymsResponse, error)
+	// 整体替换商品搜索同义词词典并重新加载搜索分析器
+	UpdateGoodsSynonyms(ctx context.Context, in *GoodsSynonymsRequest, opts ...grpc.CallOption) (*GoodsSynonymsResponse, error)
+	// 获取热搜关键词 - 用于商城首页
+	HotKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...grpc.CallOption) (*SearchKeywordsResponse, error)
+	// 获取无结果搜索词 - 用于运营补充商品和同义词
+	ZeroResultKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...grpc.CallOption) (*SearchKeywordsResponse, error)
+	// 获取热搜屏蔽词
+	GetHotKeywordBlocklist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HotKeywordBlocklist, error)
+	// 整体替换热搜屏蔽词
+	UpdateHotKeywordBlocklist(ctx context.Context, in *HotKeywordBlocklist, opts ...grpc.CallOption) (*HotKeywordBlocklist, error)
 	// 获取所有分类列表
 	GetAllCategorysList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CategoryListResponse, error)
 	// 获取子分类
@@ -74,6 +176,14 @@ type GoodsClient interface {
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error)
 	// 更新分类信息
 	UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 获取分类属性模板，包含从上级分类继承的属性
+	CategoryAttributeList(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*CategoryAttributeListResponse, error)
+	// 创建分类属性模板
+	CreateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*CategoryAttributeInfo, error)
+	// 更新分类属性模板，名称和类型创建后不可修改
+	UpdateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*Empty, error)
+	// 删除分类属性模板，同时清除商品上的该属性值
+	DeleteCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*Empty, error)
 	// 获取品牌列表
 	BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error)
 	// 创建品牌
@@ -120,6 +230,36 @@ func (c *goodsClient) GoodsList(ctx context.Context, in *GoodsFilterRequest, opt
 	return out, nil
 }
 
+func (c *goodsClient) AdminGoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsListResponse)
+	err := c.cc.Invoke(ctx, Goods_AdminGoodsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SuggestGoods(ctx context.Context, in *SuggestGoodsRequest, opts ...grpc.CallOption) (*SuggestGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestGoodsResponse)
+	err := c.cc.Invoke(ctx, Goods_SuggestGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) RecommendGoods(ctx context.Context, in *GoodsRecommendRequest, opts ...grpc.CallOption) (*GoodsRecommendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsRecommendResponse)
+	err := c.cc.Invoke(ctx, Goods_RecommendGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GoodsListResponse)
@@ -130,6 +270,56 @@ func (c *goodsClient) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, o
 	return out, nil
 }
 
+func (c *goodsClient) GetGoodsBySn(ctx context.Context, in *GoodsSnRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsBySn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BatchResolveGoodsSn(ctx context.Context, in *BatchGoodsSnRequest, opts ...grpc.CallOption) (*BatchGoodsSnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGoodsSnResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchResolveGoodsSn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) IncrGoodsCounters(ctx context.Context, in *IncrGoodsCountersRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_IncrGoodsCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetGoodsCounters(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsCountersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsCountersResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateGoodsRating(ctx context.Context, in *GoodsRatingInfo, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) CreateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...grpc.CallOption) (*GoodsInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GoodsInfoResponse)
@@ -170,448 +360,1480 @@ func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, o
 	return out, nil
 }
 
-func (c *goodsClient) GetAllCategorysList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CategoryListResponse, error) {
+func (c *goodsClient) ImportGoods(ctx context.Context, in *ImportGoodsRequest, opts ...grpc.CallOption) (*ImportGoodsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryListResponse)
-	err := c.cc.Invoke(ctx, Goods_GetAllCategorysList_FullMethodName, in, out, cOpts...)
+	out := new(ImportGoodsResponse)
+	err := c.cc.Invoke(ctx, Goods_ImportGoods_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*SubCategoryListResponse, error) {
+func (c *goodsClient) ImportGoodsStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportGoodsRequest, ImportGoodsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SubCategoryListResponse)
-	err := c.cc.Invoke(ctx, Goods_GetSubCategory_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Goods_ServiceDesc.Streams[0], Goods_ImportGoodsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportGoodsRequest, ImportGoodsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Goods_ImportGoodsStreamClient = grpc.ClientStreamingClient[ImportGoodsRequest, ImportGoodsResponse]
+
+func (c *goodsClient) ExportGoods(ctx context.Context, in *ExportGoodsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGoodsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Goods_ServiceDesc.Streams[1], Goods_ExportGoods_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportGoodsRequest, ExportGoodsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Goods_ExportGoodsClient = grpc.ServerStreamingClient[ExportGoodsChunk]
+
+func (c *goodsClient) UpdateGoodsStatus(ctx context.Context, in *GoodsStatusRequest, opts ...grpc.CallOption) (*GoodsStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsStatusResponse)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error) {
+func (c *goodsClient) ScheduleGoodsSale(ctx context.Context, in *GoodsScheduleRequest, opts ...grpc.CallOption) (*GoodsStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryInfoResponse)
-	err := c.cc.Invoke(ctx, Goods_CreateCategory_FullMethodName, in, out, cOpts...)
+	out := new(GoodsStatusResponse)
+	err := c.cc.Invoke(ctx, Goods_ScheduleGoodsSale_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) GoodsStatusLogs(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsStatusLogListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_DeleteCategory_FullMethodName, in, out, cOpts...)
+	out := new(GoodsStatusLogListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsStatusLogs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) GoodsPriceHistory(ctx context.Context, in *GoodsPriceHistoryRequest, opts ...grpc.CallOption) (*GoodsPriceHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_UpdateCategory_FullMethodName, in, out, cOpts...)
+	out := new(GoodsPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsPriceHistory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
+func (c *goodsClient) GoodsDescVersions(ctx context.Context, in *GoodsDescVersionListRequest, opts ...grpc.CallOption) (*GoodsDescVersionListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BrandListResponse)
-	err := c.cc.Invoke(ctx, Goods_BrandList_FullMethodName, in, out, cOpts...)
+	out := new(GoodsDescVersionListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsDescVersions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error) {
+func (c *goodsClient) GetGoodsDescVersion(ctx context.Context, in *GoodsDescVersionRequest, opts ...grpc.CallOption) (*GoodsDescVersionInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BrandInfoResponse)
-	err := c.cc.Invoke(ctx, Goods_CreateBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsDescVersionInfo)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsDescVersion_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) RollbackGoodsDesc(ctx context.Context, in *GoodsDescVersionRequest, opts ...grpc.CallOption) (*GoodsDescVersionInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_DeleteBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsDescVersionInfo)
+	err := c.cc.Invoke(ctx, Goods_RollbackGoodsDesc_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) UpdateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsSkuListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_UpdateBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsSkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsSkuList_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) BannerList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BannerListResponse, error) {
+func (c *goodsClient) BatchGetSkus(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*GoodsSkuListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BannerListResponse)
-	err := c.cc.Invoke(ctx, Goods_BannerList_FullMethodName, in, out, cOpts...)
+	out := new(GoodsSkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchGetSkus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error) {
+func (c *goodsClient) CreateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*GoodsSkuInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BannerResponse)
-	err := c.cc.Invoke(ctx, Goods_CreateBanner_FullMethodName, in, out, cOpts...)
+	out := new(GoodsSkuInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateGoodsSku_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) DeleteBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) UpdateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_DeleteBanner_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsSku_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) DeleteGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_UpdateBanner_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Goods_DeleteGoodsSku_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...grpc.CallOption) (*CategoryBrandListResponse, error) {
+func (c *goodsClient) ReindexGoods(ctx context.Context, in *ReindexGoodsRequest, opts ...grpc.CallOption) (*ReindexStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryBrandListResponse)
-	err := c.cc.Invoke(ctx, Goods_CategoryBrandList_FullMethodName, in, out, cOpts...)
+	out := new(ReindexStatusResponse)
+	err := c.cc.Invoke(ctx, Goods_ReindexGoods_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) GetCategoryBrandList(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
+func (c *goodsClient) GetReindexStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReindexStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BrandListResponse)
-	err := c.cc.Invoke(ctx, Goods_GetCategoryBrandList_FullMethodName, in, out, cOpts...)
+	out := new(ReindexStatusResponse)
+	err := c.cc.Invoke(ctx, Goods_GetReindexStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*CategoryBrandResponse, error) {
+func (c *goodsClient) RollbackGoodsIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GoodsIndexResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryBrandResponse)
-	err := c.cc.Invoke(ctx, Goods_CreateCategoryBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsIndexResponse)
+	err := c.cc.Invoke(ctx, Goods_RollbackGoodsIndex_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) GetGoodsSynonyms(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GoodsSynonymsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_DeleteCategoryBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsSynonymsResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsSynonyms_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goodsClient) UpdateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *goodsClient) UpdateGoodsSynonyms(ctx context.Context, in *GoodsSynonymsRequest, opts ...grpc.CallOption) (*GoodsSynonymsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Goods_UpdateCategoryBrand_FullMethodName, in, out, cOpts...)
+	out := new(GoodsSynonymsResponse)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsSynonyms_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GoodsServer is the server API for Goods service.
-// All implementations must embed UnimplementedGoodsServer
-// for forward compatibility.
-//
-// Goods 服务定义
-// 提供商品、分类、品牌、轮播图等相关功能
-type GoodsServer interface {
-	// 获取商品列表
-	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
-	// 批量获取商品信息 - 用于订单提交时批量查询商品信息
-	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
-	// 创建商品
-	CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error)
-	// 删除商品
-	DeleteGoods(context.Context, *DeleteGoodsInfo) (*Empty, error)
-	// 更新商品信息
-	UpdateGoods(context.Context, *CreateGoodsInfo) (*Empty, error)
-	// 获取商品详情
-	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
-	// 获取所有分类列表
-	GetAllCategorysList(context.Context, *Empty) (*CategoryListResponse, error)
-	// 获取子分类
-	GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error)
-	// 创建分类
-	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
-	// 删除分类
-	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error)
-	// 更新分类信息
-	UpdateCategory(context.Context, *CategoryInfoRequest) (*Empty, error)
-	// 获取品牌列表
-	BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error)
-	// 创建品牌
-	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
-	// 删除品牌
-	DeleteBrand(context.Context, *BrandRequest) (*Empty, error)
-	// 更新品牌信息
-	UpdateBrand(context.Context, *BrandRequest) (*Empty, error)
-	// 获取轮播图列表
-	BannerList(context.Context, *Empty) (*BannerListResponse, error)
-	// 创建轮播图
-	CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error)
-	// 删除轮播图
-	DeleteBanner(context.Context, *BannerRequest) (*Empty, error)
-	// 更新轮播图
-	UpdateBanner(context.Context, *BannerRequest) (*Empty, error)
-	// 获取品牌分类关联列表
-	CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error)
-	// 通过分类获取品牌列表
-	GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error)
-	// 创建品牌分类关联
-	CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error)
-	// 删除品牌分类关联
-	DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
-	// 更新品牌分类关联
-	UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
-	mustEmbedUnimplementedGoodsServer()
+func (c *goodsClient) HotKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...grpc.CallOption) (*SearchKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchKeywordsResponse)
+	err := c.cc.Invoke(ctx, Goods_HotKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGoodsServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGoodsServer struct{}
-
-func (UnimplementedGoodsServer) GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GoodsList not implemented")
+func (c *goodsClient) ZeroResultKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...grpc.CallOption) (*SearchKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchKeywordsResponse)
+	err := c.cc.Invoke(ctx, Goods_ZeroResultKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGoods not implemented")
+
+func (c *goodsClient) GetHotKeywordBlocklist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HotKeywordBlocklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HotKeywordBlocklist)
+	err := c.cc.Invoke(ctx, Goods_GetHotKeywordBlocklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGoods not implemented")
+
+func (c *goodsClient) UpdateHotKeywordBlocklist(ctx context.Context, in *HotKeywordBlocklist, opts ...grpc.CallOption) (*HotKeywordBlocklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HotKeywordBlocklist)
+	err := c.cc.Invoke(ctx, Goods_UpdateHotKeywordBlocklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) DeleteGoods(context.Context, *DeleteGoodsInfo) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoods not implemented")
+
+func (c *goodsClient) GetAllCategorysList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CategoryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryListResponse)
+	err := c.cc.Invoke(ctx, Goods_GetAllCategorysList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) UpdateGoods(context.Context, *CreateGoodsInfo) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoods not implemented")
+
+func (c *goodsClient) GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*SubCategoryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubCategoryListResponse)
+	err := c.cc.Invoke(ctx, Goods_GetSubCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
+
+func (c *goodsClient) CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoodsServer) GetAllCategorysList(context.Context, *Empty) (*CategoryListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategorysList not implemented")
+
+func (c *goodsClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CategoryAttributeList(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*CategoryAttributeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributeListResponse)
+	err := c.cc.Invoke(ctx, Goods_CategoryAttributeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*CategoryAttributeInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributeInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandListResponse)
+	err := c.cc.Invoke(ctx, Goods_BrandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BannerList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BannerListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BannerListResponse)
+	err := c.cc.Invoke(ctx, Goods_BannerList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BannerResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...grpc.CallOption) (*CategoryBrandListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryBrandListResponse)
+	err := c.cc.Invoke(ctx, Goods_CategoryBrandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetCategoryBrandList(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandListResponse)
+	err := c.cc.Invoke(ctx, Goods_GetCategoryBrandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*CategoryBrandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryBrandResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateCategoryBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteCategoryBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategoryBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoodsServer is the server API for Goods service.
+// All implementations must embed UnimplementedGoodsServer
+// for forward compatibility.
+//
+// Goods 服务定义
+// 提供商品、分类、品牌、轮播图等相关功能
+type GoodsServer interface {
+	// 获取商品列表
+	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	// 管理后台商品列表 - 与商品列表相同，设置 includeOffSale 时包含未上架的商品
+	AdminGoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	// 搜索联想 - 输入时返回商品名称补全以及匹配的分类、品牌
+	SuggestGoods(context.Context, *SuggestGoodsRequest) (*SuggestGoodsResponse, error)
+	// 商品推荐：经常一起购买的在售商品，数据不足时补充同类热销商品
+	RecommendGoods(context.Context, *GoodsRecommendRequest) (*GoodsRecommendResponse, error)
+	// 批量获取商品信息 - 用于订单提交时批量查询商品信息
+	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
+	// 按商品编号获取商品详情 - 用于仓储、供应商系统对接
+	GetGoodsBySn(context.Context, *GoodsSnRequest) (*GoodsInfoResponse, error)
+	// 批量将商品编号解析为商品ID
+	BatchResolveGoodsSn(context.Context, *BatchGoodsSnRequest) (*BatchGoodsSnResponse, error)
+	// 累加商品点击、收藏、销量计数，增量先缓存在 Redis 中定时写入数据库和搜索索引
+	IncrGoodsCounters(context.Context, *IncrGoodsCountersRequest) (*Empty, error)
+	// 批量获取商品实时计数，包含尚未写入数据库的增量
+	GetGoodsCounters(context.Context, *BatchGoodsIdInfo) (*GoodsCountersResponse, error)
+	// 更新商品评分汇总，以传入的汇总值覆盖
+	UpdateGoodsRating(context.Context, *GoodsRatingInfo) (*Empty, error)
+	// 创建商品
+	CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error)
+	// 删除商品
+	DeleteGoods(context.Context, *DeleteGoodsInfo) (*Empty, error)
+	// 更新商品信息
+	UpdateGoods(context.Context, *CreateGoodsInfo) (*Empty, error)
+	// 获取商品详情
+	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
+	// 从 CSV/XLSX 批量导入商品，支持试运行和按商品编号更新
+	ImportGoods(context.Context, *ImportGoodsRequest) (*ImportGoodsResponse, error)
+	// 流式导入商品，用于大文件，首个消息携带导入选项，文件内容按顺序分片传入，仅 gRPC
+	ImportGoodsStream(grpc.ClientStreamingServer[ImportGoodsRequest, ImportGoodsResponse]) error
+	// 按过滤条件导出商品，文件内容分片返回，仅 gRPC；HTTP 下载地址为 GET /v1/goods/export
+	ExportGoods(*ExportGoodsRequest, grpc.ServerStreamingServer[ExportGoodsChunk]) error
+	// 商品状态流转：提交审核、审核通过或驳回、上架、下架、归档
+	UpdateGoodsStatus(context.Context, *GoodsStatusRequest) (*GoodsStatusResponse, error)
+	// 设置计划上下架时间，由后台定时任务执行
+	ScheduleGoodsSale(context.Context, *GoodsScheduleRequest) (*GoodsStatusResponse, error)
+	// 获取商品状态流转记录
+	GoodsStatusLogs(context.Context, *GoodInfoRequest) (*GoodsStatusLogListResponse, error)
+	// 获取商品价格变动记录，按时间倒序
+	GoodsPriceHistory(context.Context, *GoodsPriceHistoryRequest) (*GoodsPriceHistoryResponse, error)
+	// 获取商品描述的历史版本，按版本倒序，不含描述内容
+	GoodsDescVersions(context.Context, *GoodsDescVersionListRequest) (*GoodsDescVersionListResponse, error)
+	// 获取商品描述的指定版本，含描述内容
+	GetGoodsDescVersion(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error)
+	// 将商品描述回滚到指定版本，以该版本内容生成新版本
+	RollbackGoodsDesc(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error)
+	// 获取商品的 SKU 列表
+	GoodsSkuList(context.Context, *GoodInfoRequest) (*GoodsSkuListResponse, error)
+	// 批量获取 SKU 信息 - 用于购物车、订单按 SKU 查询价格
+	BatchGetSkus(context.Context, *BatchSkuIdInfo) (*GoodsSkuListResponse, error)
+	// 创建 SKU
+	CreateGoodsSku(context.Context, *GoodsSkuInfo) (*GoodsSkuInfo, error)
+	// 更新 SKU
+	UpdateGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error)
+	// 删除 SKU
+	DeleteGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error)
+	// 全量重建商品索引
+	// 从 MySQL 分批写入新的版本索引（goods_v2...），完成后原子切换 goods 别名，旧索引保留用于回滚
+	ReindexGoods(context.Context, *ReindexGoodsRequest) (*ReindexStatusResponse, error)
+	// 查询索引重建进度
+	GetReindexStatus(context.Context, *Empty) (*ReindexStatusResponse, error)
+	// 回滚商品索引
+	// 将 goods 别名切回上一个版本索引
+	RollbackGoodsIndex(context.Context, *Empty) (*GoodsIndexResponse, error)
+	// 获取商品搜索同义词词典
+	GetGoodsSynonyms(context.Context, *Empty) (*GoodsSynonymsResponse, error)
+	// 整体替换商品搜索同义词词典并重新加载搜索分析器
+	UpdateGoodsSynonyms(context.Context, *GoodsSynonymsRequest) (*GoodsSynonymsResponse, error)
+	// 获取热搜关键词 - 用于商城首页
+	HotKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error)
+	// 获取无结果搜索词 - 用于运营补充商品和同义词
+	ZeroResultKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error)
+	// 获取热搜屏蔽词
+	GetHotKeywordBlocklist(context.Context, *Empty) (*HotKeywordBlocklist, error)
+	// 整体替换热搜屏蔽词
+	UpdateHotKeywordBlocklist(context.Context, *HotKeywordBlocklist) (*HotKeywordBlocklist, error)
+	// 获取所有分类列表
+	GetAllCategorysList(context.Context, *Empty) (*CategoryListResponse, error)
+	// 获取子分类
+	GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error)
+	// 创建分类
+	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
+	// 删除分类
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error)
+	// 更新分类信息
+	UpdateCategory(context.Context, *CategoryInfoRequest) (*Empty, error)
+	// 获取分类属性模板，包含从上级分类继承的属性
+	CategoryAttributeList(context.Context, *CategoryListRequest) (*CategoryAttributeListResponse, error)
+	// 创建分类属性模板
+	CreateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*CategoryAttributeInfo, error)
+	// 更新分类属性模板，名称和类型创建后不可修改
+	UpdateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error)
+	// 删除分类属性模板，同时清除商品上的该属性值
+	DeleteCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error)
+	// 获取品牌列表
+	BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error)
+	// 创建品牌
+	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
+	// 删除品牌
+	DeleteBrand(context.Context, *BrandRequest) (*Empty, error)
+	// 更新品牌信息
+	UpdateBrand(context.Context, *BrandRequest) (*Empty, error)
+	// 获取轮播图列表
+	BannerList(context.Context, *Empty) (*BannerListResponse, error)
+	// 创建轮播图
+	CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error)
+	// 删除轮播图
+	DeleteBanner(context.Context, *BannerRequest) (*Empty, error)
+	// 更新轮播图
+	UpdateBanner(context.Context, *BannerRequest) (*Empty, error)
+	// 获取品牌分类关联列表
+	CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error)
+	// 通过分类获取品牌列表
+	GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error)
+	// 创建品牌分类关联
+	CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error)
+	// 删除品牌分类关联
+	DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
+	// 更新品牌分类关联
+	UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
+	mustEmbedUnimplementedGoodsServer()
+}
+
+// UnimplementedGoodsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGoodsServer struct{}
+
+func (UnimplementedGoodsServer) GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsList not implemented")
+}
+func (UnimplementedGoodsServer) AdminGoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGoodsList not implemented")
+}
+func (UnimplementedGoodsServer) SuggestGoods(context.Context, *SuggestGoodsRequest) (*SuggestGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGoods not implemented")
+}
+func (UnimplementedGoodsServer) RecommendGoods(context.Context, *GoodsRecommendRequest) (*GoodsRecommendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendGoods not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGoods not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsBySn(context.Context, *GoodsSnRequest) (*GoodsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsBySn not implemented")
+}
+func (UnimplementedGoodsServer) BatchResolveGoodsSn(context.Context, *BatchGoodsSnRequest) (*BatchGoodsSnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchResolveGoodsSn not implemented")
+}
+func (UnimplementedGoodsServer) IncrGoodsCounters(context.Context, *IncrGoodsCountersRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrGoodsCounters not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsCounters(context.Context, *BatchGoodsIdInfo) (*GoodsCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsCounters not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsRating(context.Context, *GoodsRatingInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsRating not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoods not implemented")
+}
+func (UnimplementedGoodsServer) DeleteGoods(context.Context, *DeleteGoodsInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoods not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoods(context.Context, *CreateGoodsInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoods not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
+}
+func (UnimplementedGoodsServer) ImportGoods(context.Context, *ImportGoodsRequest) (*ImportGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGoods not implemented")
+}
+func (UnimplementedGoodsServer) ImportGoodsStream(grpc.ClientStreamingServer[ImportGoodsRequest, ImportGoodsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportGoodsStream not implemented")
+}
+func (UnimplementedGoodsServer) ExportGoods(*ExportGoodsRequest, grpc.ServerStreamingServer[ExportGoodsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportGoods not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsStatus(context.Context, *GoodsStatusRequest) (*GoodsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsStatus not implemented")
+}
+func (UnimplementedGoodsServer) ScheduleGoodsSale(context.Context, *GoodsScheduleRequest) (*GoodsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleGoodsSale not implemented")
+}
+func (UnimplementedGoodsServer) GoodsStatusLogs(context.Context, *GoodInfoRequest) (*GoodsStatusLogListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsStatusLogs not implemented")
+}
+func (UnimplementedGoodsServer) GoodsPriceHistory(context.Context, *GoodsPriceHistoryRequest) (*GoodsPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsPriceHistory not implemented")
+}
+func (UnimplementedGoodsServer) GoodsDescVersions(context.Context, *GoodsDescVersionListRequest) (*GoodsDescVersionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsDescVersions not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsDescVersion(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDescVersion not implemented")
+}
+func (UnimplementedGoodsServer) RollbackGoodsDesc(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackGoodsDesc not implemented")
+}
+func (UnimplementedGoodsServer) GoodsSkuList(context.Context, *GoodInfoRequest) (*GoodsSkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsSkuList not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetSkus(context.Context, *BatchSkuIdInfo) (*GoodsSkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSkus not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoodsSku(context.Context, *GoodsSkuInfo) (*GoodsSkuInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoodsSku not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsSku not implemented")
+}
+func (UnimplementedGoodsServer) DeleteGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoodsSku not implemented")
+}
+func (UnimplementedGoodsServer) ReindexGoods(context.Context, *ReindexGoodsRequest) (*ReindexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexGoods not implemented")
+}
+func (UnimplementedGoodsServer) GetReindexStatus(context.Context, *Empty) (*ReindexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReindexStatus not implemented")
+}
+func (UnimplementedGoodsServer) RollbackGoodsIndex(context.Context, *Empty) (*GoodsIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackGoodsIndex not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsSynonyms(context.Context, *Empty) (*GoodsSynonymsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsSynonyms not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsSynonyms(context.Context, *GoodsSynonymsRequest) (*GoodsSynonymsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsSynonyms not implemented")
+}
+func (UnimplementedGoodsServer) HotKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HotKeywords not implemented")
+}
+func (UnimplementedGoodsServer) ZeroResultKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZeroResultKeywords not implemented")
+}
+func (UnimplementedGoodsServer) GetHotKeywordBlocklist(context.Context, *Empty) (*HotKeywordBlocklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotKeywordBlocklist not implemented")
+}
+func (UnimplementedGoodsServer) UpdateHotKeywordBlocklist(context.Context, *HotKeywordBlocklist) (*HotKeywordBlocklist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHotKeywordBlocklist not implemented")
+}
+func (UnimplementedGoodsServer) GetAllCategorysList(context.Context, *Empty) (*CategoryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategorysList not implemented")
+}
+func (UnimplementedGoodsServer) GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubCategory not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedGoodsServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryInfoRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedGoodsServer) CategoryAttributeList(context.Context, *CategoryListRequest) (*CategoryAttributeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryAttributeList not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*CategoryAttributeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryAttribute not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryAttribute not implemented")
+}
+func (UnimplementedGoodsServer) DeleteCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryAttribute not implemented")
+}
+func (UnimplementedGoodsServer) BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
+}
+func (UnimplementedGoodsServer) CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+}
+func (UnimplementedGoodsServer) DeleteBrand(context.Context, *BrandRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+}
+func (UnimplementedGoodsServer) UpdateBrand(context.Context, *BrandRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+}
+func (UnimplementedGoodsServer) BannerList(context.Context, *Empty) (*BannerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BannerList not implemented")
+}
+func (UnimplementedGoodsServer) CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBanner not implemented")
+}
+func (UnimplementedGoodsServer) DeleteBanner(context.Context, *BannerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBanner not implemented")
+}
+func (UnimplementedGoodsServer) UpdateBanner(context.Context, *BannerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
+}
+func (UnimplementedGoodsServer) CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryBrandList not implemented")
+}
+func (UnimplementedGoodsServer) GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryBrandList not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryBrand not implemented")
+}
+func (UnimplementedGoodsServer) DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryBrand not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryBrand not implemented")
+}
+func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
+func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
+
+// UnsafeGoodsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoodsServer will
+// result in compilation errors.
+type UnsafeGoodsServer interface {
+	mustEmbedUnimplementedGoodsServer()
+}
+
+func RegisterGoodsServer(s grpc.ServiceRegistrar, srv GoodsServer) {
+	// If the following call pancis, it indicates UnimplementedGoodsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Goods_ServiceDesc, srv)
+}
+
+func _Goods_GoodsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsList(ctx, req.(*GoodsFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_AdminGoodsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).AdminGoodsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_AdminGoodsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).AdminGoodsList(ctx, req.(*GoodsFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SuggestGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SuggestGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SuggestGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SuggestGoods(ctx, req.(*SuggestGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_RecommendGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsRecommendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).RecommendGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_RecommendGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).RecommendGoods(ctx, req.(*GoodsRecommendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGoodsIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetGoodsBySn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsBySn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsBySn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsBySn(ctx, req.(*GoodsSnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchResolveGoodsSn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGoodsSnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchResolveGoodsSn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchResolveGoodsSn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchResolveGoodsSn(ctx, req.(*BatchGoodsSnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_IncrGoodsCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrGoodsCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).IncrGoodsCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_IncrGoodsCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).IncrGoodsCounters(ctx, req.(*IncrGoodsCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubCategory not implemented")
+
+func _Goods_GetGoodsCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGoodsIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsCounters(ctx, req.(*BatchGoodsIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+
+func _Goods_UpdateGoodsRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsRatingInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoodsRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoodsRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoodsRating(ctx, req.(*GoodsRatingInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+
+func _Goods_CreateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoodsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoods(ctx, req.(*CreateGoodsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryInfoRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+
+func _Goods_DeleteGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoodsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteGoods(ctx, req.(*DeleteGoodsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
+
+func _Goods_UpdateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoodsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoods(ctx, req.(*CreateGoodsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+
+func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) DeleteBrand(context.Context, *BrandRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+
+func _Goods_ImportGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ImportGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ImportGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ImportGoods(ctx, req.(*ImportGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) UpdateBrand(context.Context, *BrandRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+
+func _Goods_ImportGoodsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoodsServer).ImportGoodsStream(&grpc.GenericServerStream[ImportGoodsRequest, ImportGoodsResponse]{ServerStream: stream})
 }
-func (UnimplementedGoodsServer) BannerList(context.Context, *Empty) (*BannerListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BannerList not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Goods_ImportGoodsStreamServer = grpc.ClientStreamingServer[ImportGoodsRequest, ImportGoodsResponse]
+
+func _Goods_ExportGoods_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGoodsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoodsServer).ExportGoods(m, &grpc.GenericServerStream[ExportGoodsRequest, ExportGoodsChunk]{ServerStream: stream})
 }
-func (UnimplementedGoodsServer) CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBanner not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Goods_ExportGoodsServer = grpc.ServerStreamingServer[ExportGoodsChunk]
+
+func _Goods_UpdateGoodsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoodsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoodsStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoodsStatus(ctx, req.(*GoodsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) DeleteBanner(context.Context, *BannerRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBanner not implemented")
+
+func _Goods_ScheduleGoodsSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ScheduleGoodsSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ScheduleGoodsSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ScheduleGoodsSale(ctx, req.(*GoodsScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) UpdateBanner(context.Context, *BannerRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
+
+func _Goods_GoodsStatusLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsStatusLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsStatusLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsStatusLogs(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CategoryBrandList not implemented")
+
+func _Goods_GoodsPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsPriceHistory(ctx, req.(*GoodsPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsDescVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDescVersionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsDescVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsDescVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsDescVersions(ctx, req.(*GoodsDescVersionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetGoodsDescVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDescVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsDescVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsDescVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsDescVersion(ctx, req.(*GoodsDescVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_RollbackGoodsDesc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDescVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).RollbackGoodsDesc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_RollbackGoodsDesc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).RollbackGoodsDesc(ctx, req.(*GoodsDescVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsSkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsSkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsSkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsSkuList(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSkuIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetSkus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetSkus(ctx, req.(*BatchSkuIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryBrandList not implemented")
+
+func _Goods_CreateGoodsSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoodsSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoodsSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoodsSku(ctx, req.(*GoodsSkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryBrand not implemented")
+
+func _Goods_UpdateGoodsSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoodsSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoodsSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoodsSku(ctx, req.(*GoodsSkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryBrand not implemented")
+
+func _Goods_DeleteGoodsSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteGoodsSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteGoodsSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteGoodsSku(ctx, req.(*GoodsSkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryBrand not implemented")
+
+func _Goods_ReindexGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ReindexGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ReindexGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ReindexGoods(ctx, req.(*ReindexGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
-func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
 
-// UnsafeGoodsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoodsServer will
-// result in compilation errors.
-type UnsafeGoodsServer interface {
-	mustEmbedUnimplementedGoodsServer()
+func _Goods_GetReindexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetReindexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetReindexStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetReindexStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoodsServer(s grpc.ServiceRegistrar, srv GoodsServer) {
-	// If the following call pancis, it indicates UnimplementedGoodsServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Goods_RollbackGoodsIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Goods_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GoodsServer).RollbackGoodsIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_RollbackGoodsIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).RollbackGoodsIndex(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_GoodsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GoodsFilterRequest)
+func _Goods_GetGoodsSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).GoodsList(ctx, in)
+		return srv.(GoodsServer).GetGoodsSynonyms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_GoodsList_FullMethodName,
+		FullMethod: Goods_GetGoodsSynonyms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).GoodsList(ctx, req.(*GoodsFilterRequest))
+		return srv.(GoodsServer).GetGoodsSynonyms(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_BatchGetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchGoodsIdInfo)
+func _Goods_UpdateGoodsSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSynonymsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).BatchGetGoods(ctx, in)
+		return srv.(GoodsServer).UpdateGoodsSynonyms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_BatchGetGoods_FullMethodName,
+		FullMethod: Goods_UpdateGoodsSynonyms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
+		return srv.(GoodsServer).UpdateGoodsSynonyms(ctx, req.(*GoodsSynonymsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_CreateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGoodsInfo)
+func _Goods_HotKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchKeywordsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).CreateGoods(ctx, in)
+		return srv.(GoodsServer).HotKeywords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_CreateGoods_FullMethodName,
+		FullMethod: Goods_HotKeywords_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).CreateGoods(ctx, req.(*CreateGoodsInfo))
+		return srv.(GoodsServer).HotKeywords(ctx, req.(*SearchKeywordsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_DeleteGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGoodsInfo)
+func _Goods_ZeroResultKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchKeywordsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).DeleteGoods(ctx, in)
+		return srv.(GoodsServer).ZeroResultKeywords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_DeleteGoods_FullMethodName,
+		FullMethod: Goods_ZeroResultKeywords_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).DeleteGoods(ctx, req.(*DeleteGoodsInfo))
+		return srv.(GoodsServer).ZeroResultKeywords(ctx, req.(*SearchKeywordsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_UpdateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGoodsInfo)
+func _Goods_GetHotKeywordBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).UpdateGoods(ctx, in)
+		return srv.(GoodsServer).GetHotKeywordBlocklist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_UpdateGoods_FullMethodName,
+		FullMethod: Goods_GetHotKeywordBlocklist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).UpdateGoods(ctx, req.(*CreateGoodsInfo))
+		return srv.(GoodsServer).GetHotKeywordBlocklist(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GoodInfoRequest)
+func _Goods_UpdateHotKeywordBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotKeywordBlocklist)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServer).GetGoodsDetail(ctx, in)
+		return srv.(GoodsServer).UpdateHotKeywordBlocklist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Goods_GetGoodsDetail_FullMethodName,
+		FullMethod: Goods_UpdateHotKeywordBlocklist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).GetGoodsDetail(ctx, req.(*GoodInfoRequest))
+		return srv.(GoodsServer).UpdateHotKeywordBlocklist(ctx, req.(*HotKeywordBlocklist))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -706,6 +1928,78 @@ func _Goods_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_CategoryAttributeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CategoryAttributeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CategoryAttributeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CategoryAttributeList(ctx, req.(*CategoryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_BrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BrandFilterRequest)
 	if err := dec(in); err != nil {
@@ -951,10 +2245,42 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoodsList",
 			Handler:    _Goods_GoodsList_Handler,
 		},
+		{
+			MethodName: "AdminGoodsList",
+			Handler:    _Goods_AdminGoodsList_Handler,
+		},
+		{
+			MethodName: "SuggestGoods",
+			Handler:    _Goods_SuggestGoods_Handler,
+		},
+		{
+			MethodName: "RecommendGoods",
+			Handler:    _Goods_RecommendGoods_Handler,
+		},
 		{
 			MethodName: "BatchGetGoods",
 			Handler:    _Goods_BatchGetGoods_Handler,
 		},
+		{
+			MethodName: "GetGoodsBySn",
+			Handler:    _Goods_GetGoodsBySn_Handler,
+		},
+		{
+			MethodName: "BatchResolveGoodsSn",
+			Handler:    _Goods_BatchResolveGoodsSn_Handler,
+		},
+		{
+			MethodName: "IncrGoodsCounters",
+			Handler:    _Goods_IncrGoodsCounters_Handler,
+		},
+		{
+			MethodName: "GetGoodsCounters",
+			Handler:    _Goods_GetGoodsCounters_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsRating",
+			Handler:    _Goods_UpdateGoodsRating_Handler,
+		},
 		{
 			MethodName: "CreateGoods",
 			Handler:    _Goods_CreateGoods_Handler,
@@ -971,6 +2297,94 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGoodsDetail",
 			Handler:    _Goods_GetGoodsDetail_Handler,
 		},
+		{
+			MethodName: "ImportGoods",
+			Handler:    _Goods_ImportGoods_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsStatus",
+			Handler:    _Goods_UpdateGoodsStatus_Handler,
+		},
+		{
+			MethodName: "ScheduleGoodsSale",
+			Handler:    _Goods_ScheduleGoodsSale_Handler,
+		},
+		{
+			MethodName: "GoodsStatusLogs",
+			Handler:    _Goods_GoodsStatusLogs_Handler,
+		},
+		{
+			MethodName: "GoodsPriceHistory",
+			Handler:    _Goods_GoodsPriceHistory_Handler,
+		},
+		{
+			MethodName: "GoodsDescVersions",
+			Handler:    _Goods_GoodsDescVersions_Handler,
+		},
+		{
+			MethodName: "GetGoodsDescVersion",
+			Handler:    _Goods_GetGoodsDescVersion_Handler,
+		},
+		{
+			MethodName: "RollbackGoodsDesc",
+			Handler:    _Goods_RollbackGoodsDesc_Handler,
+		},
+		{
+			MethodName: "GoodsSkuList",
+			Handler:    _Goods_GoodsSkuList_Handler,
+		},
+		{
+			MethodName: "BatchGetSkus",
+			Handler:    _Goods_BatchGetSkus_Handler,
+		},
+		{
+			MethodName: "CreateGoodsSku",
+			Handler:    _Goods_CreateGoodsSku_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsSku",
+			Handler:    _Goods_UpdateGoodsSku_Handler,
+		},
+		{
+			MethodName: "DeleteGoodsSku",
+			Handler:    _Goods_DeleteGoodsSku_Handler,
+		},
+		{
+			MethodName: "ReindexGoods",
+			Handler:    _Goods_ReindexGoods_Handler,
+		},
+		{
+			MethodName: "GetReindexStatus",
+			Handler:    _Goods_GetReindexStatus_Handler,
+		},
+		{
+			MethodName: "RollbackGoodsIndex",
+			Handler:    _Goods_RollbackGoodsIndex_Handler,
+		},
+		{
+			MethodName: "GetGoodsSynonyms",
+			Handler:    _Goods_GetGoodsSynonyms_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsSynonyms",
+			Handler:    _Goods_UpdateGoodsSynonyms_Handler,
+		},
+		{
+			MethodName: "HotKeywords",
+			Handler:    _Goods_HotKeywords_Handler,
+		},
+		{
+			MethodName: "ZeroResultKeywords",
+			Handler:    _Goods_ZeroResultKeywords_Handler,
+		},
+		{
+			MethodName: "GetHotKeywordBlocklist",
+			Handler:    _Goods_GetHotKeywordBlocklist_Handler,
+		},
+		{
+			MethodName: "UpdateHotKeywordBlocklist",
+			Handler:    _Goods_UpdateHotKeywordBlocklist_Handler,
+		},
 		{
 			MethodName: "GetAllCategorysList",
 			Handler:    _Goods_GetAllCategorysList_Handler,
@@ -991,6 +2405,22 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategory",
 			Handler:    _Goods_UpdateCategory_Handler,
 		},
+		{
+			MethodName: "CategoryAttributeList",
+			Handler:    _Goods_CategoryAttributeList_Handler,
+		},
+		{
+			MethodName: "CreateCategoryAttribute",
+			Handler:    _Goods_CreateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryAttribute",
+			Handler:    _Goods_UpdateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryAttribute",
+			Handler:    _Goods_DeleteCategoryAttribute_Handler,
+		},
 		{
 			MethodName: "BrandList",
 			Handler:    _Goods_BrandList_Handler,
@@ -1044,6 +2474,17 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Goods_UpdateCategoryBrand_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportGoodsStream",
+			Handler:       _Goods_ImportGoodsStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportGoods",
+			Handler:       _Goods_ExportGoods_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "goods/v1/service.proto",
 }
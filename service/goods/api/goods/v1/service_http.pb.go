@@ -19,38 +19,80 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationGoodsAdminGoodsList = "/service.goods.api.goods.v1.Goods/AdminGoodsList"
 const OperationGoodsBannerList = "/service.goods.api.goods.v1.Goods/BannerList"
 const OperationGoodsBatchGetGoods = "/service.goods.api.goods.v1.Goods/BatchGetGoods"
+const OperationGoodsBatchGetSkus = "/service.goods.api.goods.v1.Goods/BatchGetSkus"
+const OperationGoodsBatchResolveGoodsSn = "/service.goods.api.goods.v1.Goods/BatchResolveGoodsSn"
 const OperationGoodsBrandList = "/service.goods.api.goods.v1.Goods/BrandList"
+const OperationGoodsCategoryAttributeList = "/service.goods.api.goods.v1.Goods/CategoryAttributeList"
 const OperationGoodsCategoryBrandList = "/service.goods.api.goods.v1.Goods/CategoryBrandList"
 const OperationGoodsCreateBanner = "/service.goods.api.goods.v1.Goods/CreateBanner"
 const OperationGoodsCreateBrand = "/service.goods.api.goods.v1.Goods/CreateBrand"
 const OperationGoodsCreateCategory = "/service.goods.api.goods.v1.Goods/CreateCategory"
+const OperationGoodsCreateCategoryAttribute = "/service.goods.api.goods.v1.Goods/CreateCategoryAttribute"
 const OperationGoodsCreateCategoryBrand = "/service.goods.api.goods.v1.Goods/CreateCategoryBrand"
 const OperationGoodsCreateGoods = "/service.goods.api.goods.v1.Goods/CreateGoods"
+const OperationGoodsCreateGoodsSku = "/service.goods.api.goods.v1.Goods/CreateGoodsSku"
 const OperationGoodsDeleteBanner = "/service.goods.api.goods.v1.Goods/DeleteBanner"
 const OperationGoodsDeleteBrand = "/service.goods.api.goods.v1.Goods/DeleteBrand"
 const OperationGoodsDeleteCategory = "/service.goods.api.goods.v1.Goods/DeleteCategory"
+const OperationGoodsDeleteCategoryAttribute = "/service.goods.api.goods.v1.Goods/DeleteCategoryAttribute"
 const OperationGoodsDeleteCategoryBrand = "/service.goods.api.goods.v1.Goods/DeleteCategoryBrand"
 const OperationGoodsDeleteGoods = "/service.goods.api.goods.v1.Goods/DeleteGoods"
+const OperationGoodsDeleteGoodsSku = "/service.goods.api.goods.v1.Goods/DeleteGoodsSku"
 const OperationGoodsGetAllCategorysList = "/service.goods.api.goods.v1.Goods/GetAllCategorysList"
 const OperationGoodsGetCategoryBrandList = "/service.goods.api.goods.v1.Goods/GetCategoryBrandList"
+const OperationGoodsGetGoodsBySn = "/service.goods.api.goods.v1.Goods/GetGoodsBySn"
+const OperationGoodsGetGoodsCounters = "/service.goods.api.goods.v1.Goods/GetGoodsCounters"
+const OperationGoodsGetGoodsDescVersion = "/service.goods.api.goods.v1.Goods/GetGoodsDescVersion"
 const OperationGoodsGetGoodsDetail = "/service.goods.api.goods.v1.Goods/GetGoodsDetail"
+const OperationGoodsGetGoodsSynonyms = "/service.goods.api.goods.v1.Goods/GetGoodsSynonyms"
+const OperationGoodsGetHotKeywordBlocklist = "/service.goods.api.goods.v1.Goods/GetHotKeywordBlocklist"
+const OperationGoodsGetReindexStatus = "/service.goods.api.goods.v1.Goods/GetReindexStatus"
 const OperationGoodsGetSubCategory = "/service.goods.api.goods.v1.Goods/GetSubCategory"
+const OperationGoodsGoodsDescVersions = "/service.goods.api.goods.v1.Goods/GoodsDescVersions"
 const OperationGoodsGoodsList = "/service.goods.api.goods.v1.Goods/GoodsList"
+const OperationGoodsGoodsPriceHistory = "/service.goods.api.goods.v1.Goods/GoodsPriceHistory"
+const OperationGoodsGoodsSkuList = "/service.goods.api.goods.v1.Goods/GoodsSkuList"
+const OperationGoodsGoodsStatusLogs = "/service.goods.api.goods.v1.Goods/GoodsStatusLogs"
+const OperationGoodsHotKeywords = "/service.goods.api.goods.v1.Goods/HotKeywords"
+const OperationGoodsImportGoods = "/service.goods.api.goods.v1.Goods/ImportGoods"
+const OperationGoodsIncrGoodsCounters = "/service.goods.api.goods.v1.Goods/IncrGoodsCounters"
+const OperationGoodsRecommendGoods = "/service.goods.api.goods.v1.Goods/RecommendGoods"
+const OperationGoodsReindexGoods = "/service.goods.api.goods.v1.Goods/ReindexGoods"
+const OperationGoodsRollbackGoodsDesc = "/service.goods.api.goods.v1.Goods/RollbackGoodsDesc"
+const OperationGoodsRollbackGoodsIndex = "/service.goods.api.goods.v1.Goods/RollbackGoodsIndex"
+const OperationGoodsScheduleGoodsSale = "/service.goods.api.goods.v1.Goods/ScheduleGoodsSale"
+const OperationGoodsSuggestGoods = "/service.goods.api.goods.v1.Goods/SuggestGoods"
 const OperationGoodsUpdateBanner = "/service.goods.api.goods.v1.Goods/UpdateBanner"
 const OperationGoodsUpdateBrand = "/service.goods.api.goods.v1.Goods/UpdateBrand"
 const OperationGoodsUpdateCategory = "/service.goods.api.goods.v1.Goods/UpdateCategory"
+const OperationGoodsUpdateCategoryAttribute = "/service.goods.api.goods.v1.Goods/UpdateCategoryAttribute"
 const OperationGoodsUpdateCategoryBrand = "/service.goods.api.goods.v1.Goods/UpdateCategoryBrand"
 const OperationGoodsUpdateGoods = "/service.goods.api.goods.v1.Goods/UpdateGoods"
+const OperationGoodsUpdateGoodsRating = "/service.goods.api.goods.v1.Goods/UpdateGoodsRating"
+const OperationGoodsUpdateGoodsSku = "/service.goods.api.goods.v1.Goods/UpdateGoodsSku"
+const OperationGoodsUpdateGoodsStatus = "/service.goods.api.goods.v1.Goods/UpdateGoodsStatus"
+const OperationGoodsUpdateGoodsSynonyms = "/service.goods.api.goods.v1.Goods/UpdateGoodsSynonyms"
+const OperationGoodsUpdateHotKeywordBlocklist = "/service.goods.api.goods.v1.Goods/UpdateHotKeywordBlocklist"
+const OperationGoodsZeroResultKeywords = "/service.goods.api.goods.v1.Goods/ZeroResultKeywords"
 
 type GoodsHTTPServer interface {
+	// AdminGoodsList 管理后台商品列表 - 与商品列表相同，设置 includeOffSale 时包含未上架的商品
+	AdminGoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
 	// BannerList 获取轮播图列表
 	BannerList(context.Context, *Empty) (*BannerListResponse, error)
 	// BatchGetGoods 批量获取商品信息 - 用于订单提交时批量查询商品信息
 	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
+	// BatchGetSkus 批量获取 SKU 信息 - 用于购物车、订单按 SKU 查询价格
+	BatchGetSkus(context.Context, *BatchSkuIdInfo) (*GoodsSkuListResponse, error)
+	// BatchResolveGoodsSn 批量将商品编号解析为商品ID
+	BatchResolveGoodsSn(context.Context, *BatchGoodsSnRequest) (*BatchGoodsSnResponse, error)
 	// BrandList 获取品牌列表
 	BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error)
+	// CategoryAttributeList 获取分类属性模板，包含从上级分类继承的属性
+	CategoryAttributeList(context.Context, *CategoryListRequest) (*CategoryAttributeListResponse, error)
 	// CategoryBrandList 获取品牌分类关联列表
 	CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error)
 	// CreateBanner 创建轮播图
@@ -59,55 +101,151 @@ type GoodsHTTPServer interface {
 	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
 	// CreateCategory 创建分类
 	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
+	// CreateCategoryAttribute 创建分类属性模板
+	CreateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*CategoryAttributeInfo, error)
 	// CreateCategoryBrand 创建品牌分类关联
 	CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error)
 	// CreateGoods 创建商品
 	CreateGoods(context.Context, *CreateGoodsInfo) (*GoodsInfoResponse, error)
+	// CreateGoodsSku 创建 SKU
+	CreateGoodsSku(context.Context, *GoodsSkuInfo) (*GoodsSkuInfo, error)
 	// DeleteBanner 删除轮播图
 	DeleteBanner(context.Context, *BannerRequest) (*Empty, error)
 	// DeleteBrand 删除品牌
 	DeleteBrand(context.Context, *BrandRequest) (*Empty, error)
 	// DeleteCategory 删除分类
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error)
+	// DeleteCategoryAttribute 删除分类属性模板，同时清除商品上的该属性值
+	DeleteCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error)
 	// DeleteCategoryBrand 删除品牌分类关联
 	DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
 	// DeleteGoods 删除商品
 	DeleteGoods(context.Context, *DeleteGoodsInfo) (*Empty, error)
+	// DeleteGoodsSku 删除 SKU
+	DeleteGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error)
 	// GetAllCategorysList 获取所有分类列表
 	GetAllCategorysList(context.Context, *Empty) (*CategoryListResponse, error)
 	// GetCategoryBrandList 通过分类获取品牌列表
 	GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error)
+	// GetGoodsBySn 按商品编号获取商品详情 - 用于仓储、供应商系统对接
+	GetGoodsBySn(context.Context, *GoodsSnRequest) (*GoodsInfoResponse, error)
+	// GetGoodsCounters 批量获取商品实时计数，包含尚未写入数据库的增量
+	GetGoodsCounters(context.Context, *BatchGoodsIdInfo) (*GoodsCountersResponse, error)
+	// GetGoodsDescVersion 获取商品描述的指定版本，含描述内容
+	GetGoodsDescVersion(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error)
 	// GetGoodsDetail 获取商品详情
 	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
+	// GetGoodsSynonyms 获取商品搜索同义词词典
+	GetGoodsSynonyms(context.Context, *Empty) (*GoodsSynonymsResponse, error)
+	// GetHotKeywordBlocklist 获取热搜屏蔽词
+	GetHotKeywordBlocklist(context.Context, *Empty) (*HotKeywordBlocklist, error)
+	// GetReindexStatus 查询索引重建进度
+	GetReindexStatus(context.Context, *Empty) (*ReindexStatusResponse, error)
 	// GetSubCategory 获取子分类
 	GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error)
+	// GoodsDescVersions 获取商品描述的历史版本，按版本倒序，不含描述内容
+	GoodsDescVersions(context.Context, *GoodsDescVersionListRequest) (*GoodsDescVersionListResponse, error)
 	// GoodsList 获取商品列表
 	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	// GoodsPriceHistory 获取商品价格变动记录，按时间倒序
+	GoodsPriceHistory(context.Context, *GoodsPriceHistoryRequest) (*GoodsPriceHistoryResponse, error)
+	// GoodsSkuList 获取商品的 SKU 列表
+	GoodsSkuList(context.Context, *GoodInfoRequest) (*GoodsSkuListResponse, error)
+	// GoodsStatusLogs 获取商品状态流转记录
+	GoodsStatusLogs(context.Context, *GoodInfoRequest) (*GoodsStatusLogListResponse, error)
+	// HotKeywords 获取热搜关键词 - 用于商城首页
+	HotKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error)
+	// ImportGoods 从 CSV/XLSX 批量导入商品，支持试运行和按商品编号更新
+	ImportGoods(context.Context, *ImportGoodsRequest) (*ImportGoodsResponse, error)
+	// IncrGoodsCounters 累加商品点击、收藏、销量计数，增量先缓存在 Redis 中定时写入数据库和搜索索引
+	IncrGoodsCounters(context.Context, *IncrGoodsCountersRequest) (*Empty, error)
+	// RecommendGoods 商品推荐：经常一起购买的在售商品，数据不足时补充同类热销商品
+	RecommendGoods(context.Context, *GoodsRecommendRequest) (*GoodsRecommendResponse, error)
+	// ReindexGoods 全量重建商品索引
+	// 从 MySQL 分批写入新的版本索引（goods_v2...），完成后原子切换 goods 别名，旧索引保留用于回滚
+	ReindexGoods(context.Context, *ReindexGoodsRequest) (*ReindexStatusResponse, error)
+	// RollbackGoodsDesc 将商品描述回滚到指定版本，以该版本内容生成新版本
+	RollbackGoodsDesc(context.Context, *GoodsDescVersionRequest) (*GoodsDescVersionInfo, error)
+	// RollbackGoodsIndex 回滚商品索引
+	// 将 goods 别名切回上一个版本索引
+	RollbackGoodsIndex(context.Context, *Empty) (*GoodsIndexResponse, error)
+	// ScheduleGoodsSale 设置计划上下架时间，由后台定时任务执行
+	ScheduleGoodsSale(context.Context, *GoodsScheduleRequest) (*GoodsStatusResponse, error)
+	// SuggestGoods 搜索联想 - 输入时返回商品名称补全以及匹配的分类、品牌
+	SuggestGoods(context.Context, *SuggestGoodsRequest) (*SuggestGoodsResponse, error)
 	// UpdateBanner 更新轮播图
 	UpdateBanner(context.Context, *BannerRequest) (*Empty, error)
 	// UpdateBrand 更新品牌信息
 	UpdateBrand(context.Context, *BrandRequest) (*Empty, error)
 	// UpdateCategory 更新分类信息
 	UpdateCategory(context.Context, *CategoryInfoRequest) (*Empty, error)
+	// UpdateCategoryAttribute 更新分类属性模板，名称和类型创建后不可修改
+	UpdateCategoryAttribute(context.Context, *CategoryAttributeInfo) (*Empty, error)
 	// UpdateCategoryBrand 更新品牌分类关联
 	UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*Empty, error)
 	// UpdateGoods 更新商品信息
 	UpdateGoods(context.Context, *CreateGoodsInfo) (*Empty, error)
+	// UpdateGoodsRating 更新商品评分汇总，以传入的汇总值覆盖
+	UpdateGoodsRating(context.Context, *GoodsRatingInfo) (*Empty, error)
+	// UpdateGoodsSku 更新 SKU
+	UpdateGoodsSku(context.Context, *GoodsSkuInfo) (*Empty, error)
+	// UpdateGoodsStatus 商品状态流转：提交审核、审核通过或驳回、上架、下架、归档
+	UpdateGoodsStatus(context.Context, *GoodsStatusRequest) (*GoodsStatusResponse, error)
+	// UpdateGoodsSynonyms 整体替换商品搜索同义词词典并重新加载搜索分析器
+	UpdateGoodsSynonyms(context.Context, *GoodsSynonymsRequest) (*GoodsSynonymsResponse, error)
+	// UpdateHotKeywordBlocklist 整体替换热搜屏蔽词
+	UpdateHotKeywordBlocklist(context.Context, *HotKeywordBlocklist) (*HotKeywordBlocklist, error)
+	// ZeroResultKeywords 获取无结果搜索词 - 用于运营补充商品和同义词
+	ZeroResultKeywords(context.Context, *SearchKeywordsRequest) (*SearchKeywordsResponse, error)
 }
 
 func RegisterGoodsHTTPServer(s *http.Server, srv GoodsHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/goods", _Goods_GoodsList0_HTTP_Handler(srv))
+	r.GET("/v1/admin/goods", _Goods_AdminGoodsList0_HTTP_Handler(srv))
+	r.GET("/v1/goods/suggest", _Goods_SuggestGoods0_HTTP_Handler(srv))
+	r.GET("/v1/goods/recommend", _Goods_RecommendGoods0_HTTP_Handler(srv))
 	r.POST("/v1/goods/batch", _Goods_BatchGetGoods0_HTTP_Handler(srv))
+	r.GET("/v1/goods/sn/{goodsSn}", _Goods_GetGoodsBySn0_HTTP_Handler(srv))
+	r.POST("/v1/goods/sn/batch", _Goods_BatchResolveGoodsSn0_HTTP_Handler(srv))
+	r.POST("/v1/goods/counters", _Goods_IncrGoodsCounters0_HTTP_Handler(srv))
+	r.POST("/v1/goods/counters/batch", _Goods_GetGoodsCounters0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/{goodsId}/rating", _Goods_UpdateGoodsRating0_HTTP_Handler(srv))
 	r.POST("/v1/goods", _Goods_CreateGoods0_HTTP_Handler(srv))
 	r.DELETE("/v1/goods/{id}", _Goods_DeleteGoods0_HTTP_Handler(srv))
 	r.PUT("/v1/goods/{id}", _Goods_UpdateGoods0_HTTP_Handler(srv))
 	r.GET("/v1/goods/{id}", _Goods_GetGoodsDetail0_HTTP_Handler(srv))
+	r.POST("/v1/goods/import", _Goods_ImportGoods0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/{id}/status", _Goods_UpdateGoodsStatus0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/{id}/schedule", _Goods_ScheduleGoodsSale0_HTTP_Handler(srv))
+	r.GET("/v1/goods/{id}/status-logs", _Goods_GoodsStatusLogs0_HTTP_Handler(srv))
+	r.GET("/v1/goods/{id}/price-history", _Goods_GoodsPriceHistory0_HTTP_Handler(srv))
+	r.GET("/v1/goods/{id}/desc-versions", _Goods_GoodsDescVersions0_HTTP_Handler(srv))
+	r.GET("/v1/goods/{id}/desc-versions/{version}", _Goods_GetGoodsDescVersion0_HTTP_Handler(srv))
+	r.POST("/v1/goods/{id}/desc-versions/{version}/rollback", _Goods_RollbackGoodsDesc0_HTTP_Handler(srv))
+	r.GET("/v1/goods/{id}/skus", _Goods_GoodsSkuList0_HTTP_Handler(srv))
+	r.POST("/v1/goods/skus/batch", _Goods_BatchGetSkus0_HTTP_Handler(srv))
+	r.POST("/v1/goods/{goodsId}/skus", _Goods_CreateGoodsSku0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/skus/{id}", _Goods_UpdateGoodsSku0_HTTP_Handler(srv))
+	r.DELETE("/v1/goods/skus/{id}", _Goods_DeleteGoodsSku0_HTTP_Handler(srv))
+	r.POST("/v1/goods/index/reindex", _Goods_ReindexGoods0_HTTP_Handler(srv))
+	r.GET("/v1/goods/index/reindex", _Goods_GetReindexStatus0_HTTP_Handler(srv))
+	r.POST("/v1/goods/index/rollback", _Goods_RollbackGoodsIndex0_HTTP_Handler(srv))
+	r.GET("/v1/goods/index/synonyms", _Goods_GetGoodsSynonyms0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/index/synonyms", _Goods_UpdateGoodsSynonyms0_HTTP_Handler(srv))
+	r.GET("/v1/goods/search/hot-keywords", _Goods_HotKeywords0_HTTP_Handler(srv))
+	r.GET("/v1/goods/search/zero-result-keywords", _Goods_ZeroResultKeywords0_HTTP_Handler(srv))
+	r.GET("/v1/goods/search/blocklist", _Goods_GetHotKeywordBlocklist0_HTTP_Handler(srv))
+	r.PUT("/v1/goods/search/blocklist", _Goods_UpdateHotKeywordBlocklist0_HTTP_Handler(srv))
 	r.GET("/v1/categories", _Goods_GetAllCategorysList0_HTTP_Handler(srv))
 	r.GET("/v1/categories/{id}/sub", _Goods_GetSubCategory0_HTTP_Handler(srv))
 	r.POST("/v1/categories", _Goods_CreateCategory0_HTTP_Handler(srv))
 	r.DELETE("/v1/categories/{id}", _Goods_DeleteCategory0_HTTP_Handler(srv))
 	r.PUT("/v1/categories/{id}", _Goods_UpdateCategory0_HTTP_Handler(srv))
+	r.GET("/v1/categories/{id}/attributes", _Goods_CategoryAttributeList0_HTTP_Handler(srv))
+	r.POST("/v1/categories/{categoryId}/attributes", _Goods_CreateCategoryAttribute0_HTTP_Handler(srv))
+	r.PUT("/v1/categories/attributes/{id}", _Goods_UpdateCategoryAttribute0_HTTP_Handler(srv))
+	r.DELETE("/v1/categories/attributes/{id}", _Goods_DeleteCategoryAttribute0_HTTP_Handler(srv))
 	r.GET("/v1/brands", _Goods_BrandList0_HTTP_Handler(srv))
 	r.POST("/v1/brands", _Goods_CreateBrand0_HTTP_Handler(srv))
 	r.DELETE("/v1/brands/{id}", _Goods_DeleteBrand0_HTTP_Handler(srv))
@@ -142,194 +280,232 @@ func _Goods_GoodsList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Goods_BatchGetGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_AdminGoodsList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BatchGoodsIdInfo
-		if err := ctx.Bind(&in); err != nil {
+		var in GoodsFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsAdminGoodsList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGoodsList(ctx, req.(*GoodsFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
+		reply := out.(*GoodsListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_SuggestGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestGoodsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsBatchGetGoods)
+		http.SetOperation(ctx, OperationGoodsSuggestGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
+			return srv.SuggestGoods(ctx, req.(*SuggestGoodsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GoodsListResponse)
+		reply := out.(*SuggestGoodsResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CreateGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_RecommendGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateGoodsInfo
+		var in GoodsRecommendRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsRecommendGoods)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecommendGoods(ctx, req.(*GoodsRecommendRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsRecommendResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_BatchGetGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGoodsIdInfo
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCreateGoods)
+		http.SetOperation(ctx, OperationGoodsBatchGetGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateGoods(ctx, req.(*CreateGoodsInfo))
+			return srv.BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GoodsInfoResponse)
+		reply := out.(*GoodsListResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_DeleteGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GetGoodsBySn0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteGoodsInfo
+		var in GoodsSnRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsDeleteGoods)
+		http.SetOperation(ctx, OperationGoodsGetGoodsBySn)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteGoods(ctx, req.(*DeleteGoodsInfo))
+			return srv.GetGoodsBySn(ctx, req.(*GoodsSnRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*GoodsInfoResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_UpdateGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_BatchResolveGoodsSn0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateGoodsInfo
+		var in BatchGoodsSnRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationGoodsUpdateGoods)
+		http.SetOperation(ctx, OperationGoodsBatchResolveGoodsSn)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateGoods(ctx, req.(*CreateGoodsInfo))
+			return srv.BatchResolveGoodsSn(ctx, req.(*BatchGoodsSnRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*BatchGoodsSnResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_GetGoodsDetail0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_IncrGoodsCounters0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GoodInfoRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in IncrGoodsCountersRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsGetGoodsDetail)
+		http.SetOperation(ctx, OperationGoodsIncrGoodsCounters)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetGoodsDetail(ctx, req.(*GoodInfoRequest))
+			return srv.IncrGoodsCounters(ctx, req.(*IncrGoodsCountersRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GoodsInfoResponse)
+		reply := out.(*Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_GetAllCategorysList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GetGoodsCounters0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in Empty
+		var in BatchGoodsIdInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsGetAllCategorysList)
+		http.SetOperation(ctx, OperationGoodsGetGoodsCounters)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetAllCategorysList(ctx, req.(*Empty))
+			return srv.GetGoodsCounters(ctx, req.(*BatchGoodsIdInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CategoryListResponse)
+		reply := out.(*GoodsCountersResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_GetSubCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_UpdateGoodsRating0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryListRequest
+		var in GoodsRatingInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsGetSubCategory)
+		http.SetOperation(ctx, OperationGoodsUpdateGoodsRating)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetSubCategory(ctx, req.(*CategoryListRequest))
+			return srv.UpdateGoodsRating(ctx, req.(*GoodsRatingInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SubCategoryListResponse)
+		reply := out.(*Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CreateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_CreateGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryInfoRequest
+		var in CreateGoodsInfo
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCreateCategory)
+		http.SetOperation(ctx, OperationGoodsCreateGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateCategory(ctx, req.(*CategoryInfoRequest))
+			return srv.CreateGoods(ctx, req.(*CreateGoodsInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CategoryInfoResponse)
+		reply := out.(*GoodsInfoResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_DeleteCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_DeleteGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteCategoryRequest
+		var in DeleteGoodsInfo
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsDeleteCategory)
+		http.SetOperation(ctx, OperationGoodsDeleteGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+			return srv.DeleteGoods(ctx, req.(*DeleteGoodsInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -340,9 +516,9 @@ func _Goods_DeleteCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Cont
 	}
 }
 
-func _Goods_UpdateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_UpdateGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryInfoRequest
+		var in CreateGoodsInfo
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -352,9 +528,9 @@ func _Goods_UpdateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Cont
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsUpdateCategory)
+		http.SetOperation(ctx, OperationGoodsUpdateGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateCategory(ctx, req.(*CategoryInfoRequest))
+			return srv.UpdateGoods(ctx, req.(*CreateGoodsInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -365,72 +541,78 @@ func _Goods_UpdateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Cont
 	}
 }
 
-func _Goods_BrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GetGoodsDetail0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BrandFilterRequest
+		var in GoodInfoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsBrandList)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetGoodsDetail)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.BrandList(ctx, req.(*BrandFilterRequest))
+			return srv.GetGoodsDetail(ctx, req.(*GoodInfoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BrandListResponse)
+		reply := out.(*GoodsInfoResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CreateBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_ImportGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BrandRequest
+		var in ImportGoodsRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCreateBrand)
+		http.SetOperation(ctx, OperationGoodsImportGoods)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateBrand(ctx, req.(*BrandRequest))
+			return srv.ImportGoods(ctx, req.(*ImportGoodsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BrandInfoResponse)
+		reply := out.(*ImportGoodsResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_DeleteBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_UpdateGoodsStatus0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BrandRequest
+		var in GoodsStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsDeleteBrand)
+		http.SetOperation(ctx, OperationGoodsUpdateGoodsStatus)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteBrand(ctx, req.(*BrandRequest))
+			return srv.UpdateGoodsStatus(ctx, req.(*GoodsStatusRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*GoodsStatusResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_UpdateBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_ScheduleGoodsSale0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BrandRequest
+		var in GoodsScheduleRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -440,85 +622,110 @@ func _Goods_UpdateBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsUpdateBrand)
+		http.SetOperation(ctx, OperationGoodsScheduleGoodsSale)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateBrand(ctx, req.(*BrandRequest))
+			return srv.ScheduleGoodsSale(ctx, req.(*GoodsScheduleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*GoodsStatusResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_BannerList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GoodsStatusLogs0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in Empty
+		var in GoodInfoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsBannerList)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGoodsStatusLogs)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.BannerList(ctx, req.(*Empty))
+			return srv.GoodsStatusLogs(ctx, req.(*GoodInfoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BannerListResponse)
+		reply := out.(*GoodsStatusLogListResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CreateBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GoodsPriceHistory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BannerRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in GoodsPriceHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGoodsPriceHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsPriceHistory(ctx, req.(*GoodsPriceHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
+		reply := out.(*GoodsPriceHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GoodsDescVersions0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsDescVersionListRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCreateBanner)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGoodsDescVersions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateBanner(ctx, req.(*BannerRequest))
+			return srv.GoodsDescVersions(ctx, req.(*GoodsDescVersionListRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BannerResponse)
+		reply := out.(*GoodsDescVersionListResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_DeleteBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GetGoodsDescVersion0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BannerRequest
+		var in GoodsDescVersionRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsDeleteBanner)
+		http.SetOperation(ctx, OperationGoodsGetGoodsDescVersion)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteBanner(ctx, req.(*BannerRequest))
+			return srv.GetGoodsDescVersion(ctx, req.(*GoodsDescVersionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*GoodsDescVersionInfo)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_UpdateBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_RollbackGoodsDesc0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in BannerRequest
+		var in GoodsDescVersionRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -528,94 +735,125 @@ func _Goods_UpdateBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Contex
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsUpdateBanner)
+		http.SetOperation(ctx, OperationGoodsRollbackGoodsDesc)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateBanner(ctx, req.(*BannerRequest))
+			return srv.RollbackGoodsDesc(ctx, req.(*GoodsDescVersionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*GoodsDescVersionInfo)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CategoryBrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_GoodsSkuList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryBrandFilterRequest
+		var in GoodInfoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCategoryBrandList)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGoodsSkuList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CategoryBrandList(ctx, req.(*CategoryBrandFilterRequest))
+			return srv.GoodsSkuList(ctx, req.(*GoodInfoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CategoryBrandListResponse)
+		reply := out.(*GoodsSkuListResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_GetCategoryBrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_BatchGetSkus0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryInfoRequest
+		var in BatchSkuIdInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsBatchGetSkus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetSkus(ctx, req.(*BatchSkuIdInfo))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsSkuListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateGoodsSku0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsSkuInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsGetCategoryBrandList)
+		http.SetOperation(ctx, OperationGoodsCreateGoodsSku)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCategoryBrandList(ctx, req.(*CategoryInfoRequest))
+			return srv.CreateGoodsSku(ctx, req.(*GoodsSkuInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*BrandListResponse)
+		reply := out.(*GoodsSkuInfo)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_CreateCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_UpdateGoodsSku0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryBrandRequest
+		var in GoodsSkuInfo
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsCreateCategoryBrand)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateGoodsSku)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateCategoryBrand(ctx, req.(*CategoryBrandRequest))
+			return srv.UpdateGoodsSku(ctx, req.(*GoodsSkuInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CategoryBrandResponse)
+		reply := out.(*Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Goods_DeleteCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_DeleteGoodsSku0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryBrandRequest
+		var in GoodsSkuInfo
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsDeleteCategoryBrand)
+		http.SetOperation(ctx, OperationGoodsDeleteGoodsSku)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteCategoryBrand(ctx, req.(*CategoryBrandRequest))
+			return srv.DeleteGoodsSku(ctx, req.(*GoodsSkuInfo))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -626,38 +864,694 @@ func _Goods_DeleteCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http
 	}
 }
 
-func _Goods_UpdateCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+func _Goods_ReindexGoods0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CategoryBrandRequest
+		var in ReindexGoodsRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		http.SetOperation(ctx, OperationGoodsReindexGoods)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReindexGoods(ctx, req.(*ReindexGoodsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationGoodsUpdateCategoryBrand)
+		reply := out.(*ReindexStatusResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetReindexStatus0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetReindexStatus)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateCategoryBrand(ctx, req.(*CategoryBrandRequest))
+			return srv.GetReindexStatus(ctx, req.(*Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*Empty)
+		reply := out.(*ReindexStatusResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type GoodsHTTPClient interface {
-	// BannerList 获取轮播图列表
-	BannerList(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *BannerListResponse, err error)
-	// BatchGetGoods 批量获取商品信息 - 用于订单提交时批量查询商品信息
+func _Goods_RollbackGoodsIndex0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsRollbackGoodsIndex)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackGoodsIndex(ctx, req.(*Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsIndexResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetGoodsSynonyms0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetGoodsSynonyms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGoodsSynonyms(ctx, req.(*Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsSynonymsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateGoodsSynonyms0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsSynonymsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateGoodsSynonyms)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateGoodsSynonyms(ctx, req.(*GoodsSynonymsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsSynonymsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_HotKeywords0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchKeywordsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsHotKeywords)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HotKeywords(ctx, req.(*SearchKeywordsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchKeywordsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_ZeroResultKeywords0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchKeywordsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsZeroResultKeywords)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ZeroResultKeywords(ctx, req.(*SearchKeywordsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchKeywordsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetHotKeywordBlocklist0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetHotKeywordBlocklist)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetHotKeywordBlocklist(ctx, req.(*Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HotKeywordBlocklist)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateHotKeywordBlocklist0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HotKeywordBlocklist
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateHotKeywordBlocklist)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateHotKeywordBlocklist(ctx, req.(*HotKeywordBlocklist))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HotKeywordBlocklist)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetAllCategorysList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetAllCategorysList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAllCategorysList(ctx, req.(*Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetSubCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetSubCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSubCategory(ctx, req.(*CategoryListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubCategoryListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCreateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCategory(ctx, req.(*CategoryInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryInfoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_DeleteCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCategoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsDeleteCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateCategory0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCategory(ctx, req.(*CategoryInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CategoryAttributeList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCategoryAttributeList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CategoryAttributeList(ctx, req.(*CategoryListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryAttributeListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateCategoryAttribute0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryAttributeInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCreateCategoryAttribute)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryAttributeInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateCategoryAttribute0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryAttributeInfo
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateCategoryAttribute)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_DeleteCategoryAttribute0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryAttributeInfo
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsDeleteCategoryAttribute)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCategoryAttribute(ctx, req.(*CategoryAttributeInfo))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_BrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BrandFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsBrandList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BrandList(ctx, req.(*BrandFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BrandListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BrandRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCreateBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateBrand(ctx, req.(*BrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BrandInfoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_DeleteBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BrandRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsDeleteBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteBrand(ctx, req.(*BrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BrandRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateBrand(ctx, req.(*BrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_BannerList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsBannerList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BannerList(ctx, req.(*Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BannerListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BannerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCreateBanner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateBanner(ctx, req.(*BannerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BannerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_DeleteBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BannerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsDeleteBanner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteBanner(ctx, req.(*BannerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateBanner0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BannerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateBanner)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateBanner(ctx, req.(*BannerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CategoryBrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryBrandFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCategoryBrandList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CategoryBrandList(ctx, req.(*CategoryBrandFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryBrandListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_GetCategoryBrandList0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryInfoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsGetCategoryBrandList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCategoryBrandList(ctx, req.(*CategoryInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BrandListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_CreateCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryBrandRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsCreateCategoryBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCategoryBrand(ctx, req.(*CategoryBrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryBrandResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_DeleteCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryBrandRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsDeleteCategoryBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCategoryBrand(ctx, req.(*CategoryBrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Goods_UpdateCategoryBrand0_HTTP_Handler(srv GoodsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CategoryBrandRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGoodsUpdateCategoryBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCategoryBrand(ctx, req.(*CategoryBrandRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+type GoodsHTTPClient interface {
+	// AdminGoodsList 管理后台商品列表 - 与商品列表相同，设置 includeOffSale 时包含未上架的商品
+	AdminGoodsList(ctx context.Context, req *GoodsFilterRequest, opts ...http.CallOption) (rsp *GoodsListResponse, err error)
+	// BannerList 获取轮播图列表
+	BannerList(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *BannerListResponse, err error)
+	// BatchGetGoods 批量获取商品信息 - 用于订单提交时批量查询商品信息
 	BatchGetGoods(ctx context.Context, req *BatchGoodsIdInfo, opts ...http.CallOption) (rsp *GoodsListResponse, err error)
+	// BatchGetSkus 批量获取 SKU 信息 - 用于购物车、订单按 SKU 查询价格
+	BatchGetSkus(ctx context.Context, req *BatchSkuIdInfo, opts ...http.CallOption) (rsp *GoodsSkuListResponse, err error)
+	// BatchResolveGoodsSn 批量将商品编号解析为商品ID
+	BatchResolveGoodsSn(ctx context.Context, req *BatchGoodsSnRequest, opts ...http.CallOption) (rsp *BatchGoodsSnResponse, err error)
 	// BrandList 获取品牌列表
 	BrandList(ctx context.Context, req *BrandFilterRequest, opts ...http.CallOption) (rsp *BrandListResponse, err error)
+	// CategoryAttributeList 获取分类属性模板，包含从上级分类继承的属性
+	CategoryAttributeList(ctx context.Context, req *CategoryListRequest, opts ...http.CallOption) (rsp *CategoryAttributeListResponse, err error)
 	// CategoryBrandList 获取品牌分类关联列表
 	CategoryBrandList(ctx context.Context, req *CategoryBrandFilterRequest, opts ...http.CallOption) (rsp *CategoryBrandListResponse, err error)
 	// CreateBanner 创建轮播图
@@ -666,56 +1560,454 @@ type GoodsHTTPClient interface {
 	CreateBrand(ctx context.Context, req *BrandRequest, opts ...http.CallOption) (rsp *BrandInfoResponse, err error)
 	// CreateCategory 创建分类
 	CreateCategory(ctx context.Context, req *CategoryInfoRequest, opts ...http.CallOption) (rsp *CategoryInfoResponse, err error)
+	// CreateCategoryAttribute 创建分类属性模板
+	CreateCategoryAttribute(ctx context.Context, req *CategoryAttributeInfo, opts ...http.CallOption) (rsp *CategoryAttributeInfo, err error)
 	// CreateCategoryBrand 创建品牌分类关联
 	CreateCategoryBrand(ctx context.Context, req *CategoryBrandRequest, opts ...http.CallOption) (rsp *CategoryBrandResponse, err error)
 	// CreateGoods 创建商品
 	CreateGoods(ctx context.Context, req *CreateGoodsInfo, opts ...http.CallOption) (rsp *GoodsInfoResponse, err error)
+	// CreateGoodsSku 创建 SKU
+	CreateGoodsSku(ctx context.Context, req *GoodsSkuInfo, opts ...http.CallOption) (rsp *GoodsSkuInfo, err error)
 	// DeleteBanner 删除轮播图
 	DeleteBanner(ctx context.Context, req *BannerRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// DeleteBrand 删除品牌
 	DeleteBrand(ctx context.Context, req *BrandRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// DeleteCategory 删除分类
 	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *Empty, err error)
+	// DeleteCategoryAttribute 删除分类属性模板，同时清除商品上的该属性值
+	DeleteCategoryAttribute(ctx context.Context, req *CategoryAttributeInfo, opts ...http.CallOption) (rsp *Empty, err error)
 	// DeleteCategoryBrand 删除品牌分类关联
 	DeleteCategoryBrand(ctx context.Context, req *CategoryBrandRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// DeleteGoods 删除商品
 	DeleteGoods(ctx context.Context, req *DeleteGoodsInfo, opts ...http.CallOption) (rsp *Empty, err error)
+	// DeleteGoodsSku 删除 SKU
+	DeleteGoodsSku(ctx context.Context, req *GoodsSkuInfo, opts ...http.CallOption) (rsp *Empty, err error)
 	// GetAllCategorysList 获取所有分类列表
 	GetAllCategorysList(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *CategoryListResponse, err error)
 	// GetCategoryBrandList 通过分类获取品牌列表
 	GetCategoryBrandList(ctx context.Context, req *CategoryInfoRequest, opts ...http.CallOption) (rsp *BrandListResponse, err error)
+	// GetGoodsBySn 按商品编号获取商品详情 - 用于仓储、供应商系统对接
+	GetGoodsBySn(ctx context.Context, req *GoodsSnRequest, opts ...http.CallOption) (rsp *GoodsInfoResponse, err error)
+	// GetGoodsCounters 批量获取商品实时计数，包含尚未写入数据库的增量
+	GetGoodsCounters(ctx context.Context, req *BatchGoodsIdInfo, opts ...http.CallOption) (rsp *GoodsCountersResponse, err error)
+	// GetGoodsDescVersion 获取商品描述的指定版本，含描述内容
+	GetGoodsDescVersion(ctx context.Context, req *GoodsDescVersionRequest, opts ...http.CallOption) (rsp *GoodsDescVersionInfo, err error)
 	// GetGoodsDetail 获取商品详情
 	GetGoodsDetail(ctx context.Context, req *GoodInfoRequest, opts ...http.CallOption) (rsp *GoodsInfoResponse, err error)
+	// GetGoodsSynonyms 获取商品搜索同义词词典
+	GetGoodsSynonyms(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *GoodsSynonymsResponse, err error)
+	// GetHotKeywordBlocklist 获取热搜屏蔽词
+	GetHotKeywordBlocklist(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *HotKeywordBlocklist, err error)
+	// GetReindexStatus 查询索引重建进度
+	GetReindexStatus(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *ReindexStatusResponse, err error)
 	// GetSubCategory 获取子分类
 	GetSubCategory(ctx context.Context, req *CategoryListRequest, opts ...http.CallOption) (rsp *SubCategoryListResponse, err error)
+	// GoodsDescVersions 获取商品描述的历史版本，按版本倒序，不含描述内容
+	GoodsDescVersions(ctx context.Context, req *GoodsDescVersionListRequest, opts ...http.CallOption) (rsp *GoodsDescVersionListResponse, err error)
 	// GoodsList 获取商品列表
 	GoodsList(ctx context.Context, req *GoodsFilterRequest, opts ...http.CallOption) (rsp *GoodsListResponse, err error)
+	// GoodsPriceHistory 获取商品价格变动记录，按时间倒序
+	GoodsPriceHistory(ctx context.Context, req *GoodsPriceHistoryRequest, opts ...http.CallOption) (rsp *GoodsPriceHistoryResponse, err error)
+	// GoodsSkuList 获取商品的 SKU 列表
+	GoodsSkuList(ctx context.Context, req *GoodInfoRequest, opts ...http.CallOption) (rsp *GoodsSkuListResponse, err error)
+	// GoodsStatusLogs 获取商品状态流转记录
+	GoodsStatusLogs(ctx context.Context, req *GoodInfoRequest, opts ...http.CallOption) (rsp *GoodsStatusLogListResponse, err error)
+	// HotKeywords 获取热搜关键词 - 用于商城首页
+	HotKeywords(ctx context.Context, req *SearchKeywordsRequest, opts ...http.CallOption) (rsp *SearchKeywordsResponse, err error)
+	// ImportGoods 从 CSV/XLSX 批量导入商品，支持试运行和按商品编号更新
+	ImportGoods(ctx context.Context, req *ImportGoodsRequest, opts ...http.CallOption) (rsp *ImportGoodsResponse, err error)
+	// IncrGoodsCounters 累加商品点击、收藏、销量计数，增量先缓存在 Redis 中定时写入数据库和搜索索引
+	IncrGoodsCounters(ctx context.Context, req *IncrGoodsCountersRequest, opts ...http.CallOption) (rsp *Empty, err error)
+	// RecommendGoods 商品推荐：经常一起购买的在售商品，数据不足时补充同类热销商品
+	RecommendGoods(ctx context.Context, req *GoodsRecommendRequest, opts ...http.CallOption) (rsp *GoodsRecommendResponse, err error)
+	// ReindexGoods 全量重建商品索引
+	// 从 MySQL 分批写入新的版本索引（goods_v2...），完成后原子切换 goods 别名，旧索引保留用于回滚
+	ReindexGoods(ctx context.Context, req *ReindexGoodsRequest, opts ...http.CallOption) (rsp *ReindexStatusResponse, err error)
+	// RollbackGoodsDesc 将商品描述回滚到指定版本，以该版本内容生成新版本
+	RollbackGoodsDesc(ctx context.Context, req *GoodsDescVersionRequest, opts ...http.CallOption) (rsp *GoodsDescVersionInfo, err error)
+	// RollbackGoodsIndex 回滚商品索引
+	// 将 goods 别名切回上一个版本索引
+	RollbackGoodsIndex(ctx context.Context, req *Empty, opts ...http.CallOption) (rsp *GoodsIndexResponse, err error)
+	// ScheduleGoodsSale 设置计划上下架时间，由后台定时任务执行
+	ScheduleGoodsSale(ctx context.Context, req *GoodsScheduleRequest, opts ...http.CallOption) (rsp *GoodsStatusResponse, err error)
+	// SuggestGoods 搜索联想 - 输入时返回商品名称补全以及匹配的分类、品牌
+	SuggestGoods(ctx context.Context, req *SuggestGoodsRequest, opts ...http.CallOption) (rsp *SuggestGoodsResponse, err error)
 	// UpdateBanner 更新轮播图
 	UpdateBanner(ctx context.Context, req *BannerRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateBrand 更新品牌信息
 	UpdateBrand(ctx context.Context, req *BrandRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateCategory 更新分类信息
 	UpdateCategory(ctx context.Context, req *CategoryInfoRequest, opts ...http.CallOption) (rsp *Empty, err error)
+	// UpdateCategoryAttribute 更新分类属性模板，名称和类型创建后不可修改
+	UpdateCategoryAttribute(ctx context.Context, req *CategoryAttributeInfo, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateCategoryBrand 更新品牌分类关联
 	UpdateCategoryBrand(ctx context.Context, req *CategoryBrandRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateGoods 更新商品信息
 	UpdateGoods(ctx context.Context, req *CreateGoodsInfo, opts ...http.CallOption) (rsp *Empty, err error)
+	// UpdateGoodsRating 更新商品评分汇总，以传入的汇总值覆盖
+	UpdateGoodsRating(ctx context.Context, req *GoodsRatingInfo, opts ...http.CallOption) (rsp *Empty, err error)
+	// UpdateGoodsSku 更新 SKU
+	UpdateGoodsSku(ctx context.Context, req *GoodsSkuInfo, opts ...http.CallOption) (rsp *Empty, err error)
+	// UpdateGoodsStatus 商品状态流转：提交审核、审核通过或驳回、上架、下架、归档
+	UpdateGoodsStatus(ctx context.Context, req *GoodsStatusRequest, opts ...http.CallOption) (rsp *GoodsStatusResponse, err error)
+	// UpdateGoodsSynonyms 整体替换商品搜索同义词词典并重新加载搜索分析器
+	UpdateGoodsSynonyms(ctx context.Context, req *GoodsSynonymsRequest, opts ...http.CallOption) (rsp *GoodsSynonymsResponse, err error)
+	// UpdateHotKeywordBlocklist 整体替换热搜屏蔽词
+	UpdateHotKeywordBlocklist(ctx context.Context, req *HotKeywordBlocklist, opts ...http.CallOption) (rsp *HotKeywordBlocklist, err error)
+	// ZeroResultKeywords 获取无结果搜索词 - 用于运营补充商品和同义词
+	ZeroResultKeywords(ctx context.Context, req *SearchKeywordsRequest, opts ...http.CallOption) (rsp *SearchKeywordsResponse, err error)
+}
+
+type GoodsHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewGoodsHTTPClient(client *http.Client) GoodsHTTPClient {
+	return &GoodsHTTPClientImpl{client}
+}
+
+// AdminGoodsList 管理后台商品列表 - 与商品列表相同，设置 includeOffSale 时包含未上架的商品
+func (c *GoodsHTTPClientImpl) AdminGoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...http.CallOption) (*GoodsListResponse, error) {
+	var out GoodsListResponse
+	pattern := "/v1/admin/goods"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsAdminGoodsList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BannerList 获取轮播图列表
+func (c *GoodsHTTPClientImpl) BannerList(ctx context.Context, in *Empty, opts ...http.CallOption) (*BannerListResponse, error) {
+	var out BannerListResponse
+	pattern := "/v1/banners"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsBannerList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchGetGoods 批量获取商品信息 - 用于订单提交时批量查询商品信息
+func (c *GoodsHTTPClientImpl) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...http.CallOption) (*GoodsListResponse, error) {
+	var out GoodsListResponse
+	pattern := "/v1/goods/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsBatchGetGoods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-type GoodsHTTPClientImpl struct {
-	cc *http.Client
+// BatchGetSkus 批量获取 SKU 信息 - 用于购物车、订单按 SKU 查询价格
+func (c *GoodsHTTPClientImpl) BatchGetSkus(ctx context.Context, in *BatchSkuIdInfo, opts ...http.CallOption) (*GoodsSkuListResponse, error) {
+	var out GoodsSkuListResponse
+	pattern := "/v1/goods/skus/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsBatchGetSkus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func NewGoodsHTTPClient(client *http.Client) GoodsHTTPClient {
-	return &GoodsHTTPClientImpl{client}
+// BatchResolveGoodsSn 批量将商品编号解析为商品ID
+func (c *GoodsHTTPClientImpl) BatchResolveGoodsSn(ctx context.Context, in *BatchGoodsSnRequest, opts ...http.CallOption) (*BatchGoodsSnResponse, error) {
+	var out BatchGoodsSnResponse
+	pattern := "/v1/goods/sn/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsBatchResolveGoodsSn))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BrandList 获取品牌列表
+func (c *GoodsHTTPClientImpl) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...http.CallOption) (*BrandListResponse, error) {
+	var out BrandListResponse
+	pattern := "/v1/brands"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsBrandList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CategoryAttributeList 获取分类属性模板，包含从上级分类继承的属性
+func (c *GoodsHTTPClientImpl) CategoryAttributeList(ctx context.Context, in *CategoryListRequest, opts ...http.CallOption) (*CategoryAttributeListResponse, error) {
+	var out CategoryAttributeListResponse
+	pattern := "/v1/categories/{id}/attributes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsCategoryAttributeList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CategoryBrandList 获取品牌分类关联列表
+func (c *GoodsHTTPClientImpl) CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...http.CallOption) (*CategoryBrandListResponse, error) {
+	var out CategoryBrandListResponse
+	pattern := "/v1/category-brands"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsCategoryBrandList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateBanner 创建轮播图
+func (c *GoodsHTTPClientImpl) CreateBanner(ctx context.Context, in *BannerRequest, opts ...http.CallOption) (*BannerResponse, error) {
+	var out BannerResponse
+	pattern := "/v1/banners"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateBanner))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateBrand 创建品牌
+func (c *GoodsHTTPClientImpl) CreateBrand(ctx context.Context, in *BrandRequest, opts ...http.CallOption) (*BrandInfoResponse, error) {
+	var out BrandInfoResponse
+	pattern := "/v1/brands"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCategory 创建分类
+func (c *GoodsHTTPClientImpl) CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...http.CallOption) (*CategoryInfoResponse, error) {
+	var out CategoryInfoResponse
+	pattern := "/v1/categories"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCategoryAttribute 创建分类属性模板
+func (c *GoodsHTTPClientImpl) CreateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...http.CallOption) (*CategoryAttributeInfo, error) {
+	var out CategoryAttributeInfo
+	pattern := "/v1/categories/{categoryId}/attributes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateCategoryAttribute))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCategoryBrand 创建品牌分类关联
+func (c *GoodsHTTPClientImpl) CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...http.CallOption) (*CategoryBrandResponse, error) {
+	var out CategoryBrandResponse
+	pattern := "/v1/category-brands"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateCategoryBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGoods 创建商品
+func (c *GoodsHTTPClientImpl) CreateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...http.CallOption) (*GoodsInfoResponse, error) {
+	var out GoodsInfoResponse
+	pattern := "/v1/goods"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateGoods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGoodsSku 创建 SKU
+func (c *GoodsHTTPClientImpl) CreateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...http.CallOption) (*GoodsSkuInfo, error) {
+	var out GoodsSkuInfo
+	pattern := "/v1/goods/{goodsId}/skus"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsCreateGoodsSku))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteBanner 删除轮播图
+func (c *GoodsHTTPClientImpl) DeleteBanner(ctx context.Context, in *BannerRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/banners/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteBanner))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteBrand 删除品牌
+func (c *GoodsHTTPClientImpl) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/brands/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCategory 删除分类
+func (c *GoodsHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/categories/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCategoryAttribute 删除分类属性模板，同时清除商品上的该属性值
+func (c *GoodsHTTPClientImpl) DeleteCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/categories/attributes/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteCategoryAttribute))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCategoryBrand 删除品牌分类关联
+func (c *GoodsHTTPClientImpl) DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/category-brands/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteCategoryBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGoods 删除商品
+func (c *GoodsHTTPClientImpl) DeleteGoods(ctx context.Context, in *DeleteGoodsInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/goods/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteGoods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGoodsSku 删除 SKU
+func (c *GoodsHTTPClientImpl) DeleteGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/goods/skus/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsDeleteGoodsSku))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllCategorysList 获取所有分类列表
+func (c *GoodsHTTPClientImpl) GetAllCategorysList(ctx context.Context, in *Empty, opts ...http.CallOption) (*CategoryListResponse, error) {
+	var out CategoryListResponse
+	pattern := "/v1/categories"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetAllCategorysList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCategoryBrandList 通过分类获取品牌列表
+func (c *GoodsHTTPClientImpl) GetCategoryBrandList(ctx context.Context, in *CategoryInfoRequest, opts ...http.CallOption) (*BrandListResponse, error) {
+	var out BrandListResponse
+	pattern := "/v1/categories/{id}/brands"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetCategoryBrandList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-// BannerList 获取轮播图列表
-func (c *GoodsHTTPClientImpl) BannerList(ctx context.Context, in *Empty, opts ...http.CallOption) (*BannerListResponse, error) {
-	var out BannerListResponse
-	pattern := "/v1/banners"
+// GetGoodsBySn 按商品编号获取商品详情 - 用于仓储、供应商系统对接
+func (c *GoodsHTTPClientImpl) GetGoodsBySn(ctx context.Context, in *GoodsSnRequest, opts ...http.CallOption) (*GoodsInfoResponse, error) {
+	var out GoodsInfoResponse
+	pattern := "/v1/goods/sn/{goodsSn}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsBannerList))
+	opts = append(opts, http.Operation(OperationGoodsGetGoodsBySn))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -724,12 +2016,12 @@ func (c *GoodsHTTPClientImpl) BannerList(ctx context.Context, in *Empty, opts ..
 	return &out, nil
 }
 
-// BatchGetGoods 批量获取商品信息 - 用于订单提交时批量查询商品信息
-func (c *GoodsHTTPClientImpl) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...http.CallOption) (*GoodsListResponse, error) {
-	var out GoodsListResponse
-	pattern := "/v1/goods/batch"
+// GetGoodsCounters 批量获取商品实时计数，包含尚未写入数据库的增量
+func (c *GoodsHTTPClientImpl) GetGoodsCounters(ctx context.Context, in *BatchGoodsIdInfo, opts ...http.CallOption) (*GoodsCountersResponse, error) {
+	var out GoodsCountersResponse
+	pattern := "/v1/goods/counters/batch"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsBatchGetGoods))
+	opts = append(opts, http.Operation(OperationGoodsGetGoodsCounters))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -738,12 +2030,12 @@ func (c *GoodsHTTPClientImpl) BatchGetGoods(ctx context.Context, in *BatchGoodsI
 	return &out, nil
 }
 
-// BrandList 获取品牌列表
-func (c *GoodsHTTPClientImpl) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...http.CallOption) (*BrandListResponse, error) {
-	var out BrandListResponse
-	pattern := "/v1/brands"
+// GetGoodsDescVersion 获取商品描述的指定版本，含描述内容
+func (c *GoodsHTTPClientImpl) GetGoodsDescVersion(ctx context.Context, in *GoodsDescVersionRequest, opts ...http.CallOption) (*GoodsDescVersionInfo, error) {
+	var out GoodsDescVersionInfo
+	pattern := "/v1/goods/{id}/desc-versions/{version}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsBrandList))
+	opts = append(opts, http.Operation(OperationGoodsGetGoodsDescVersion))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -752,12 +2044,12 @@ func (c *GoodsHTTPClientImpl) BrandList(ctx context.Context, in *BrandFilterRequ
 	return &out, nil
 }
 
-// CategoryBrandList 获取品牌分类关联列表
-func (c *GoodsHTTPClientImpl) CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...http.CallOption) (*CategoryBrandListResponse, error) {
-	var out CategoryBrandListResponse
-	pattern := "/v1/category-brands"
+// GetGoodsDetail 获取商品详情
+func (c *GoodsHTTPClientImpl) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...http.CallOption) (*GoodsInfoResponse, error) {
+	var out GoodsInfoResponse
+	pattern := "/v1/goods/{id}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsCategoryBrandList))
+	opts = append(opts, http.Operation(OperationGoodsGetGoodsDetail))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -766,152 +2058,180 @@ func (c *GoodsHTTPClientImpl) CategoryBrandList(ctx context.Context, in *Categor
 	return &out, nil
 }
 
-// CreateBanner 创建轮播图
-func (c *GoodsHTTPClientImpl) CreateBanner(ctx context.Context, in *BannerRequest, opts ...http.CallOption) (*BannerResponse, error) {
-	var out BannerResponse
-	pattern := "/v1/banners"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsCreateBanner))
+// GetGoodsSynonyms 获取商品搜索同义词词典
+func (c *GoodsHTTPClientImpl) GetGoodsSynonyms(ctx context.Context, in *Empty, opts ...http.CallOption) (*GoodsSynonymsResponse, error) {
+	var out GoodsSynonymsResponse
+	pattern := "/v1/goods/index/synonyms"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetGoodsSynonyms))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// CreateBrand 创建品牌
-func (c *GoodsHTTPClientImpl) CreateBrand(ctx context.Context, in *BrandRequest, opts ...http.CallOption) (*BrandInfoResponse, error) {
-	var out BrandInfoResponse
-	pattern := "/v1/brands"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsCreateBrand))
+// GetHotKeywordBlocklist 获取热搜屏蔽词
+func (c *GoodsHTTPClientImpl) GetHotKeywordBlocklist(ctx context.Context, in *Empty, opts ...http.CallOption) (*HotKeywordBlocklist, error) {
+	var out HotKeywordBlocklist
+	pattern := "/v1/goods/search/blocklist"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetHotKeywordBlocklist))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// CreateCategory 创建分类
-func (c *GoodsHTTPClientImpl) CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...http.CallOption) (*CategoryInfoResponse, error) {
-	var out CategoryInfoResponse
-	pattern := "/v1/categories"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsCreateCategory))
+// GetReindexStatus 查询索引重建进度
+func (c *GoodsHTTPClientImpl) GetReindexStatus(ctx context.Context, in *Empty, opts ...http.CallOption) (*ReindexStatusResponse, error) {
+	var out ReindexStatusResponse
+	pattern := "/v1/goods/index/reindex"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetReindexStatus))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// CreateCategoryBrand 创建品牌分类关联
-func (c *GoodsHTTPClientImpl) CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...http.CallOption) (*CategoryBrandResponse, error) {
-	var out CategoryBrandResponse
-	pattern := "/v1/category-brands"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsCreateCategoryBrand))
+// GetSubCategory 获取子分类
+func (c *GoodsHTTPClientImpl) GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...http.CallOption) (*SubCategoryListResponse, error) {
+	var out SubCategoryListResponse
+	pattern := "/v1/categories/{id}/sub"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGetSubCategory))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// CreateGoods 创建商品
-func (c *GoodsHTTPClientImpl) CreateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...http.CallOption) (*GoodsInfoResponse, error) {
-	var out GoodsInfoResponse
+// GoodsDescVersions 获取商品描述的历史版本，按版本倒序，不含描述内容
+func (c *GoodsHTTPClientImpl) GoodsDescVersions(ctx context.Context, in *GoodsDescVersionListRequest, opts ...http.CallOption) (*GoodsDescVersionListResponse, error) {
+	var out GoodsDescVersionListResponse
+	pattern := "/v1/goods/{id}/desc-versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGoodsDescVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GoodsList 获取商品列表
+func (c *GoodsHTTPClientImpl) GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...http.CallOption) (*GoodsListResponse, error) {
+	var out GoodsListResponse
 	pattern := "/v1/goods"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationGoodsCreateGoods))
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsGoodsList))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeleteBanner 删除轮播图
-func (c *GoodsHTTPClientImpl) DeleteBanner(ctx context.Context, in *BannerRequest, opts ...http.CallOption) (*Empty, error) {
-	var out Empty
-	pattern := "/v1/banners/{id}"
+// GoodsPriceHistory 获取商品价格变动记录，按时间倒序
+func (c *GoodsHTTPClientImpl) GoodsPriceHistory(ctx context.Context, in *GoodsPriceHistoryRequest, opts ...http.CallOption) (*GoodsPriceHistoryResponse, error) {
+	var out GoodsPriceHistoryResponse
+	pattern := "/v1/goods/{id}/price-history"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsDeleteBanner))
+	opts = append(opts, http.Operation(OperationGoodsGoodsPriceHistory))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeleteBrand 删除品牌
-func (c *GoodsHTTPClientImpl) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...http.CallOption) (*Empty, error) {
-	var out Empty
-	pattern := "/v1/brands/{id}"
+// GoodsSkuList 获取商品的 SKU 列表
+func (c *GoodsHTTPClientImpl) GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...http.CallOption) (*GoodsSkuListResponse, error) {
+	var out GoodsSkuListResponse
+	pattern := "/v1/goods/{id}/skus"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsDeleteBrand))
+	opts = append(opts, http.Operation(OperationGoodsGoodsSkuList))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeleteCategory 删除分类
-func (c *GoodsHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*Empty, error) {
-	var out Empty
-	pattern := "/v1/categories/{id}"
+// GoodsStatusLogs 获取商品状态流转记录
+func (c *GoodsHTTPClientImpl) GoodsStatusLogs(ctx context.Context, in *GoodInfoRequest, opts ...http.CallOption) (*GoodsStatusLogListResponse, error) {
+	var out GoodsStatusLogListResponse
+	pattern := "/v1/goods/{id}/status-logs"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsDeleteCategory))
+	opts = append(opts, http.Operation(OperationGoodsGoodsStatusLogs))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeleteCategoryBrand 删除品牌分类关联
-func (c *GoodsHTTPClientImpl) DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...http.CallOption) (*Empty, error) {
-	var out Empty
-	pattern := "/v1/category-brands/{id}"
+// HotKeywords 获取热搜关键词 - 用于商城首页
+func (c *GoodsHTTPClientImpl) HotKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...http.CallOption) (*SearchKeywordsResponse, error) {
+	var out SearchKeywordsResponse
+	pattern := "/v1/goods/search/hot-keywords"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsDeleteCategoryBrand))
+	opts = append(opts, http.Operation(OperationGoodsHotKeywords))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// DeleteGoods 删除商品
-func (c *GoodsHTTPClientImpl) DeleteGoods(ctx context.Context, in *DeleteGoodsInfo, opts ...http.CallOption) (*Empty, error) {
+// ImportGoods 从 CSV/XLSX 批量导入商品，支持试运行和按商品编号更新
+func (c *GoodsHTTPClientImpl) ImportGoods(ctx context.Context, in *ImportGoodsRequest, opts ...http.CallOption) (*ImportGoodsResponse, error) {
+	var out ImportGoodsResponse
+	pattern := "/v1/goods/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsImportGoods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// IncrGoodsCounters 累加商品点击、收藏、销量计数，增量先缓存在 Redis 中定时写入数据库和搜索索引
+func (c *GoodsHTTPClientImpl) IncrGoodsCounters(ctx context.Context, in *IncrGoodsCountersRequest, opts ...http.CallOption) (*Empty, error) {
 	var out Empty
-	pattern := "/v1/goods/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsDeleteGoods))
+	pattern := "/v1/goods/counters"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsIncrGoodsCounters))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetAllCategorysList 获取所有分类列表
-func (c *GoodsHTTPClientImpl) GetAllCategorysList(ctx context.Context, in *Empty, opts ...http.CallOption) (*CategoryListResponse, error) {
-	var out CategoryListResponse
-	pattern := "/v1/categories"
+// RecommendGoods 商品推荐：经常一起购买的在售商品，数据不足时补充同类热销商品
+func (c *GoodsHTTPClientImpl) RecommendGoods(ctx context.Context, in *GoodsRecommendRequest, opts ...http.CallOption) (*GoodsRecommendResponse, error) {
+	var out GoodsRecommendResponse
+	pattern := "/v1/goods/recommend"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsGetAllCategorysList))
+	opts = append(opts, http.Operation(OperationGoodsRecommendGoods))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -920,54 +2240,70 @@ func (c *GoodsHTTPClientImpl) GetAllCategorysList(ctx context.Context, in *Empty
 	return &out, nil
 }
 
-// GetCategoryBrandList 通过分类获取品牌列表
-func (c *GoodsHTTPClientImpl) GetCategoryBrandList(ctx context.Context, in *CategoryInfoRequest, opts ...http.CallOption) (*BrandListResponse, error) {
-	var out BrandListResponse
-	pattern := "/v1/categories/{id}/brands"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsGetCategoryBrandList))
+// ReindexGoods 全量重建商品索引
+// 从 MySQL 分批写入新的版本索引（goods_v2...），完成后原子切换 goods 别名，旧索引保留用于回滚
+func (c *GoodsHTTPClientImpl) ReindexGoods(ctx context.Context, in *ReindexGoodsRequest, opts ...http.CallOption) (*ReindexStatusResponse, error) {
+	var out ReindexStatusResponse
+	pattern := "/v1/goods/index/reindex"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsReindexGoods))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetGoodsDetail 获取商品详情
-func (c *GoodsHTTPClientImpl) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...http.CallOption) (*GoodsInfoResponse, error) {
-	var out GoodsInfoResponse
-	pattern := "/v1/goods/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsGetGoodsDetail))
+// RollbackGoodsDesc 将商品描述回滚到指定版本，以该版本内容生成新版本
+func (c *GoodsHTTPClientImpl) RollbackGoodsDesc(ctx context.Context, in *GoodsDescVersionRequest, opts ...http.CallOption) (*GoodsDescVersionInfo, error) {
+	var out GoodsDescVersionInfo
+	pattern := "/v1/goods/{id}/desc-versions/{version}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsRollbackGoodsDesc))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetSubCategory 获取子分类
-func (c *GoodsHTTPClientImpl) GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...http.CallOption) (*SubCategoryListResponse, error) {
-	var out SubCategoryListResponse
-	pattern := "/v1/categories/{id}/sub"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsGetSubCategory))
+// RollbackGoodsIndex 回滚商品索引
+// 将 goods 别名切回上一个版本索引
+func (c *GoodsHTTPClientImpl) RollbackGoodsIndex(ctx context.Context, in *Empty, opts ...http.CallOption) (*GoodsIndexResponse, error) {
+	var out GoodsIndexResponse
+	pattern := "/v1/goods/index/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsRollbackGoodsIndex))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GoodsList 获取商品列表
-func (c *GoodsHTTPClientImpl) GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...http.CallOption) (*GoodsListResponse, error) {
-	var out GoodsListResponse
-	pattern := "/v1/goods"
+// ScheduleGoodsSale 设置计划上下架时间，由后台定时任务执行
+func (c *GoodsHTTPClientImpl) ScheduleGoodsSale(ctx context.Context, in *GoodsScheduleRequest, opts ...http.CallOption) (*GoodsStatusResponse, error) {
+	var out GoodsStatusResponse
+	pattern := "/v1/goods/{id}/schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsScheduleGoodsSale))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SuggestGoods 搜索联想 - 输入时返回商品名称补全以及匹配的分类、品牌
+func (c *GoodsHTTPClientImpl) SuggestGoods(ctx context.Context, in *SuggestGoodsRequest, opts ...http.CallOption) (*SuggestGoodsResponse, error) {
+	var out SuggestGoodsResponse
+	pattern := "/v1/goods/suggest"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationGoodsGoodsList))
+	opts = append(opts, http.Operation(OperationGoodsSuggestGoods))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -1018,6 +2354,20 @@ func (c *GoodsHTTPClientImpl) UpdateCategory(ctx context.Context, in *CategoryIn
 	return &out, nil
 }
 
+// UpdateCategoryAttribute 更新分类属性模板，名称和类型创建后不可修改
+func (c *GoodsHTTPClientImpl) UpdateCategoryAttribute(ctx context.Context, in *CategoryAttributeInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/categories/attributes/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateCategoryAttribute))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateCategoryBrand 更新品牌分类关联
 func (c *GoodsHTTPClientImpl) UpdateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...http.CallOption) (*Empty, error) {
 	var out Empty
@@ -1045,3 +2395,87 @@ func (c *GoodsHTTPClientImpl) UpdateGoods(ctx context.Context, in *CreateGoodsIn
 	}
 	return &out, nil
 }
+
+// UpdateGoodsRating 更新商品评分汇总，以传入的汇总值覆盖
+func (c *GoodsHTTPClientImpl) UpdateGoodsRating(ctx context.Context, in *GoodsRatingInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/goods/{goodsId}/rating"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateGoodsRating))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGoodsSku 更新 SKU
+func (c *GoodsHTTPClientImpl) UpdateGoodsSku(ctx context.Context, in *GoodsSkuInfo, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/goods/skus/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateGoodsSku))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGoodsStatus 商品状态流转：提交审核、审核通过或驳回、上架、下架、归档
+func (c *GoodsHTTPClientImpl) UpdateGoodsStatus(ctx context.Context, in *GoodsStatusRequest, opts ...http.CallOption) (*GoodsStatusResponse, error) {
+	var out GoodsStatusResponse
+	pattern := "/v1/goods/{id}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateGoodsStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGoodsSynonyms 整体替换商品搜索同义词词典并重新加载搜索分析器
+func (c *GoodsHTTPClientImpl) UpdateGoodsSynonyms(ctx context.Context, in *GoodsSynonymsRequest, opts ...http.CallOption) (*GoodsSynonymsResponse, error) {
+	var out GoodsSynonymsResponse
+	pattern := "/v1/goods/index/synonyms"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateGoodsSynonyms))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateHotKeywordBlocklist 整体替换热搜屏蔽词
+func (c *GoodsHTTPClientImpl) UpdateHotKeywordBlocklist(ctx context.Context, in *HotKeywordBlocklist, opts ...http.CallOption) (*HotKeywordBlocklist, error) {
+	var out HotKeywordBlocklist
+	pattern := "/v1/goods/search/blocklist"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGoodsUpdateHotKeywordBlocklist))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ZeroResultKeywords 获取无结果搜索词 - 用于运营补充商品和同义词
+func (c *GoodsHTTPClientImpl) ZeroResultKeywords(ctx context.Context, in *SearchKeywordsRequest, opts ...http.CallOption) (*SearchKeywordsResponse, error) {
+	var out SearchKeywordsResponse
+	pattern := "/v1/goods/search/zero-result-keywords"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGoodsZeroResultKeywords))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
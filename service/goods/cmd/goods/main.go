@@ -5,7 +5,9 @@ import (
 	"os"
 
 	"mshop/pkg/nacosx"
+	"mshop/service/goods/internal/biz"
 	"mshop/service/goods/internal/conf"
+	"mshop/service/goods/internal/data"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,7 +33,7 @@ func init() {
 	flag.StringVar(&env, "env", "dev", "config path, eg: -env dev")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ix *biz.GoodsIndexer, sc *biz.GoodsSaleScheduler, cf *biz.GoodsCounterFlusher, rc *biz.GoodsRecommender, sr *biz.SearchRecorder) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +43,11 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			ix,
+			sc,
+			cf,
+			rc,
+			sr,
 		),
 	)
 }
@@ -81,7 +88,21 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	// 搜索配置支持热更新，商品运营可在配置中心调整相关度权重
+	search := data.NewSearchConfig(bc.Search)
+	if err := c.Watch("search", func(key string, value config.Value) {
+		var sc conf.Search
+		if err := value.Scan(&sc); err != nil {
+			log.NewHelper(logger).Errorf("failed to reload search config: %v", err)
+			return
+		}
+		search.Store(&sc)
+		log.NewHelper(logger).Info("search config reloaded")
+	}); err != nil {
+		log.NewHelper(logger).Warnf("failed to watch search config: %v", err)
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Goods, bc.Services, search, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, goods *conf.Goods, services *conf.Services, searchConfig *data.SearchConfig, logger log.Logger) (*kratos.App, func(), error) {
 	db, err := data.NewDB(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -32,17 +32,43 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(confData, logger, db, client)
+	redisClient, cleanup, err := data.NewRedisClient(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	goodsRepo := data.NewGoodsRepo(dataData, logger)
-	goodsUsecase := biz.NewGoodsUsecase(db, logger, goodsRepo)
+	dataData, cleanup2, err := data.NewData(confData, logger, db, client, redisClient)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goodsRepo := data.NewGoodsRepo(dataData, searchConfig, logger)
+	goodsSearcher, err := data.NewGoodsSearcher(confData, goodsRepo, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	keywordRepo := data.NewKeywordRepo(dataData, logger)
+	counterRepo := data.NewCounterRepo(dataData, logger)
+	goodsIndexer := biz.NewGoodsIndexer(db, logger, goodsSearcher)
+	locker := data.NewLocker(dataData, logger)
+	searchRecorder := biz.NewSearchRecorder(db, logger, keywordRepo, locker)
+	goodsUsecase := biz.NewGoodsUsecase(db, goods, logger, goodsSearcher, goodsRepo, goodsRepo, keywordRepo, counterRepo, goodsIndexer, searchRecorder)
 	goodsService := service.NewGoodsService(goodsUsecase)
 	grpcServer := server.NewGRPCServer(confServer, goodsService, logger)
 	httpServer := server.NewHTTPServer(confServer, goodsService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	goodsSaleScheduler := biz.NewGoodsSaleScheduler(db, logger, goodsIndexer)
+	goodsCounterFlusher := biz.NewGoodsCounterFlusher(db, logger, counterRepo, locker, goodsIndexer)
+	orderClient, err := data.NewOrderServiceClient(services, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goodsRecommender := biz.NewGoodsRecommender(db, logger, locker, orderClient)
+	app := newApp(logger, grpcServer, httpServer, goodsIndexer, goodsSaleScheduler, goodsCounterFlusher, goodsRecommender, searchRecorder)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,351 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxAttributeNameLen 属性名称最大长度（字符）
+	maxAttributeNameLen = 50
+	// maxAttributeUnitLen 属性单位最大长度（字符）
+	maxAttributeUnitLen = 20
+	// maxAttributeOptions 枚举属性可选值数量上限
+	maxAttributeOptions = 100
+	// maxGoodsAttrValueLen 商品属性值最大长度（字符）
+	maxGoodsAttrValueLen = 200
+)
+
+// CategoryAttributeList 获取分类的属性模板，上级分类的属性在前
+func (uc *GoodsUsecase) CategoryAttributeList(ctx context.Context, req *pb.CategoryListRequest) (resp *pb.CategoryAttributeListResponse, err error) {
+	attributes, err := categoryAttributes(uc.db.WithContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &pb.CategoryAttributeListResponse{
+		Total: int32(len(attributes)),
+		Data:  make([]*pb.CategoryAttributeInfo, 0, len(attributes)),
+	}
+	for _, attribute := range attributes {
+		resp.Data = append(resp.Data, newCategoryAttributeInfo(attribute))
+	}
+	return
+}
+
+// CreateCategoryAttribute 创建分类属性模板
+// 新增的必填属性不影响已有商品，商品下次修改属性或分类时按新模板校验
+func (uc *GoodsUsecase) CreateCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (resp *pb.CategoryAttributeInfo, err error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len([]rune(name)) > maxAttributeNameLen {
+		return nil, errx.ErrorCategoryAttributeInvalid("attribute name must be 1-%d characters", maxAttributeNameLen)
+	}
+	if _, ok := pb.CategoryAttributeType_name[int32(req.Type)]; !ok {
+		return nil, errx.ErrorCategoryAttributeInvalid("unknown attribute type %d", req.Type)
+	}
+
+	now := time.Now()
+	attribute := &CategoryAttribute{
+		CategoryID: req.CategoryId,
+		Name:       name,
+		Type:       int32(req.Type),
+		Required:   req.Required,
+		Sort:       req.Sort,
+		AddTime:    now,
+		UpdateTime: now,
+	}
+	if err := attribute.setOptions(req.Options, req.Unit); err != nil {
+		return nil, err
+	}
+
+	if err := uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		chainIDs, err := categoryChainIDs(tx, req.CategoryId)
+		if err != nil {
+			return err
+		}
+		subtreeIDs, err := categorySubtreeIDs(tx, req.CategoryId)
+		if err != nil {
+			return err
+		}
+
+		// 同一条分类链上属性名称唯一，上级和下级分类都不能存在同名属性
+		var count int64
+		if err := tx.Model(&CategoryAttribute{}).
+			Where("category_id IN ? AND name = ?", append(chainIDs, subtreeIDs...), name).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errx.ErrorCategoryAttributeExists("attribute %s already exists", name)
+		}
+
+		return tx.Create(attribute).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	return newCategoryAttributeInfo(attribute), nil
+}
+
+// UpdateCategoryAttribute 更新分类属性模板的必填、可选值、单位和排序
+// 名称和类型已写入商品属性值，创建后不可修改
+func (uc *GoodsUsecase) UpdateCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (_ *pb.Empty, err error) {
+	var attribute CategoryAttribute
+	if result := uc.db.WithContext(ctx).First(&attribute, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorCategoryAttributeNotFound("category attribute not found")
+	}
+
+	options := req.Options
+	if len(options) == 0 {
+		options = attribute.Options
+	}
+	if err := attribute.setOptions(options, req.Unit); err != nil {
+		return nil, err
+	}
+	attribute.Required = req.Required
+	attribute.Sort = req.Sort
+	attribute.UpdateTime = time.Now()
+
+	if result := uc.db.WithContext(ctx).Save(&attribute); result.Error != nil {
+		return nil, result.Error
+	}
+	return &pb.Empty{}, nil
+}
+
+// DeleteCategoryAttribute 删除分类属性模板，并清除该分类子树下商品的同名属性值
+func (uc *GoodsUsecase) DeleteCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (_ *pb.Empty, err error) {
+	var attribute CategoryAttribute
+	if result := uc.db.WithContext(ctx).First(&attribute, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorCategoryAttributeNotFound("category attribute not found")
+	}
+
+	if err := uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&attribute).Error; err != nil {
+			return err
+		}
+
+		categoryIDs, err := categorySubtreeIDs(tx, attribute.CategoryID)
+		if err != nil {
+			return err
+		}
+		var goods []*Goods
+		if err := tx.Select("id", "attrs").Where("category_id IN ?", categoryIDs).Find(&goods).Error; err != nil {
+			return err
+		}
+
+		goodsIDs := make([]int32, 0)
+		for _, g := range goods {
+			attrs := make(GoodsAttrs, 0, len(g.Attrs))
+			for _, attr := range g.Attrs {
+				if attr.Name != attribute.Name {
+					attrs = append(attrs, attr)
+				}
+			}
+			if len(attrs) == len(g.Attrs) {
+				continue
+			}
+			if err := tx.Model(&Goods{ID: g.ID}).Select("attrs", "update_time").Updates(&Goods{
+				Attrs:      attrs,
+				UpdateTime: time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+			goodsIDs = append(goodsIDs, g.ID)
+		}
+		return uc.indexer.Enqueue(tx, goodsIDs...)
+	}); err != nil {
+		return nil, err
+	}
+	uc.indexer.Notify()
+
+	return &pb.Empty{}, nil
+}
+
+// setOptions 校验并设置枚举可选值和单位，非枚举类型不保存可选值
+func (a *CategoryAttribute) setOptions(options []string, unit string) error {
+	unit = strings.TrimSpace(unit)
+	if len([]rune(unit)) > maxAttributeUnitLen {
+		return errx.ErrorCategoryAttributeInvalid("attribute unit is too long, max %d characters", maxAttributeUnitLen)
+	}
+	a.Unit = unit
+
+	if pb.CategoryAttributeType(a.Type) != pb.CategoryAttributeType_CATEGORY_ATTRIBUTE_ENUM {
+		a.Options = GormList{}
+		return nil
+	}
+	if len(options) == 0 || len(options) > maxAttributeOptions {
+		return errx.ErrorCategoryAttributeInvalid("enum attribute requires 1-%d options", maxAttributeOptions)
+	}
+
+	result := make(GormList, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || len([]rune(option)) > maxGoodsAttrValueLen {
+			return errx.ErrorCategoryAttributeInvalid("enum option must be 1-%d characters", maxGoodsAttrValueLen)
+		}
+		if seen[option] {
+			return errx.ErrorCategoryAttributeInvalid("duplicate enum option %s", option)
+		}
+		seen[option] = true
+		result = append(result, option)
+	}
+	a.Options = result
+	return nil
+}
+
+// categoryChainIDs 返回从顶级分类到指定分类的 ID 链
+func categoryChainIDs(tx *gorm.DB, id int32) ([]int32, error) {
+	if id <= 0 {
+		return nil, errx.ErrorCategoryNotFound("category not found")
+	}
+	chain := make([]int32, 0, 3)
+	for id != 0 {
+		var category Category
+		if result := tx.Select("id", "parent_category_id").First(&category, id); result.RowsAffected == 0 {
+			return nil, errx.ErrorCategoryNotFound("category not found")
+		}
+		chain = append([]int32{category.ID}, chain...)
+		id = category.ParentCategoryID
+		// 防止数据异常时父子关系成环
+		if len(chain) > 10 {
+			return nil, errx.ErrorCategoryParentInvalid("category %d has invalid parent chain", chain[0])
+		}
+	}
+	return chain, nil
+}
+
+// categoryAttributes 返回分类生效的属性模板，包含上级分类的属性
+// 按分类层级从上到下，同一分类内按 sort、id 排序
+func categoryAttributes(tx *gorm.DB, categoryID int32) ([]*CategoryAttribute, error) {
+	chainIDs, err := categoryChainIDs(tx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes []*CategoryAttribute
+	if result := tx.Where("category_id IN ?", chainIDs).Find(&attributes); result.Error != nil {
+		return nil, result.Error
+	}
+
+	depth := make(map[int32]int, len(chainIDs))
+	for i, id := range chainIDs {
+		depth[id] = i
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		a, b := attributes[i], attributes[j]
+		if depth[a.CategoryID] != depth[b.CategoryID] {
+			return depth[a.CategoryID] < depth[b.CategoryID]
+		}
+		if a.Sort != b.Sort {
+			return a.Sort < b.Sort
+		}
+		return a.ID < b.ID
+	})
+	return attributes, nil
+}
+
+// resolveGoodsAttrs 按分类属性模板校验商品属性值，返回按模板顺序排列的规范化结果
+// 值为空的属性视为未填写；数值统一为十进制格式，布尔统一为 true 或 false
+func resolveGoodsAttrs(tx *gorm.DB, categoryID int32, values []*pb.GoodsAttrValue) (GoodsAttrs, error) {
+	attributes, err := categoryAttributes(tx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := make(map[string]string, len(values))
+	for _, v := range values {
+		name := strings.TrimSpace(v.GetName())
+		value := strings.TrimSpace(v.GetValue())
+		if value == "" {
+			continue
+		}
+		if _, ok := input[name]; ok {
+			return nil, errx.ErrorGoodsAttributeInvalid("duplicate attribute %s", name)
+		}
+		input[name] = value
+	}
+
+	attrs := make(GoodsAttrs, 0, len(input))
+	for _, attribute := range attributes {
+		value, ok := input[attribute.Name]
+		if !ok {
+			if attribute.Required {
+				return nil, errx.ErrorGoodsAttributeInvalid("attribute %s is required", attribute.Name)
+			}
+			continue
+		}
+		delete(input, attribute.Name)
+
+		value, err := attribute.normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, GoodsAttr{Name: attribute.Name, Value: value})
+	}
+	for name := range input {
+		return nil, errx.ErrorGoodsAttributeInvalid("attribute %s is not defined for category %d", name, categoryID)
+	}
+	return attrs, nil
+}
+
+// normalize 按属性类型校验并规范化属性值
+func (a *CategoryAttribute) normalize(value string) (string, error) {
+	switch pb.CategoryAttributeType(a.Type) {
+	case pb.CategoryAttributeType_CATEGORY_ATTRIBUTE_NUMBER:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", errx.ErrorGoodsAttributeInvalid("attribute %s must be a number", a.Name)
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	case pb.CategoryAttributeType_CATEGORY_ATTRIBUTE_BOOL:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errx.ErrorGoodsAttributeInvalid("attribute %s must be true or false", a.Name)
+		}
+		return strconv.FormatBool(b), nil
+	case pb.CategoryAttributeType_CATEGORY_ATTRIBUTE_ENUM:
+		for _, option := range a.Options {
+			if option == value {
+				return value, nil
+			}
+		}
+		return "", errx.ErrorGoodsAttributeInvalid("attribute %s must be one of %s", a.Name, strings.Join(a.Options, ", "))
+	default:
+		if len([]rune(value)) > maxGoodsAttrValueLen {
+			return "", errx.ErrorGoodsAttributeInvalid("attribute %s is too long, max %d characters", a.Name, maxGoodsAttrValueLen)
+		}
+		return value, nil
+	}
+}
+
+// newCategoryAttributeInfo 构建分类属性模板响应
+func newCategoryAttributeInfo(a *CategoryAttribute) *pb.CategoryAttributeInfo {
+	return &pb.CategoryAttributeInfo{
+		Id:         a.ID,
+		CategoryId: a.CategoryID,
+		Name:       a.Name,
+		Type:       pb.CategoryAttributeType(a.Type),
+		Required:   a.Required,
+		Options:    a.Options,
+		Unit:       a.Unit,
+		Sort:       a.Sort,
+	}
+}
+
+// newGoodsAttrValues 构建商品属性值响应
+func newGoodsAttrValues(attrs GoodsAttrs) []*pb.GoodsAttrValue {
+	values := make([]*pb.GoodsAttrValue, 0, len(attrs))
+	for _, attr := range attrs {
+		values = append(values, &pb.GoodsAttrValue{Name: attr.Name, Value: attr.Value})
+	}
+	return values
+}
@@ -1,26 +1,47 @@
 package biz
 
 import (
+	"mshop/service/goods/internal/conf"
 	"mshop/service/goods/internal/data"
 
+	"github.com/go-kratos/aegis/circuitbreaker"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGoodsUsecase)
+var ProviderSet = wire.NewSet(NewGoodsUsecase, NewGoodsIndexer, NewGoodsSaleScheduler, NewGoodsCounterFlusher, NewGoodsRecommender, NewSearchRecorder)
 
 type GoodsUsecase struct {
-	db        *gorm.DB
-	log       *log.Helper
-	goodsRepo *data.GoodsRepo
+	db       *gorm.DB
+	conf     *conf.Goods
+	log      *log.Helper
+	searcher data.GoodsSearcher
+	indices  data.GoodsIndexManager
+	synonyms data.GoodsSynonymStore
+	keywords *data.KeywordRepo
+	counters *data.CounterRepo
+	indexer  *GoodsIndexer
+	recorder *SearchRecorder
+	reindex  reindexState
+
+	searchBreaker circuitbreaker.CircuitBreaker
 }
 
-func NewGoodsUsecase(db *gorm.DB, logger log.Logger, goodsRepo *data.GoodsRepo) *GoodsUsecase {
+func NewGoodsUsecase(db *gorm.DB, c *conf.Goods, logger log.Logger, searcher data.GoodsSearcher, indices data.GoodsIndexManager, synonyms data.GoodsSynonymStore, keywords *data.KeywordRepo, counters *data.CounterRepo, indexer *GoodsIndexer, recorder *SearchRecorder) *GoodsUsecase {
 	return &GoodsUsecase{
-		db:        db,
-		log:       log.NewHelper(logger),
-		goodsRepo: goodsRepo,
+		db:       db,
+		conf:     c,
+		log:      log.NewHelper(logger),
+		searcher: searcher,
+		indices:  indices,
+		synonyms: synonyms,
+		keywords: keywords,
+		counters: counters,
+		indexer:  indexer,
+		recorder: recorder,
+
+		searchBreaker: newSearchBreaker(),
 	}
 }
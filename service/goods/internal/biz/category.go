@@ -6,6 +6,8 @@ import (
 	"log"
 	"mshop/pkg/errx"
 	pb "mshop/service/goods/api/goods/v1"
+
+	"gorm.io/gorm"
 )
 
 // GetAllCategorysList 获取所有一级及子分类
@@ -211,11 +213,41 @@ func (uc *GoodsUsecase) UpdateCategory(ctx context.Context, req *pb.CategoryInfo
 	category.Name = req.Name
 	category.IsTab = req.IsTab
 
-	// 6. 更新数据
-	if result := uc.db.Updates(&category); result.Error != nil {
-		log.Printf("[UpdateCategory] update db error: %v", result.Error)
-		return nil, errx.ErrorDatabaseError("db error: %v", result.Error)
+	// 6. 更新数据，分类名称、层级和 isTab 写入了商品索引，同时登记整棵子树下商品的索引任务
+	if err := uc.db.Transaction(func(tx *gorm.DB) error {
+		// 指定更新列，isTab 为 false、父分类为 0 等零值也需写入
+		if err := tx.Model(&category).Select("name", "parent_category_id", "level", "is_tab").Updates(&category).Error; err != nil {
+			return err
+		}
+		categoryIDs, err := categorySubtreeIDs(tx, category.ID)
+		if err != nil {
+			return err
+		}
+		var goodsIDs []int32
+		if err := tx.Model(&Goods{}).Where("category_id IN ?", categoryIDs).Pluck("id", &goodsIDs).Error; err != nil {
+			return err
+		}
+		return uc.indexer.Enqueue(tx, goodsIDs...)
+	}); err != nil {
+		log.Printf("[UpdateCategory] update db error: %v", err)
+		return nil, errx.ErrorDatabaseError("db error: %v", err)
 	}
+	uc.indexer.Notify()
 
 	return &pb.Empty{}, nil
 }
+
+// categorySubtreeIDs 返回分类及其所有后代分类的 ID
+func categorySubtreeIDs(tx *gorm.DB, id int32) ([]int32, error) {
+	ids := []int32{id}
+	parents := []int32{id}
+	for len(parents) > 0 {
+		var children []int32
+		if err := tx.Model(&Category{}).Where("parent_category_id IN ?", parents).Pluck("id", &children).Error; err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		parents = children
+	}
+	return ids, nil
+}
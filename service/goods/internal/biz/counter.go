@@ -0,0 +1,259 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxCounterItems 单次累加计数的商品数量上限
+	maxCounterItems = 100
+	// maxCounterRequestIDLen 幂等键最大长度
+	maxCounterRequestIDLen = 128
+
+	// counterFlushInterval 计数增量写入数据库的间隔
+	counterFlushInterval = 10 * time.Second
+	// counterFlushBatchSize 每批写入的商品数量
+	counterFlushBatchSize = 500
+	// counterFlushLockKey 写入任务锁，多实例同时只有一个实例写入
+	counterFlushLockKey = "goods:counter:flush:lock"
+	// counterFlushLockTTL 写入任务锁的有效期，需大于一轮写入的耗时
+	counterFlushLockTTL = time.Minute
+	// counterFinalFlushTimeout 停止时最后一次写入的超时时间
+	counterFinalFlushTimeout = 5 * time.Second
+)
+
+// IncrGoodsCounters 累加商品点击、收藏、销量计数，增量由 GoodsCounterFlusher 定时写入
+func (s *GoodsUsecase) IncrGoodsCounters(ctx context.Context, req *pb.IncrGoodsCountersRequest) (resp *pb.Empty, err error) {
+	if len(req.Items) > maxCounterItems {
+		return nil, errx.ErrorInvalidParams("too many items, max %d", maxCounterItems)
+	}
+	if len(req.RequestId) > maxCounterRequestIDLen {
+		return nil, errx.ErrorInvalidParams("request id is too long, max %d characters", maxCounterRequestIDLen)
+	}
+
+	// 合并同一商品的增量，保持请求顺序
+	deltas := make([]*data.GoodsCounterDelta, 0, len(req.Items))
+	byID := make(map[int32]*data.GoodsCounterDelta, len(req.Items))
+	for _, item := range req.Items {
+		if item.GoodsId <= 0 {
+			return nil, errx.ErrorInvalidParams("invalid goods id %d", item.GoodsId)
+		}
+		if item.ClickNum < 0 {
+			return nil, errx.ErrorInvalidParams("click num must not be negative")
+		}
+		d, ok := byID[item.GoodsId]
+		if !ok {
+			d = &data.GoodsCounterDelta{GoodsID: item.GoodsId}
+			byID[item.GoodsId] = d
+			deltas = append(deltas, d)
+		}
+		d.Click += int64(item.ClickNum)
+		d.Fav += int64(item.FavNum)
+		d.Sold += int64(item.SoldNum)
+	}
+
+	nonZero := deltas[:0]
+	for _, d := range deltas {
+		if !d.IsZero() {
+			nonZero = append(nonZero, d)
+		}
+	}
+	if len(nonZero) == 0 {
+		return &pb.Empty{}, nil
+	}
+
+	applied, err := s.counters.Incr(ctx, req.RequestId, nonZero)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		s.log.Infof("goods counters request %s already applied, ignored", req.RequestId)
+	}
+	return &pb.Empty{}, nil
+}
+
+// GetGoodsCounters 批量获取商品实时计数，为数据库中的计数加上尚未写入的增量
+// Redis 不可用时只返回数据库中的计数
+func (s *GoodsUsecase) GetGoodsCounters(ctx context.Context, req *pb.BatchGoodsIdInfo) (resp *pb.GoodsCountersResponse, err error) {
+	resp = &pb.GoodsCountersResponse{
+		Data: make([]*pb.GoodsCounterInfo, 0, len(req.Id)),
+	}
+	if len(req.Id) == 0 {
+		return resp, nil
+	}
+
+	var goods []*Goods
+	if result := s.db.WithContext(ctx).Select("id", "click_num", "fav_num", "sold_num").Where("id IN ?", req.Id).Find(&goods); result.Error != nil {
+		return nil, result.Error
+	}
+	goodsMap := make(map[int32]*Goods, len(goods))
+	ids := make([]int32, 0, len(goods))
+	for _, g := range goods {
+		goodsMap[g.ID] = g
+		ids = append(ids, g.ID)
+	}
+
+	pending, err := s.counters.Pending(ctx, ids)
+	if err != nil {
+		s.log.Warnf("failed to get pending goods counters: %v", err)
+		pending = nil
+	}
+
+	seen := make(map[int32]bool, len(req.Id))
+	for _, id := range req.Id {
+		g, ok := goodsMap[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		info := &pb.GoodsCounterInfo{
+			GoodsId:  g.ID,
+			ClickNum: g.ClickNum,
+			FavNum:   g.FavNum,
+			SoldNum:  g.SoldNum,
+		}
+		if d, ok := pending[id]; ok {
+			info.ClickNum = nonNegative(int64(info.ClickNum) + d.Click)
+			info.FavNum = nonNegative(int64(info.FavNum) + d.Fav)
+			info.SoldNum = nonNegative(int64(info.SoldNum) + d.Sold)
+		}
+		resp.Data = append(resp.Data, info)
+	}
+	return resp, nil
+}
+
+// nonNegative 计数不小于 0
+func nonNegative(n int64) int32 {
+	if n < 0 {
+		return 0
+	}
+	return int32(n)
+}
+
+// GoodsCounterFlusher 商品计数写入任务
+// 定期从 Redis 取出计数增量，批量写入数据库并登记索引任务，多实例运行时通过 Redis 锁保证同时只有一个实例写入
+type GoodsCounterFlusher struct {
+	db       *gorm.DB
+	log      *log.Helper
+	counters *data.CounterRepo
+	locker   *data.Locker
+	indexer  *GoodsIndexer
+
+	stop chan struct{}
+}
+
+// NewGoodsCounterFlusher 创建商品计数写入任务
+func NewGoodsCounterFlusher(db *gorm.DB, logger log.Logger, counters *data.CounterRepo, locker *data.Locker, indexer *GoodsIndexer) *GoodsCounterFlusher {
+	return &GoodsCounterFlusher{
+		db:       db,
+		log:      log.NewHelper(log.With(logger, "module", "biz/counter")),
+		counters: counters,
+		locker:   locker,
+		indexer:  indexer,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动写入任务
+func (f *GoodsCounterFlusher) Start(ctx context.Context) error {
+	f.log.Info("goods counter flusher started")
+
+	ticker := time.NewTicker(counterFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-f.stop:
+			// 停止前写入剩余的增量
+			flushCtx, cancel := context.WithTimeout(context.Background(), counterFinalFlushTimeout)
+			f.flush(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+			f.flush(ctx)
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止写入任务
+func (f *GoodsCounterFlusher) Stop(ctx context.Context) error {
+	close(f.stop)
+	f.log.Info("goods counter flusher stopped")
+	return nil
+}
+
+// flush 取出全部待写入的增量并写入数据库
+func (f *GoodsCounterFlusher) flush(ctx context.Context) {
+	unlock, err := f.locker.TryLock(ctx, counterFlushLockKey, counterFlushLockTTL)
+	if err != nil {
+		f.log.Errorf("failed to acquire counter flush lock: %v", err)
+		return
+	}
+	if unlock == nil {
+		// 其他实例正在写入
+		return
+	}
+	defer unlock()
+
+	flushed := 0
+	for {
+		deltas, err := f.counters.Take(ctx, counterFlushBatchSize)
+		if err != nil {
+			f.log.Errorf("failed to take goods counters: %v", err)
+			break
+		}
+		if len(deltas) == 0 {
+			break
+		}
+		if err := f.apply(ctx, deltas); err != nil {
+			// 增量保留在 inflight 中，下一轮重试
+			f.log.Errorf("failed to flush %d goods counters: %v", len(deltas), err)
+			break
+		}
+		if err := f.counters.Ack(ctx); err != nil {
+			// 确认失败时下一轮会重复写入这批增量
+			f.log.Errorf("failed to ack goods counters: %v", err)
+			break
+		}
+		flushed += len(deltas)
+		if len(deltas) < counterFlushBatchSize {
+			break
+		}
+	}
+
+	if flushed > 0 {
+		f.indexer.Notify()
+		f.log.Infof("flushed counters of %d goods", flushed)
+	}
+}
+
+// apply 在一个事务中写入一批增量并登记索引任务
+func (f *GoodsCounterFlusher) apply(ctx context.Context, deltas []*data.GoodsCounterDelta) error {
+	return f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]int32, 0, len(deltas))
+		for _, d := range deltas {
+			if d.IsZero() {
+				continue
+			}
+			if result := tx.Model(&Goods{}).Where("id = ?", d.GoodsID).Updates(map[string]interface{}{
+				"click_num": gorm.Expr("GREATEST(click_num + ?, 0)", d.Click),
+				"fav_num":   gorm.Expr("GREATEST(fav_num + ?, 0)", d.Fav),
+				"sold_num":  gorm.Expr("GREATEST(sold_num + ?, 0)", d.Sold),
+			}); result.Error != nil {
+				return result.Error
+			}
+			ids = append(ids, d.GoodsID)
+		}
+		return f.indexer.Enqueue(tx, ids...)
+	})
+}
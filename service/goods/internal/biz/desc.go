@@ -0,0 +1,418 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	"mshop/pkg/utils"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"golang.org/x/net/html"
+	"gorm.io/gorm"
+)
+
+// 商品描述格式
+const (
+	GoodsDescHTML     = "html"
+	GoodsDescMarkdown = "markdown"
+)
+
+const (
+	// maxGoodsDescSize 商品描述最大字节数
+	maxGoodsDescSize = 256 << 10
+	// maxGoodsDescImages 商品描述中引用图片的最大数量
+	maxGoodsDescImages = 100
+)
+
+// goodsDescAllowedTags 描述中允许的 HTML 标签及其属性，其余标签去除标签保留文本
+var goodsDescAllowedTags = map[string][]string{
+	"p": nil, "br": nil, "hr": nil, "div": nil, "span": nil, "section": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "del": nil, "sub": nil, "sup": nil,
+	"blockquote": nil, "pre": nil, "code": nil,
+	"ul": nil, "ol": nil, "li": nil,
+	"table": nil, "thead": nil, "tbody": nil, "tfoot": nil, "tr": nil,
+	"th":         {"colspan", "rowspan"},
+	"td":         {"colspan", "rowspan"},
+	"figure":     nil,
+	"figcaption": nil,
+	"a":          {"href", "title"},
+	"img":        {"src", "alt", "title", "width", "height"},
+}
+
+// goodsDescDroppedTags 描述中连同内容一起去除的标签
+var goodsDescDroppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "frame": true, "frameset": true,
+	"object": true, "embed": true, "applet": true, "noscript": true, "template": true,
+	"form": true, "textarea": true, "select": true, "svg": true, "math": true,
+}
+
+var (
+	// markdownCodePattern 匹配围栏代码块和行内代码，其中的内容按原文显示，不检查 HTML 和链接
+	markdownCodePattern = regexp.MustCompile("(?s)(?:^|\n) {0,3}```.*?(?:\n {0,3}```|$)|(?:^|\n) {0,3}~~~.*?(?:\n {0,3}~~~|$)|`[^`\n]+`")
+	// markdownLinkPattern 匹配行内链接和图片的地址
+	markdownLinkPattern = regexp.MustCompile(`\[[^\]]*\]\(\s*<?([^)\s>]+)`)
+	// markdownRefPattern 匹配引用式链接定义 [id]: url 的地址
+	markdownRefPattern = regexp.MustCompile(`(?m)^ {0,3}\[[^\]]+\]:\s*<?([^\s>]+)`)
+	// markdownAutolinkPattern 匹配自动链接 <scheme:...> 的地址
+	markdownAutolinkPattern = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9+.\-]{1,31}:[^\s<>]*)>`)
+	// markdownEmailPattern 匹配邮箱自动链接 <user@host>
+	markdownEmailPattern = regexp.MustCompile(`<[^\s<>@:]+@[^\s<>@:]+>`)
+	// markdownHTMLPattern 匹配内嵌 HTML 的标签、注释和声明
+	markdownHTMLPattern = regexp.MustCompile(`<[A-Za-z/!?]`)
+	// markdownImagePattern 匹配行内和引用式图片
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\][(\[]`)
+	// markdownEscapePattern 匹配反斜杠转义的 ASCII 标点
+	markdownEscapePattern = regexp.MustCompile("\\\\([!-/:-@\\[-`{-~])")
+)
+
+// sanitizeGoodsDesc 校验并清理商品描述，返回规范化的格式和内容
+// HTML 只保留白名单中的标签和属性；Markdown 不允许内嵌 HTML，校验通过后原样保存；
+// 两种格式中的链接和图片地址都只允许 http、https 或站内相对地址
+func sanitizeGoodsDesc(format, content string) (string, string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = GoodsDescHTML
+	}
+	if len(content) > maxGoodsDescSize {
+		return "", "", errx.ErrorGoodsDescInvalid("goods description is too large, max %d bytes", maxGoodsDescSize)
+	}
+
+	var images int
+	switch format {
+	case GoodsDescHTML:
+		var err error
+		content, images, err = sanitizeDescHTML(content)
+		if err != nil {
+			return "", "", errx.ErrorGoodsDescInvalid("invalid html: %v", err)
+		}
+	case GoodsDescMarkdown:
+		var err error
+		if images, err = checkDescMarkdown(content); err != nil {
+			return "", "", err
+		}
+	default:
+		return "", "", errx.ErrorGoodsDescInvalid("unsupported description format %q, expected html or markdown", format)
+	}
+	// 转义可能使清理后的内容变大
+	if len(content) > maxGoodsDescSize {
+		return "", "", errx.ErrorGoodsDescInvalid("sanitized goods description is too large, max %d bytes", maxGoodsDescSize)
+	}
+	if images > maxGoodsDescImages {
+		return "", "", errx.ErrorGoodsDescInvalid("too many images in goods description, max %d", maxGoodsDescImages)
+	}
+	return format, content, nil
+}
+
+// checkDescMarkdown 校验 Markdown 描述，返回图片数量
+// 代码以外不允许内嵌 HTML；行内链接、引用式链接定义和自动链接的地址按渲染后的结果校验
+func checkDescMarkdown(content string) (int, error) {
+	text := markdownCodePattern.ReplaceAllString(content, "\n")
+
+	urls := make([]string, 0)
+	for _, pattern := range []*regexp.Regexp{markdownLinkPattern, markdownRefPattern, markdownAutolinkPattern} {
+		for _, m := range pattern.FindAllStringSubmatch(text, -1) {
+			urls = append(urls, m[1])
+		}
+	}
+	for _, raw := range urls {
+		if dest := markdownDest(raw); !safeDescURL(dest) {
+			return 0, errx.ErrorGoodsDescInvalid("unsupported link or image url %q", raw)
+		}
+	}
+
+	// 去除自动链接后仍有标签即为内嵌 HTML
+	rest := markdownAutolinkPattern.ReplaceAllString(text, "")
+	rest = markdownEmailPattern.ReplaceAllString(rest, "")
+	if loc := markdownHTMLPattern.FindStringIndex(rest); loc != nil {
+		end := loc[0] + 32
+		if end > len(rest) {
+			end = len(rest)
+		}
+		return 0, errx.ErrorGoodsDescInvalid("raw html is not allowed in markdown description: %q", strings.ToValidUTF8(rest[loc[0]:end], ""))
+	}
+
+	return len(markdownImagePattern.FindAllString(text, -1)), nil
+}
+
+// markdownDest 还原链接地址中的反斜杠转义和 HTML 实体，得到渲染后实际使用的地址
+func markdownDest(raw string) string {
+	return html.UnescapeString(markdownEscapePattern.ReplaceAllString(raw, "$1"))
+}
+
+// sanitizeDescHTML 按白名单清理 HTML，返回清理后的内容和图片数量
+func sanitizeDescHTML(content string) (string, int, error) {
+	var b strings.Builder
+	images := 0
+	// skip 位于需要连同内容去除的标签内的层数
+	skip := 0
+
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return b.String(), images, nil
+			}
+			return "", 0, z.Err()
+		case html.TextToken:
+			if skip == 0 {
+				b.WriteString(html.EscapeString(string(z.Text())))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			if goodsDescDroppedTags[t.Data] {
+				if tt == html.StartTagToken {
+					skip++
+				}
+				continue
+			}
+			allowed, ok := goodsDescAllowedTags[t.Data]
+			if skip > 0 || !ok {
+				continue
+			}
+			t.Attr = filterDescAttrs(t.Attr, allowed)
+			if t.Data == "img" {
+				if !hasDescAttr(t.Attr, "src") {
+					continue
+				}
+				images++
+			}
+			b.WriteString(t.String())
+		case html.EndTagToken:
+			t := z.Token()
+			if goodsDescDroppedTags[t.Data] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if _, ok := goodsDescAllowedTags[t.Data]; ok && skip == 0 {
+				b.WriteString(t.String())
+			}
+		}
+		// 注释和文档类型声明直接丢弃
+	}
+}
+
+// filterDescAttrs 只保留允许的属性，链接和图片地址不安全时去除该属性
+func filterDescAttrs(attrs []html.Attribute, allowed []string) []html.Attribute {
+	filtered := make([]html.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Namespace != "" || !containsString(allowed, attr.Key) {
+			continue
+		}
+		if (attr.Key == "href" || attr.Key == "src") && !safeDescURL(attr.Val) {
+			continue
+		}
+		filtered = append(filtered, attr)
+	}
+	return filtered
+}
+
+// hasDescAttr 判断是否包含指定属性
+func hasDescAttr(attrs []html.Attribute, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString 判断切片中是否包含 s
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// safeDescURL 判断地址是否为 http、https 或站内相对地址
+func safeDescURL(raw string) bool {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "":
+		// 相对地址和 //host/path 形式的协议相对地址
+		return u.Opaque == ""
+	default:
+		return false
+	}
+}
+
+// saveGoodsDesc 在事务 tx 中写入商品描述并生成新版本
+// 以读取时的版本号作为更新条件，并发修改时只有一个成功
+func saveGoodsDesc(tx *gorm.DB, goods *Goods, format, content, operator, reason string) error {
+	now := time.Now()
+	version := goods.DescVersion + 1
+	result := tx.Model(&Goods{}).
+		Where("id = ? AND desc_version = ?", goods.ID, goods.DescVersion).
+		Updates(map[string]interface{}{
+			"goods_desc":   content,
+			"desc_format":  format,
+			"desc_version": version,
+			"update_time":  now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errx.ErrorGoodsDescVersionConflict("goods description changed concurrently, please retry")
+	}
+
+	if err := tx.Create(&GoodsDescVersion{
+		GoodsID:  goods.ID,
+		Version:  version,
+		Format:   format,
+		Content:  content,
+		Size:     int32(len(content)),
+		Operator: operator,
+		Reason:   reason,
+		AddTime:  now,
+	}).Error; err != nil {
+		return err
+	}
+
+	goods.GoodsDesc = content
+	goods.DescFormat = format
+	goods.DescVersion = version
+	goods.UpdateTime = now
+	return nil
+}
+
+// descOperator 校验修改描述的操作人，可以为空
+func descOperator(operator string) (string, error) {
+	operator = strings.TrimSpace(operator)
+	if len(operator) > maxOperatorLen {
+		return "", errx.ErrorInvalidParams("operator is too long, max %d characters", maxOperatorLen)
+	}
+	return operator, nil
+}
+
+// GoodsDescVersions 获取商品描述的历史版本，按版本倒序，不返回描述内容
+func (s *GoodsUsecase) GoodsDescVersions(ctx context.Context, req *pb.GoodsDescVersionListRequest) (resp *pb.GoodsDescVersionListResponse, err error) {
+	var goods Goods
+	if result := s.db.WithContext(ctx).Select("id", "desc_version").First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	query := s.db.WithContext(ctx).Model(&GoodsDescVersion{}).Where("goods_id = ?", req.Id)
+	var total int64
+	if result := query.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+	var versions []*GoodsDescVersion
+	if result := query.Omit("content").Scopes(utils.Paginate(req.Pages, req.PagePerNums)).Order("version DESC").Find(&versions); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.GoodsDescVersionListResponse{
+		Total: int32(total),
+		Data:  make([]*pb.GoodsDescVersionInfo, 0, len(versions)),
+	}
+	for _, v := range versions {
+		resp.Data = append(resp.Data, newGoodsDescVersionInfo(v, goods.DescVersion))
+	}
+	return
+}
+
+// GetGoodsDescVersion 获取商品描述的指定版本
+func (s *GoodsUsecase) GetGoodsDescVersion(ctx context.Context, req *pb.GoodsDescVersionRequest) (resp *pb.GoodsDescVersionInfo, err error) {
+	var goods Goods
+	if result := s.db.WithContext(ctx).Select("id", "desc_version").First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	var version GoodsDescVersion
+	if result := s.db.WithContext(ctx).Where("goods_id = ? AND version = ?", req.Id, req.Version).Limit(1).Find(&version); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsDescVersionNotFound("goods description version %d not found", req.Version)
+	}
+
+	resp = newGoodsDescVersionInfo(&version, goods.DescVersion)
+	resp.Content = version.Content
+	return
+}
+
+// RollbackGoodsDesc 将商品描述回滚到指定版本，历史版本保持不变，以该版本内容生成新版本
+func (s *GoodsUsecase) RollbackGoodsDesc(ctx context.Context, req *pb.GoodsDescVersionRequest) (resp *pb.GoodsDescVersionInfo, err error) {
+	operator, reason, err := checkOperator(req.Operator, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	var target GoodsDescVersion
+	if result := s.db.WithContext(ctx).Where("goods_id = ? AND version = ?", req.Id, req.Version).Limit(1).Find(&target); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsDescVersionNotFound("goods description version %d not found", req.Version)
+	}
+	if target.Version == goods.DescVersion {
+		return nil, errx.ErrorInvalidParams("version %d is already the current description", target.Version)
+	}
+
+	rollbackReason := fmt.Sprintf("rollback to version %d", target.Version)
+	if reason != "" {
+		rollbackReason += ": " + reason
+	}
+	if len([]rune(rollbackReason)) > maxStatusReasonLen {
+		rollbackReason = string([]rune(rollbackReason)[:maxStatusReasonLen])
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return saveGoodsDesc(tx, &goods, target.Format, target.Content, operator, rollbackReason)
+	}); err != nil {
+		return nil, err
+	}
+	s.log.Infof("goods %d description rolled back to version %d by %s", goods.ID, target.Version, operator)
+
+	return &pb.GoodsDescVersionInfo{
+		GoodsId:  goods.ID,
+		Version:  goods.DescVersion,
+		Format:   goods.DescFormat,
+		Content:  goods.GoodsDesc,
+		Size:     int32(len(goods.GoodsDesc)),
+		Operator: operator,
+		Reason:   rollbackReason,
+		AddTime:  goods.UpdateTime.Unix(),
+		Current:  true,
+	}, nil
+}
+
+// newGoodsDescVersionInfo 构建描述版本信息，不含描述内容
+func newGoodsDescVersionInfo(v *GoodsDescVersion, current int32) *pb.GoodsDescVersionInfo {
+	return &pb.GoodsDescVersionInfo{
+		GoodsId:  v.GoodsID,
+		Version:  v.Version,
+		Format:   v.Format,
+		Size:     v.Size,
+		Operator: v.Operator,
+		Reason:   v.Reason,
+		AddTime:  v.AddTime.Unix(),
+		Current:  v.Version == current,
+	}
+}
@@ -0,0 +1,426 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	// exportBatchSize 每批从数据库读取的商品数量
+	exportBatchSize = 500
+	// exportBufferSize 导出写缓冲大小
+	exportBufferSize = 32 << 10
+	// exportSheetName 导出 xlsx 的工作表名称
+	exportSheetName = "Sheet1"
+)
+
+// goodsExportContentTypes 导出格式对应的 Content-Type
+var goodsExportContentTypes = map[string]string{
+	GoodsFileCSV:    "text/csv; charset=utf-8",
+	GoodsFileXLSX:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	GoodsFileNDJSON: "application/x-ndjson",
+}
+
+// GoodsExportFileInfo 返回导出文件名和 Content-Type，format 为空时为 csv
+func GoodsExportFileInfo(format string) (fileName, contentType string, err error) {
+	format, err = goodsExportFormat(format)
+	if err != nil {
+		return "", "", err
+	}
+	fileName = fmt.Sprintf("goods-%s.%s", time.Now().Format("20060102150405"), format)
+	return fileName, goodsExportContentTypes[format], nil
+}
+
+// goodsExportFormat 校验导出格式
+func goodsExportFormat(format string) (string, error) {
+	if format == "" {
+		return GoodsFileCSV, nil
+	}
+	f := strings.ToLower(format)
+	if _, ok := goodsExportContentTypes[f]; !ok {
+		return "", errx.ErrorInvalidParams("unsupported export format %q, expected csv, xlsx or ndjson", format)
+	}
+	return f, nil
+}
+
+// ExportGoods 按过滤条件导出商品并写入 w
+// 按商品 ID 游标分批读取，内存占用与商品总数无关；表格格式的列与导入一致，可修改后重新导入
+func (s *GoodsUsecase) ExportGoods(ctx context.Context, req *pb.ExportGoodsRequest, w io.Writer) error {
+	format, err := goodsExportFormat(req.Format)
+	if err != nil {
+		return err
+	}
+	filter := &pb.GoodsFilterRequest{}
+	if req.Filter != nil {
+		filter = proto.Clone(req.Filter).(*pb.GoodsFilterRequest)
+	}
+	// 导出默认包含未上架的商品
+	filter.IncludeOffSale = !req.OnSaleOnly
+
+	// 写入前完成全部校验，出错时调用方仍可返回错误响应
+	query, err := s.goodsFilterQuery(ctx, filter)
+	if err != nil {
+		return err
+	}
+	query = query.Session(&gorm.Session{})
+
+	var writer goodsExportWriter
+	switch format {
+	case GoodsFileNDJSON:
+		writer = newNDJSONExportWriter(w)
+	default:
+		attrNames, err := s.exportAttrNames(ctx, filter.TopCategory)
+		if err != nil {
+			return err
+		}
+		if format == GoodsFileXLSX {
+			writer, err = newXLSXExportWriter(w, attrNames)
+		} else {
+			writer, err = newCSVExportWriter(w, attrNames)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	total := 0
+	var lastID int32
+	for {
+		var goods []*Goods
+		if result := query.Omit("goods_desc").Preload("Category").Preload("Brand").
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(exportBatchSize).
+			Find(&goods); result.Error != nil {
+			writer.Abort()
+			return result.Error
+		}
+
+		for _, g := range goods {
+			if err := writer.Write(g); err != nil {
+				writer.Abort()
+				return err
+			}
+			lastID = g.ID
+		}
+		total += len(goods)
+
+		if len(goods) < exportBatchSize {
+			break
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	s.log.Infof("goods export finished: format=%s total=%d", format, total)
+	return nil
+}
+
+// exportAttrNames 返回导出的分类属性列
+// 指定分类时为该分类及其上级、下级分类的属性，否则为全部属性，同名属性只导出一列
+func (s *GoodsUsecase) exportAttrNames(ctx context.Context, categoryID int32) ([]string, error) {
+	db := s.db.WithContext(ctx)
+	query := db.Model(&CategoryAttribute{})
+	if categoryID > 0 {
+		chainIDs, err := categoryChainIDs(db, categoryID)
+		if err != nil {
+			return nil, err
+		}
+		subtreeIDs, err := categorySubtreeIDs(db, categoryID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("category_id IN ?", append(chainIDs, subtreeIDs...))
+	}
+
+	var names []string
+	if result := query.Order("sort, id").Pluck("name", &names); result.Error != nil {
+		return nil, result.Error
+	}
+
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique, nil
+}
+
+// goodsExportWriter 按格式写出商品
+type goodsExportWriter interface {
+	// Write 写出一个商品
+	Write(g *Goods) error
+	// Close 写出剩余内容
+	Close() error
+	// Abort 导出失败时释放资源
+	Abort()
+}
+
+// goodsFileTitles 返回表格格式的表头
+func goodsFileTitles(attrNames []string) []string {
+	titles := make([]string, 0, len(goodsFileColumns)+len(attrNames))
+	for _, col := range goodsFileColumns {
+		titles = append(titles, col.title)
+	}
+	for _, name := range attrNames {
+		titles = append(titles, goodsAttrColumnPrefixes[1]+name)
+	}
+	return titles
+}
+
+// goodsFileRow 返回商品在表格中的一行，列顺序与 goodsFileTitles 一致
+func goodsFileRow(g *Goods, attrNames []string) []string {
+	row := make([]string, 0, len(goodsFileColumns)+len(attrNames))
+	for _, col := range goodsFileColumns {
+		var v string
+		switch col.key {
+		case goodsColID:
+			v = strconv.Itoa(int(g.ID))
+		case goodsColGoodsSn:
+			v = g.GoodsSn
+		case goodsColName:
+			v = g.Name
+		case goodsColCategory:
+			if g.Category != nil {
+				v = g.Category.Name
+			}
+		case goodsColBrand:
+			if g.Brand != nil {
+				v = g.Brand.Name
+			}
+		case goodsColStocks:
+			v = strconv.Itoa(int(g.Stocks))
+		case goodsColMarketPrice:
+			v = formatFilePrice(g.MarketPrice)
+		case goodsColShopPrice:
+			v = formatFilePrice(g.ShopPrice)
+		case goodsColGoodsBrief:
+			v = g.GoodsBrief
+		case goodsColShipFree:
+			v = formatFileBool(g.ShipFree)
+		case goodsColImages:
+			v = strings.Join(g.Images, goodsImageSeparator)
+		case goodsColDescImages:
+			v = strings.Join(g.DescImages, goodsImageSeparator)
+		case goodsColGoodsFrontImage:
+			v = g.GoodsFrontImage
+		case goodsColIsNew:
+			v = formatFileBool(g.IsNew)
+		case goodsColIsHot:
+			v = formatFileBool(g.IsHot)
+		case goodsColStatus:
+			v = goodsStatus(g).String()
+		}
+		row = append(row, v)
+	}
+
+	attrs := make(map[string]string, len(g.Attrs))
+	for _, attr := range g.Attrs {
+		attrs[attr.Name] = attr.Value
+	}
+	for _, name := range attrNames {
+		row = append(row, attrs[name])
+	}
+	return row
+}
+
+// formatFilePrice 格式化价格列
+func formatFilePrice(price float32) string {
+	return strconv.FormatFloat(float64(price), 'f', -1, 32)
+}
+
+// formatFileBool 格式化布尔列
+func formatFileBool(b bool) string {
+	if b {
+		return "是"
+	}
+	return "否"
+}
+
+// csvExportWriter 导出 CSV，带 UTF-8 BOM 以便 Excel 正确识别编码
+type csvExportWriter struct {
+	buf       *bufio.Writer
+	w         *csv.Writer
+	attrNames []string
+}
+
+// newCSVExportWriter 创建 CSV 导出并写入表头
+func newCSVExportWriter(w io.Writer, attrNames []string) (*csvExportWriter, error) {
+	buf := bufio.NewWriterSize(w, exportBufferSize)
+	if _, err := buf.WriteString("\ufeff"); err != nil {
+		return nil, err
+	}
+	cw := &csvExportWriter{buf: buf, w: csv.NewWriter(buf), attrNames: attrNames}
+	if err := cw.w.Write(goodsFileTitles(attrNames)); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// Write 实现 goodsExportWriter
+func (cw *csvExportWriter) Write(g *Goods) error {
+	return cw.w.Write(goodsFileRow(g, cw.attrNames))
+}
+
+// Close 实现 goodsExportWriter
+func (cw *csvExportWriter) Close() error {
+	cw.w.Flush()
+	if err := cw.w.Error(); err != nil {
+		return err
+	}
+	return cw.buf.Flush()
+}
+
+// Abort 实现 goodsExportWriter
+func (cw *csvExportWriter) Abort() {}
+
+// xlsxExportWriter 导出 xlsx，行数据由 excelize 流式写入临时文件，结束时一次写出
+type xlsxExportWriter struct {
+	w         io.Writer
+	f         *excelize.File
+	sw        *excelize.StreamWriter
+	attrNames []string
+	row       int
+}
+
+// newXLSXExportWriter 创建 xlsx 导出并写入表头
+func newXLSXExportWriter(w io.Writer, attrNames []string) (*xlsxExportWriter, error) {
+	f := excelize.NewFile()
+	sw, err := f.NewStreamWriter(exportSheetName)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	xw := &xlsxExportWriter{w: w, f: f, sw: sw, attrNames: attrNames}
+	if err := xw.writeRow(goodsFileTitles(attrNames)); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return xw, nil
+}
+
+// writeRow 写入一行，单元格均为文本，避免商品编号等被识别为数字
+func (xw *xlsxExportWriter) writeRow(values []string) error {
+	if xw.row >= excelize.TotalRows {
+		return errx.ErrorInvalidParams("too many goods for xlsx, max %d rows, use csv or ndjson instead", excelize.TotalRows-1)
+	}
+	xw.row++
+	cells := make([]interface{}, len(values))
+	for i, v := range values {
+		cells[i] = v
+	}
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	return xw.sw.SetRow(cell, cells)
+}
+
+// Write 实现 goodsExportWriter
+func (xw *xlsxExportWriter) Write(g *Goods) error {
+	return xw.writeRow(goodsFileRow(g, xw.attrNames))
+}
+
+// Close 实现 goodsExportWriter
+func (xw *xlsxExportWriter) Close() error {
+	defer xw.f.Close()
+	if err := xw.sw.Flush(); err != nil {
+		return err
+	}
+	buf := bufio.NewWriterSize(xw.w, exportBufferSize)
+	if err := xw.f.Write(buf); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// Abort 实现 goodsExportWriter，删除临时文件
+func (xw *xlsxExportWriter) Abort() {
+	xw.f.Close()
+}
+
+// ndjsonExportWriter 导出 NDJSON，每行一个商品，字段与商品详情一致
+type ndjsonExportWriter struct {
+	buf  *bufio.Writer
+	opts protojson.MarshalOptions
+}
+
+// newNDJSONExportWriter 创建 NDJSON 导出
+func newNDJSONExportWriter(w io.Writer) *ndjsonExportWriter {
+	return &ndjsonExportWriter{
+		buf:  bufio.NewWriterSize(w, exportBufferSize),
+		opts: protojson.MarshalOptions{EmitUnpopulated: true},
+	}
+}
+
+// Write 实现 goodsExportWriter
+func (nw *ndjsonExportWriter) Write(g *Goods) error {
+	info := &pb.GoodsInfoResponse{
+		Id:              g.ID,
+		CategoryId:      g.CategoryID,
+		Name:            g.Name,
+		GoodsSn:         g.GoodsSn,
+		ClickNum:        g.ClickNum,
+		SoldNum:         g.SoldNum,
+		FavNum:          g.FavNum,
+		Stocks:          g.Stocks,
+		RatingAvg:       g.RatingAvg,
+		RatingCount:     g.RatingCount,
+		MarketPrice:     g.MarketPrice,
+		ShopPrice:       g.ShopPrice,
+		GoodsBrief:      g.GoodsBrief,
+		ShipFree:        g.ShipFree,
+		Images:          g.Images,
+		DescImages:      g.DescImages,
+		GoodsFrontImage: g.GoodsFrontImage,
+		IsNew:           g.IsNew,
+		IsHot:           g.IsHot,
+		OnSale:          g.OnSale,
+		AddTime:         g.AddTime.Unix(),
+		Attrs:           newGoodsAttrValues(g.Attrs),
+		Status:          goodsStatus(g),
+		OnSaleTime:      unixOrZero(g.OnSaleAt),
+		OffSaleTime:     unixOrZero(g.OffSaleAt),
+	}
+	if g.Category != nil {
+		info.Category = &pb.CategoryBriefInfoResponse{Id: g.Category.ID, Name: g.Category.Name}
+	}
+	if g.Brand != nil {
+		info.Brand = &pb.BrandInfoResponse{Id: g.Brand.ID, Name: g.Brand.Name, Logo: g.Brand.Logo}
+	}
+
+	line, err := nw.opts.Marshal(info)
+	if err != nil {
+		return err
+	}
+	if _, err := nw.buf.Write(line); err != nil {
+		return err
+	}
+	return nw.buf.WriteByte('\n')
+}
+
+// Close 实现 goodsExportWriter
+func (nw *ndjsonExportWriter) Close() error {
+	return nw.buf.Flush()
+}
+
+// Abort 实现 goodsExportWriter
+func (nw *ndjsonExportWriter) Abort() {}
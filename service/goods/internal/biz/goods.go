@@ -2,25 +2,60 @@ package biz
 
 import (
 	"context"
+	"strings"
+	"time"
+
 	"mshop/pkg/errx"
 	pb "mshop/service/goods/api/goods/v1"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
-// GoodsList 商品列表查询
-// 使用 ES 进行搜索获取商品 ID，然后在 MySQL 中查询完整数据
+// goodsManagedColumns 由发布流程、描述版本、计数写入和评分同步单独维护的列，整行保存商品时忽略
+var goodsManagedColumns = []string{"status", "on_sale", "on_sale_at", "off_sale_at", "goods_desc", "desc_format", "desc_version",
+	"click_num", "fav_num", "sold_num", "rating_avg", "rating_count"}
+
+// GoodsList 商品列表查询，只返回在售商品
 func (s *GoodsUsecase) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest) (resp *pb.GoodsListResponse, err error) {
+	filter := proto.Clone(req).(*pb.GoodsFilterRequest)
+	filter.IncludeOffSale = false
+
+	resp, err = s.goodsList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	s.recordSearch(filter, int64(resp.Total))
+	return resp, nil
+}
+
+// AdminGoodsList 管理后台商品列表查询，设置 includeOffSale 时包含未上架的商品，不计入搜索词统计
+func (s *GoodsUsecase) AdminGoodsList(ctx context.Context, req *pb.GoodsFilterRequest) (resp *pb.GoodsListResponse, err error) {
+	return s.goodsList(ctx, req)
+}
+
+// goodsList 使用 ES 进行搜索获取商品 ID，然后在 MySQL 中查询完整数据
+func (s *GoodsUsecase) goodsList(ctx context.Context, req *pb.GoodsFilterRequest) (resp *pb.GoodsListResponse, err error) {
 	resp = &pb.GoodsListResponse{
 		Data: make([]*pb.GoodsInfoResponse, 0),
 	}
 
-	// 1. 先从 ES 搜索获取商品 ID 列表
-	goodsIDs, total, err := s.goodsRepo.SearchGoodsIDs(ctx, req)
+	// 1. 先从 ES 搜索获取商品 ID 列表，ES 不可用时降级为 MySQL 搜索
+	result, degraded, err := s.searchGoods(ctx, req)
 	if err != nil {
-		s.log.Errorf("failed to search goods from ES: %v", err)
+		s.log.Errorf("failed to search goods: %v", err)
 		return nil, err
 	}
 
-	resp.Total = int32(total)
+	goodsIDs := result.IDs
+	resp.Total = int32(result.Total)
+	resp.Facets = result.Facets
+	resp.Degraded = degraded
+	resp.NextPageToken = result.NextPageToken
+	if err := s.fillFacetNames(ctx, resp.Facets); err != nil {
+		s.log.Errorf("failed to fill facet names: %v", err)
+		return nil, err
+	}
 
 	// 如果没有搜索结果，直接返回空列表
 	if len(goodsIDs) == 0 {
@@ -30,15 +65,11 @@ func (s *GoodsUsecase) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest
 	// 2. 根据 ID 列表从 MySQL 查询完整的商品信息
 	var goods []Goods
 	query := s.db.Model(&Goods{}).
+		Omit("goods_desc").
 		Preload("Category").
 		Preload("Brand").
 		Where("id IN ?", goodsIDs)
 
-	// 品牌过滤（ES 中可能没有品牌信息，需要在 MySQL 中再次过滤）
-	if req.Brand > 0 {
-		query = query.Where("brand_id = ?", req.Brand)
-	}
-
 	if result := query.Find(&goods); result.Error != nil {
 		s.log.Errorf("failed to query goods from MySQL: %v", result.Error)
 		return nil, result.Error
@@ -64,7 +95,6 @@ func (s *GoodsUsecase) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest
 			ShopPrice:       good.ShopPrice,
 			MarketPrice:     good.MarketPrice,
 			GoodsBrief:      good.GoodsBrief,
-			GoodsDesc:       good.GoodsSn,
 			GoodsSn:         good.GoodsSn,
 			Images:          good.Images,
 			DescImages:      good.DescImages,
@@ -77,6 +107,13 @@ func (s *GoodsUsecase) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest
 			ClickNum:        good.ClickNum,
 			SoldNum:         good.SoldNum,
 			FavNum:          good.FavNum,
+			Attrs:           newGoodsAttrValues(good.Attrs),
+			Status:          goodsStatus(good),
+			OnSaleTime:      unixOrZero(good.OnSaleAt),
+			OffSaleTime:     unixOrZero(good.OffSaleAt),
+			Stocks:          good.Stocks,
+			RatingAvg:       good.RatingAvg,
+			RatingCount:     good.RatingCount,
 		}
 
 		if good.Category != nil {
@@ -94,16 +131,154 @@ func (s *GoodsUsecase) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest
 			}
 		}
 
+		goodsInfo.Highlight = result.Highlights[good.ID]
+
 		resp.Data = append(resp.Data, goodsInfo)
 	}
 
 	return resp, nil
 }
 
+const (
+	// defaultSuggestSize 默认商品联想数量
+	defaultSuggestSize = 10
+	// maxSuggestSize 商品联想数量上限
+	maxSuggestSize = 20
+	// suggestNameSize 分类、品牌联想数量
+	suggestNameSize = 5
+	// maxSuggestPrefixLen 联想前缀最大长度（字符）
+	maxSuggestPrefixLen = 50
+)
+
+// SuggestGoods 搜索联想，返回在售商品名称补全以及名称前缀匹配的分类和品牌
+func (s *GoodsUsecase) SuggestGoods(ctx context.Context, req *pb.SuggestGoodsRequest) (resp *pb.SuggestGoodsResponse, err error) {
+	resp = &pb.SuggestGoodsResponse{
+		Goods:      make([]*pb.GoodsSuggestion, 0),
+		Categories: make([]*pb.CategoryBriefInfoResponse, 0),
+		Brands:     make([]*pb.BrandInfoResponse, 0),
+	}
+
+	prefix := strings.TrimSpace(req.Q)
+	if prefix == "" {
+		return resp, nil
+	}
+	if runes := []rune(prefix); len(runes) > maxSuggestPrefixLen {
+		prefix = string(runes[:maxSuggestPrefixLen])
+	}
+
+	size := int(req.Size)
+	switch {
+	case size <= 0:
+		size = defaultSuggestSize
+	case size > maxSuggestSize:
+		size = maxSuggestSize
+	}
+
+	// 1. 商品名称补全
+	resp.Goods, err = s.searcher.SuggestGoods(ctx, prefix, size)
+	if err != nil {
+		s.log.Errorf("failed to suggest goods from ES: %v", err)
+		return nil, errx.ErrorSearchUnavailable("suggest goods: %v", err)
+	}
+
+	// 2. 分类、品牌名称前缀匹配，name 列上有索引
+	like := likePrefix(prefix)
+
+	var categories []Category
+	if result := s.db.WithContext(ctx).Select("id", "name").
+		Where("name LIKE ?", like).
+		Order("level").
+		Limit(suggestNameSize).
+		Find(&categories); result.Error != nil {
+		s.log.Errorf("failed to suggest categories: %v", result.Error)
+		return nil, errx.ErrorDatabaseError("db error: %v", result.Error)
+	}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryBriefInfoResponse{
+			Id:   c.ID,
+			Name: c.Name,
+		})
+	}
+
+	var brands []Brands
+	if result := s.db.WithContext(ctx).Select("id", "name", "logo").
+		Where("name LIKE ?", like).
+		Limit(suggestNameSize).
+		Find(&brands); result.Error != nil {
+		s.log.Errorf("failed to suggest brands: %v", result.Error)
+		return nil, errx.ErrorDatabaseError("db error: %v", result.Error)
+	}
+	for _, b := range brands {
+		resp.Brands = append(resp.Brands, &pb.BrandInfoResponse{
+			Id:   b.ID,
+			Name: b.Name,
+			Logo: b.Logo,
+		})
+	}
+
+	return resp, nil
+}
+
+// likePrefix 构建前缀匹配的 LIKE 模式
+func likePrefix(prefix string) string {
+	return escapeLike(prefix) + "%"
+}
+
+// escapeLike 转义 LIKE 通配符
+func escapeLike(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s)
+}
+
+// fillFacetNames 从 MySQL 补全聚合结果中的品牌和分类名称
+func (s *GoodsUsecase) fillFacetNames(ctx context.Context, facets *pb.GoodsFacets) error {
+	if facets == nil {
+		return nil
+	}
+
+	if len(facets.Brands) > 0 {
+		ids := make([]int32, 0, len(facets.Brands))
+		for _, b := range facets.Brands {
+			ids = append(ids, b.Id)
+		}
+		var brands []Brands
+		if result := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&brands); result.Error != nil {
+			return result.Error
+		}
+		names := make(map[int32]string, len(brands))
+		for _, b := range brands {
+			names[b.ID] = b.Name
+		}
+		for _, b := range facets.Brands {
+			b.Name = names[b.Id]
+		}
+	}
+
+	if len(facets.Categories) > 0 {
+		ids := make([]int32, 0, len(facets.Categories))
+		for _, c := range facets.Categories {
+			ids = append(ids, c.Id)
+		}
+		var categories []Category
+		if result := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&categories); result.Error != nil {
+			return result.Error
+		}
+		names := make(map[int32]string, len(categories))
+		for _, c := range categories {
+			names[c.ID] = c.Name
+		}
+		for _, c := range facets.Categories {
+			c.Name = names[c.Id]
+		}
+	}
+
+	return nil
+}
+
 func (s *GoodsUsecase) BatchGetGoods(ctx context.Context, req *pb.BatchGoodsIdInfo) (resp *pb.GoodsListResponse, err error) {
 
 	goods := make([]Goods, 0)
-	if result := s.db.Preload("Category").Preload("Brand").Find(&goods, req.Id); result.Error != nil {
+	if result := s.db.Omit("goods_desc").Preload("Category").Preload("Brand").Preload("Skus", skuOrder).Find(&goods, req.Id); result.Error != nil {
 		return nil, result.Error
 	}
 
@@ -132,19 +307,35 @@ func (s *GoodsUsecase) BatchGetGoods(ctx context.Context, req *pb.BatchGoodsIdIn
 			OnSale:          good.OnSale,
 			AddTime:         good.AddTime.Unix(),
 			GoodsBrief:      good.GoodsBrief,
-			GoodsDesc:       good.GoodsSn,
 			ShipFree:        good.ShipFree,
 			ClickNum:        good.ClickNum,
 			SoldNum:         good.SoldNum,
 			FavNum:          good.FavNum,
 			MarketPrice:     good.MarketPrice,
 			GoodsSn:         good.GoodsSn,
+			Skus:            newGoodsSkuInfos(&good),
+			Attrs:           newGoodsAttrValues(good.Attrs),
+			Status:          goodsStatus(&good),
+			OnSaleTime:      unixOrZero(good.OnSaleAt),
+			OffSaleTime:     unixOrZero(good.OffSaleAt),
+			Stocks:          good.Stocks,
+			RatingAvg:       good.RatingAvg,
+			RatingCount:     good.RatingCount,
 		})
 	}
 
 	return
 }
 func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo) (resp *pb.GoodsInfoResponse, err error) {
+	// 商品编号必填且在未删除的商品中唯一
+	goodsSn := strings.TrimSpace(req.GoodsSn)
+	if goodsSn == "" {
+		return nil, errx.ErrorInvalidParams("goods sn is required")
+	}
+	if err := checkGoodsSn(s.db, goodsSn, 0); err != nil {
+		return nil, err
+	}
+
 	// 检查分类是否存在
 	var category Category
 	if result := s.db.First(&category, req.CategoryId); result.RowsAffected == 0 {
@@ -157,10 +348,26 @@ func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		return nil, errx.ErrorBrandNotFound("brand not found")
 	}
 
-	// 创建商品
+	// 按分类属性模板校验属性值
+	attrs, err := resolveGoodsAttrs(s.db, req.CategoryId, req.Attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 清理商品描述，有描述时生成第一个版本
+	descFormat, desc, err := sanitizeGoodsDesc(req.DescFormat, req.GoodsDesc)
+	if err != nil {
+		return nil, err
+	}
+	descOp, err := descOperator(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// 创建商品，新商品为草稿状态，经审核后上架
 	goods := &Goods{
 		Name:            req.Name,
-		GoodsSn:         req.GoodsSn,
+		GoodsSn:         goodsSn,
 		Stocks:          req.Stocks,
 		MarketPrice:     req.MarketPrice,
 		ShopPrice:       req.ShopPrice,
@@ -171,14 +378,29 @@ func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		GoodsFrontImage: req.GoodsFrontImage,
 		IsNew:           req.IsNew,
 		IsHot:           req.IsHot,
-		OnSale:          req.OnSale,
+		Status:          int32(pb.GoodsStatus_GOODS_STATUS_DRAFT),
 		CategoryID:      req.CategoryId,
 		BrandID:         req.BrandId,
+		Attrs:           attrs,
+		AddTime:         time.Now(),
+		UpdateTime:      time.Now(),
 	}
 
-	if result := s.db.Create(goods); result.Error != nil {
-		return nil, result.Error
+	// 商品与索引任务在同一事务中写入
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(goods).Error; err != nil {
+			return goodsSnError(err, goods.GoodsSn)
+		}
+		if desc != "" {
+			if err := saveGoodsDesc(tx, goods, descFormat, desc, descOp, ""); err != nil {
+				return err
+			}
+		}
+		return s.indexer.Enqueue(tx, goods.ID)
+	}); err != nil {
+		return nil, err
 	}
+	s.indexer.Notify()
 
 	// 预加载关联数据
 	s.db.Preload("Category").Preload("Brand").First(goods, goods.ID)
@@ -191,7 +413,9 @@ func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		ShopPrice:       goods.ShopPrice,
 		MarketPrice:     goods.MarketPrice,
 		GoodsBrief:      goods.GoodsBrief,
-		GoodsDesc:       goods.GoodsSn,
+		GoodsDesc:       goods.GoodsDesc,
+		DescFormat:      goods.DescFormat,
+		DescVersion:     goods.DescVersion,
 		ShipFree:        goods.ShipFree,
 		Images:          goods.Images,
 		DescImages:      goods.DescImages,
@@ -203,6 +427,13 @@ func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		ClickNum:        goods.ClickNum,
 		SoldNum:         goods.SoldNum,
 		FavNum:          goods.FavNum,
+		Attrs:           newGoodsAttrValues(goods.Attrs),
+		Status:          goodsStatus(goods),
+		OnSaleTime:      unixOrZero(goods.OnSaleAt),
+		OffSaleTime:     unixOrZero(goods.OffSaleAt),
+		Stocks:          goods.Stocks,
+		RatingAvg:       goods.RatingAvg,
+		RatingCount:     goods.RatingCount,
 	}
 
 	if goods.Category != nil {
@@ -223,9 +454,20 @@ func (s *GoodsUsecase) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 	return
 }
 func (s *GoodsUsecase) DeleteGoods(ctx context.Context, req *pb.DeleteGoodsInfo) (resp *pb.Empty, err error) {
-	if result := s.db.Delete(&Goods{}, req.Id); result.RowsAffected == 0 {
-		return nil, errx.ErrorGoodsNotFound("goods not found")
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Delete(&Goods{}, req.Id); result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return errx.ErrorGoodsNotFound("goods not found")
+		}
+		if err := tx.Where("goods_id = ?", req.Id).Delete(&GoodsSku{}).Error; err != nil {
+			return err
+		}
+		return s.indexer.Enqueue(tx, req.Id)
+	}); err != nil {
+		return nil, err
 	}
+	s.indexer.Notify()
 	return &pb.Empty{}, nil
 }
 func (s *GoodsUsecase) UpdateGoods(ctx context.Context, req *pb.CreateGoodsInfo) (resp *pb.Empty, err error) {
@@ -234,8 +476,10 @@ func (s *GoodsUsecase) UpdateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 	if result := s.db.First(&goods, req.Id); result.RowsAffected == 0 {
 		return nil, errx.ErrorGoodsNotFound("goods not found")
 	}
+	oldShopPrice, oldMarketPrice := goods.ShopPrice, goods.MarketPrice
 
 	// 如果更新分类，检查分类是否存在
+	categoryChanged := req.CategoryId > 0 && req.CategoryId != goods.CategoryID
 	if req.CategoryId > 0 {
 		var category Category
 		if result := s.db.First(&category, req.CategoryId); result.RowsAffected == 0 {
@@ -253,12 +497,24 @@ func (s *GoodsUsecase) UpdateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		goods.BrandID = req.BrandId
 	}
 
+	// 有 SKU 的商品售价和库存由 SKU 汇总，只能通过修改 SKU 变更
+	hasSkus, err := goodsHasSkus(s.db, goods.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasSkus && (req.ShopPrice > 0 && req.ShopPrice != goods.ShopPrice || req.Stocks > 0 && req.Stocks != goods.Stocks) {
+		return nil, errx.ErrorInvalidParams("shop price and stocks of goods with skus are synced from skus, update the skus instead")
+	}
+
 	// 更新字段
 	if req.Name != "" {
 		goods.Name = req.Name
 	}
-	if req.GoodsSn != "" {
-		goods.GoodsSn = req.GoodsSn
+	if goodsSn := strings.TrimSpace(req.GoodsSn); goodsSn != "" && goodsSn != goods.GoodsSn {
+		if err := checkGoodsSn(s.db, goodsSn, goods.ID); err != nil {
+			return nil, err
+		}
+		goods.GoodsSn = goodsSn
 	}
 	if req.Stocks > 0 {
 		goods.Stocks = req.Stocks
@@ -282,22 +538,73 @@ func (s *GoodsUsecase) UpdateGoods(ctx context.Context, req *pb.CreateGoodsInfo)
 		goods.DescImages = req.DescImages
 	}
 
+	// 改价需记录操作人，变动幅度超过限制时需确认
+	price := newGoodsPriceChange(oldShopPrice, oldMarketPrice, &goods)
+	var operator, reason string
+	if price.changed() {
+		operator, reason, err = s.guardPriceChange(price, req.Operator, req.PriceChangeReason, req.ForcePriceChange)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 传入描述且内容或格式变化时生成新版本
+	var descFormat, desc, descOp string
+	descChanged := false
+	if req.GoodsDesc != "" {
+		if descFormat, desc, err = sanitizeGoodsDesc(req.DescFormat, req.GoodsDesc); err != nil {
+			return nil, err
+		}
+		if descOp, err = descOperator(req.Operator); err != nil {
+			return nil, err
+		}
+		descChanged = desc != goods.GoodsDesc || descFormat != goods.DescFormat
+	}
+
+	// 传入属性值或更换分类时按分类属性模板重新校验，未传入属性值时沿用已保存的值
+	if len(req.Attrs) > 0 || categoryChanged {
+		values := req.Attrs
+		if len(values) == 0 {
+			values = newGoodsAttrValues(goods.Attrs)
+		}
+		attrs, err := resolveGoodsAttrs(s.db, goods.CategoryID, values)
+		if err != nil {
+			return nil, err
+		}
+		goods.Attrs = attrs
+	}
+
 	// 布尔值字段直接赋值
 	goods.ShipFree = req.ShipFree
 	goods.IsNew = req.IsNew
 	goods.IsHot = req.IsHot
-	goods.OnSale = req.OnSale
+	goods.UpdateTime = time.Now()
 
 	// 保存更新
-	if result := s.db.Save(&goods); result.Error != nil {
-		return nil, result.Error
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 上下架状态只能通过发布流程修改，描述按版本单独写入
+		if err := tx.Omit(goodsManagedColumns...).Save(&goods).Error; err != nil {
+			return goodsSnError(err, goods.GoodsSn)
+		}
+		if err := recordPriceChange(tx, goods.ID, price, operator, reason); err != nil {
+			return err
+		}
+		if descChanged {
+			if err := saveGoodsDesc(tx, &goods, descFormat, desc, descOp, ""); err != nil {
+				return err
+			}
+		}
+		return s.indexer.Enqueue(tx, goods.ID)
+	}); err != nil {
+		return nil, err
 	}
+	s.indexer.Notify()
 
 	return &pb.Empty{}, nil
 }
 func (s *GoodsUsecase) GetGoodsDetail(ctx context.Context, req *pb.GoodInfoRequest) (resp *pb.GoodsInfoResponse, err error) {
 	var goods Goods
-	if result := s.db.Preload("Category").Preload("Brand").First(&goods, req.Id); result.RowsAffected == 0 {
+	if result := s.db.Preload("Category").Preload("Brand").Preload("Skus", skuOrder).First(&goods, req.Id); result.RowsAffected == 0 {
 		return nil, errx.ErrorGoodsNotFound("goods not found")
 	}
 
@@ -309,7 +616,9 @@ func (s *GoodsUsecase) GetGoodsDetail(ctx context.Context, req *pb.GoodInfoReque
 		ShopPrice:       goods.ShopPrice,
 		MarketPrice:     goods.MarketPrice,
 		GoodsBrief:      goods.GoodsBrief,
-		GoodsDesc:       goods.GoodsSn,
+		GoodsDesc:       goods.GoodsDesc,
+		DescFormat:      goods.DescFormat,
+		DescVersion:     goods.DescVersion,
 		ShipFree:        goods.ShipFree,
 		Images:          goods.Images,
 		DescImages:      goods.DescImages,
@@ -321,6 +630,14 @@ func (s *GoodsUsecase) GetGoodsDetail(ctx context.Context, req *pb.GoodInfoReque
 		ClickNum:        goods.ClickNum,
 		SoldNum:         goods.SoldNum,
 		FavNum:          goods.FavNum,
+		Skus:            newGoodsSkuInfos(&goods),
+		Attrs:           newGoodsAttrValues(goods.Attrs),
+		Status:          goodsStatus(&goods),
+		OnSaleTime:      unixOrZero(goods.OnSaleAt),
+		OffSaleTime:     unixOrZero(goods.OffSaleAt),
+		Stocks:          goods.Stocks,
+		RatingAvg:       goods.RatingAvg,
+		RatingCount:     goods.RatingCount,
 	}
 
 	if goods.Category != nil {
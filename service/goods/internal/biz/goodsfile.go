@@ -0,0 +1,256 @@
+package biz
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 商品文件格式
+const (
+	GoodsFileCSV    = "csv"
+	GoodsFileXLSX   = "xlsx"
+	GoodsFileNDJSON = "ndjson"
+)
+
+// 商品文件列，导入和导出共用
+const (
+	goodsColID              = "id"
+	goodsColName            = "name"
+	goodsColGoodsSn         = "goodsSn"
+	goodsColCategory        = "category"
+	goodsColBrand           = "brand"
+	goodsColStocks          = "stocks"
+	goodsColMarketPrice     = "marketPrice"
+	goodsColShopPrice       = "shopPrice"
+	goodsColGoodsBrief      = "goodsBrief"
+	goodsColShipFree        = "shipFree"
+	goodsColImages          = "images"
+	goodsColDescImages      = "descImages"
+	goodsColGoodsFrontImage = "goodsFrontImage"
+	goodsColIsNew           = "isNew"
+	goodsColIsHot           = "isHot"
+	goodsColStatus          = "status"
+)
+
+// goodsFileColumns 商品文件列及其中文表头，按导出顺序排列
+// 商品ID和状态只在导出时填写，导入时忽略，导出的文件可直接修改后按商品编号导入更新
+var goodsFileColumns = []struct {
+	key   string
+	title string
+}{
+	{goodsColID, "商品ID"},
+	{goodsColGoodsSn, "商品编号"},
+	{goodsColName, "商品名称"},
+	{goodsColCategory, "分类"},
+	{goodsColBrand, "品牌"},
+	{goodsColStocks, "库存"},
+	{goodsColMarketPrice, "市场价"},
+	{goodsColShopPrice, "售价"},
+	{goodsColGoodsBrief, "商品简介"},
+	{goodsColShipFree, "包邮"},
+	{goodsColImages, "商品图片"},
+	{goodsColDescImages, "详情图片"},
+	{goodsColGoodsFrontImage, "商品主图"},
+	{goodsColIsNew, "新品"},
+	{goodsColIsHot, "热销"},
+	{goodsColStatus, "状态"},
+}
+
+// goodsAttrColumnPrefixes 分类属性列的表头前缀，例如 attr:产地、属性:产地
+var goodsAttrColumnPrefixes = []string{"attr:", "属性:"}
+
+// goodsImageSeparator 图片列中多个地址的分隔符
+const goodsImageSeparator = "|"
+
+// goodsFileFormat 返回文件格式，format 为空时按文件名扩展名判断
+func goodsFileFormat(format, fileName string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(fileName), ".")
+	}
+	switch f := strings.ToLower(format); f {
+	case GoodsFileCSV, GoodsFileXLSX:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported file format %q, expected csv or xlsx", format)
+	}
+}
+
+// goodsRowReader 按行读取表格，读完返回 io.EOF
+type goodsRowReader interface {
+	Read() ([]string, error)
+}
+
+// newGoodsRowReader 按格式创建行读取器，返回的 close 用于释放资源
+func newGoodsRowReader(format string, r io.Reader) (goodsRowReader, func(), error) {
+	switch format {
+	case GoodsFileCSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+		return cr, func() {}, nil
+	case GoodsFileXLSX:
+		// xlsx 为 zip 格式，需读取完整文件后解析
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			f.Close()
+			return nil, nil, errors.New("xlsx file has no sheet")
+		}
+		rows, err := f.Rows(sheets[0])
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return &xlsxRowReader{rows: rows}, func() {
+			rows.Close()
+			f.Close()
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported file format %q", format)
+	}
+}
+
+// xlsxRowReader 读取 xlsx 第一个工作表
+type xlsxRowReader struct {
+	rows *excelize.Rows
+}
+
+// Read 实现 goodsRowReader
+func (x *xlsxRowReader) Read() ([]string, error) {
+	if !x.rows.Next() {
+		if err := x.rows.Error(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return x.rows.Columns()
+}
+
+// goodsFileHeader 解析后的表头
+type goodsFileHeader struct {
+	// columns 列下标到列名的映射
+	columns map[int]string
+	// attrs 列下标到分类属性名称的映射
+	attrs map[int]string
+}
+
+// parseGoodsFileHeader 解析表头，列名支持英文字段名和中文表头，忽略大小写和首尾空白
+func parseGoodsFileHeader(record []string) (*goodsFileHeader, error) {
+	aliases := make(map[string]string, len(goodsFileColumns)*2)
+	for _, col := range goodsFileColumns {
+		aliases[strings.ToLower(col.key)] = col.key
+		aliases[strings.ToLower(col.title)] = col.key
+	}
+
+	header := &goodsFileHeader{
+		columns: make(map[int]string),
+		attrs:   make(map[int]string),
+	}
+	seen := make(map[string]bool)
+	for i, title := range record {
+		// Excel 另存的 CSV 带有 UTF-8 BOM
+		if i == 0 {
+			title = strings.TrimPrefix(title, "\ufeff")
+		}
+		title = strings.TrimSpace(title)
+		if title == "" {
+			continue
+		}
+		if name, ok := cutAttrColumn(title); ok {
+			if seen["attr:"+name] {
+				return nil, fmt.Errorf("duplicate attribute column %q", title)
+			}
+			seen["attr:"+name] = true
+			header.attrs[i] = name
+			continue
+		}
+		key, ok := aliases[strings.ToLower(title)]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", title)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate column %q", title)
+		}
+		seen[key] = true
+		header.columns[i] = key
+	}
+
+	for _, key := range []string{goodsColName, goodsColGoodsSn, goodsColCategory, goodsColBrand, goodsColShopPrice} {
+		if !seen[key] {
+			return nil, fmt.Errorf("missing required column %q", key)
+		}
+	}
+	return header, nil
+}
+
+// cutAttrColumn 判断是否为分类属性列并返回属性名称
+func cutAttrColumn(title string) (string, bool) {
+	for _, prefix := range goodsAttrColumnPrefixes {
+		if name, ok := strings.CutPrefix(title, prefix); ok {
+			name = strings.TrimSpace(name)
+			return name, name != ""
+		}
+	}
+	return "", false
+}
+
+// isBlankRecord 判断是否为空行
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// splitImages 拆分图片列
+func splitImages(v string) GormList {
+	images := make(GormList, 0)
+	for _, image := range strings.Split(v, goodsImageSeparator) {
+		if image = strings.TrimSpace(image); image != "" {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// parseFileBool 解析布尔列，支持 true/false、1/0、是/否、y/n，空值为 false
+func parseFileBool(v string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "", "false", "0", "否", "n", "no":
+		return false, nil
+	case "true", "1", "是", "y", "yes":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid boolean %q", v)
+	}
+}
+
+// limitedReader 读取超过 n 字节时返回错误，而不是像 io.LimitReader 一样截断
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+// errFileTooLarge 文件超过大小限制
+var errFileTooLarge = errors.New("file too large")
+
+// Read 实现 io.Reader
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, errFileTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,417 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxImportRows 单个文件的数据行数上限
+	maxImportRows = 10000
+	// maxImportFileSize 导入文件大小上限
+	maxImportFileSize = 32 << 20
+)
+
+// 导入行的处理方式
+const (
+	importActionCreate = "create"
+	importActionUpdate = "update"
+)
+
+// ImportGoods 从 CSV/XLSX 导入商品
+// 先完整解析文件，文件格式错误时不写入任何数据；随后逐行校验并各自在独立事务中写入，
+// 单行失败不影响其他行，结果中返回每行的处理方式或错误。新商品为草稿状态。
+func (s *GoodsUsecase) ImportGoods(ctx context.Context, opts *pb.ImportGoodsRequest, r io.Reader) (resp *pb.ImportGoodsResponse, err error) {
+	format, err := goodsFileFormat(opts.Format, opts.FileName)
+	if err != nil {
+		return nil, errx.ErrorGoodsImportFileInvalid("%v", err)
+	}
+	operator := importPriceOperator
+	if strings.TrimSpace(opts.Operator) != "" {
+		if operator, _, err = checkOperator(opts.Operator, ""); err != nil {
+			return nil, err
+		}
+	}
+	header, records, err := readGoodsFile(format, &limitedReader{r: r, n: maxImportFileSize})
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &goodsImporter{
+		s:          s,
+		opts:       opts,
+		header:     header,
+		operator:   operator,
+		categories: make(map[string]int32),
+		brands:     make(map[string]int32),
+		seenSn:     make(map[string]int32),
+	}
+	resp = &pb.ImportGoodsResponse{
+		DryRun: opts.DryRun,
+		Rows:   make([]*pb.ImportGoodsRowResult, 0, len(records)),
+	}
+	for _, rec := range records {
+		result := imp.importRow(ctx, rec.row, rec.values)
+		switch result.Action {
+		case importActionCreate:
+			resp.Created++
+		case importActionUpdate:
+			resp.Updated++
+		default:
+			resp.Failed++
+		}
+		resp.Rows = append(resp.Rows, result)
+	}
+	resp.Total = int32(len(records))
+
+	if !opts.DryRun && resp.Created+resp.Updated > 0 {
+		s.indexer.Notify()
+	}
+	s.log.Infof("goods import finished: dryRun=%v total=%d created=%d updated=%d failed=%d",
+		opts.DryRun, resp.Total, resp.Created, resp.Updated, resp.Failed)
+	return resp, nil
+}
+
+// goodsFileRecord 文件中的一行数据
+type goodsFileRecord struct {
+	row    int32
+	values []string
+}
+
+// readGoodsFile 读取表头和全部非空数据行
+func readGoodsFile(format string, r io.Reader) (*goodsFileHeader, []*goodsFileRecord, error) {
+	reader, closeReader, err := newGoodsRowReader(format, r)
+	if err != nil {
+		return nil, nil, importFileError(err)
+	}
+	defer closeReader()
+
+	first, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errx.ErrorGoodsImportFileInvalid("file is empty")
+	}
+	if err != nil {
+		return nil, nil, importFileError(err)
+	}
+	header, err := parseGoodsFileHeader(first)
+	if err != nil {
+		return nil, nil, errx.ErrorGoodsImportFileInvalid("invalid header: %v", err)
+	}
+
+	records := make([]*goodsFileRecord, 0)
+	for row := int32(2); ; row++ {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, importFileError(err)
+		}
+		if isBlankRecord(values) {
+			continue
+		}
+		if len(records) >= maxImportRows {
+			return nil, nil, errx.ErrorGoodsImportFileInvalid("too many rows, max %d", maxImportRows)
+		}
+		records = append(records, &goodsFileRecord{row: row, values: values})
+	}
+	return header, records, nil
+}
+
+// importFileError 转换读取文件时的错误
+func importFileError(err error) error {
+	if errors.Is(err, errFileTooLarge) {
+		return errx.ErrorGoodsImportFileInvalid("file too large, max %d bytes", maxImportFileSize)
+	}
+	return errx.ErrorGoodsImportFileInvalid("read file: %v", err)
+}
+
+// goodsImporter 单次导入的状态，缓存分类、品牌名称解析结果
+type goodsImporter struct {
+	s      *GoodsUsecase
+	opts   *pb.ImportGoodsRequest
+	header *goodsFileHeader
+	// operator 记录到价格变动记录的操作人
+	operator string
+
+	categories map[string]int32
+	brands     map[string]int32
+	// seenSn 文件中已出现的商品编号及其行号
+	seenSn map[string]int32
+}
+
+// importRow 校验并导入一行，返回该行结果
+func (imp *goodsImporter) importRow(ctx context.Context, row int32, values []string) *pb.ImportGoodsRowResult {
+	result := &pb.ImportGoodsRowResult{Row: row}
+
+	fields := make(map[string]string, len(imp.header.columns))
+	for i, key := range imp.header.columns {
+		if i < len(values) {
+			fields[key] = strings.TrimSpace(values[i])
+		}
+	}
+	result.GoodsSn = fields[goodsColGoodsSn]
+
+	goods, action, price, err := imp.buildGoods(ctx, row, fields, values)
+	if err == nil && !imp.opts.DryRun {
+		err = imp.save(ctx, goods, action, price)
+	}
+	if err != nil {
+		e := kerrors.FromError(err)
+		result.Reason = e.Reason
+		result.Error = e.Message
+		return result
+	}
+
+	result.GoodsId = goods.ID
+	result.Action = action
+	return result
+}
+
+// buildGoods 按行数据构建待写入的商品，更新时同时返回价格变动
+// 新建时未提供的列取默认值；按商品编号更新时只覆盖文件中存在的列
+func (imp *goodsImporter) buildGoods(ctx context.Context, row int32, fields map[string]string, values []string) (*Goods, string, goodsPriceChange, error) {
+	var price goodsPriceChange
+	db := imp.s.db.WithContext(ctx)
+
+	goodsSn := fields[goodsColGoodsSn]
+	if goodsSn == "" {
+		return nil, "", price, errx.ErrorInvalidParams("goods sn is required")
+	}
+	if first, ok := imp.seenSn[goodsSn]; ok {
+		return nil, "", price, errx.ErrorInvalidParams("duplicate goods sn %s, first seen at row %d", goodsSn, first)
+	}
+	imp.seenSn[goodsSn] = row
+
+	// 按商品编号查找已有商品
+	var existing Goods
+	err := db.Where("goods_sn = ?", goodsSn).First(&existing).Error
+	found := err == nil
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "", price, err
+	}
+	if found && !imp.opts.Upsert {
+		return nil, "", price, errx.ErrorGoodsSnExists("goods sn %s already exists", goodsSn)
+	}
+
+	now := time.Now()
+	goods := &existing
+	action := importActionUpdate
+	if !found {
+		goods = &Goods{
+			GoodsSn:    goodsSn,
+			Status:     int32(pb.GoodsStatus_GOODS_STATUS_DRAFT),
+			Images:     GormList{},
+			DescImages: GormList{},
+			Attrs:      GoodsAttrs{},
+			AddTime:    now,
+		}
+		action = importActionCreate
+	}
+	goods.UpdateTime = now
+	previousCategory := goods.CategoryID
+	oldShopPrice, oldMarketPrice, oldStocks := goods.ShopPrice, goods.MarketPrice, goods.Stocks
+
+	if err := imp.applyFields(ctx, goods, fields); err != nil {
+		return nil, "", price, err
+	}
+	// 有 SKU 的商品售价和库存由 SKU 汇总，忽略文件中的值，导出的文件可以原样导入
+	if found {
+		hasSkus, err := goodsHasSkus(db, goods.ID)
+		if err != nil {
+			return nil, "", price, err
+		}
+		if hasSkus {
+			goods.ShopPrice, goods.Stocks = oldShopPrice, oldStocks
+		}
+	}
+	if goods.Name == "" {
+		return nil, "", price, errx.ErrorGoodsNameEmpty("goods name is required")
+	}
+	// 新建时缺少分类或品牌列会写入 0，在校验阶段拒绝，预检即可发现
+	if goods.CategoryID == 0 {
+		return nil, "", price, errx.ErrorInvalidParams("category is required")
+	}
+	if goods.BrandID == 0 {
+		return nil, "", price, errx.ErrorInvalidParams("brand is required")
+	}
+	if goods.ShopPrice <= 0 {
+		return nil, "", price, errx.ErrorGoodsPriceInvalid("shop price must be greater than 0")
+	}
+	if found {
+		price = newGoodsPriceChange(oldShopPrice, oldMarketPrice, goods)
+		if !imp.opts.ForcePriceChange {
+			if err := imp.s.checkPriceChange(price); err != nil {
+				return nil, "", price, err
+			}
+		}
+	}
+
+	// 分类属性：更新时以已保存的属性为基础，文件中的属性列覆盖同名属性
+	attrs := make(map[string]string)
+	names := make([]string, 0)
+	for _, attr := range goods.Attrs {
+		attrs[attr.Name] = attr.Value
+		names = append(names, attr.Name)
+	}
+	for i, name := range imp.header.attrs {
+		if i >= len(values) {
+			continue
+		}
+		if _, ok := attrs[name]; !ok {
+			names = append(names, name)
+		}
+		attrs[name] = strings.TrimSpace(values[i])
+	}
+	if len(imp.header.attrs) > 0 || goods.CategoryID != previousCategory || !found {
+		attrValues := make([]*pb.GoodsAttrValue, 0, len(names))
+		for _, name := range names {
+			attrValues = append(attrValues, &pb.GoodsAttrValue{Name: name, Value: attrs[name]})
+		}
+		resolved, err := resolveGoodsAttrs(db, goods.CategoryID, attrValues)
+		if err != nil {
+			return nil, "", price, err
+		}
+		goods.Attrs = resolved
+	}
+
+	return goods, action, price, nil
+}
+
+// applyFields 按列顺序将文件中存在的列写入商品
+// 商品ID和状态为导出的只读列，不写入；上下架状态只能通过发布流程修改
+func (imp *goodsImporter) applyFields(ctx context.Context, goods *Goods, fields map[string]string) error {
+	for _, col := range goodsFileColumns {
+		key := col.key
+		v, ok := fields[key]
+		if !ok {
+			continue
+		}
+		var err error
+		switch key {
+		case goodsColName:
+			goods.Name = v
+		case goodsColCategory:
+			goods.CategoryID, err = imp.categoryID(ctx, v)
+		case goodsColBrand:
+			goods.BrandID, err = imp.brandID(ctx, v)
+		case goodsColStocks:
+			goods.Stocks, err = parseFileInt(key, v)
+		case goodsColMarketPrice:
+			goods.MarketPrice, err = parseFilePrice(key, v)
+		case goodsColShopPrice:
+			goods.ShopPrice, err = parseFilePrice(key, v)
+		case goodsColGoodsBrief:
+			goods.GoodsBrief = v
+		case goodsColShipFree:
+			goods.ShipFree, err = parseFileColumnBool(key, v)
+		case goodsColImages:
+			goods.Images = splitImages(v)
+		case goodsColDescImages:
+			goods.DescImages = splitImages(v)
+		case goodsColGoodsFrontImage:
+			goods.GoodsFrontImage = v
+		case goodsColIsNew:
+			goods.IsNew, err = parseFileColumnBool(key, v)
+		case goodsColIsHot:
+			goods.IsHot, err = parseFileColumnBool(key, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// save 在独立事务中写入商品、记录价格变动并登记索引任务
+func (imp *goodsImporter) save(ctx context.Context, goods *Goods, action string, price goodsPriceChange) error {
+	return imp.s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if action == importActionCreate {
+			if err := tx.Create(goods).Error; err != nil {
+				return goodsSnError(err, goods.GoodsSn)
+			}
+		} else {
+			// 上下架状态只能通过发布流程修改，描述按版本单独写入
+			if err := tx.Omit(goodsManagedColumns...).Save(goods).Error; err != nil {
+				return goodsSnError(err, goods.GoodsSn)
+			}
+			if err := recordPriceChange(tx, goods.ID, price, imp.operator, "imported from file"); err != nil {
+				return err
+			}
+		}
+		return imp.s.indexer.Enqueue(tx, goods.ID)
+	})
+}
+
+// categoryID 按名称解析分类
+func (imp *goodsImporter) categoryID(ctx context.Context, name string) (int32, error) {
+	if id, ok := imp.categories[name]; ok {
+		return id, nil
+	}
+	var category Category
+	if result := imp.s.db.WithContext(ctx).Where("name = ?", name).Limit(1).Find(&category); result.Error != nil {
+		return 0, result.Error
+	} else if result.RowsAffected == 0 {
+		return 0, errx.ErrorCategoryNotFound("category %q not found", name)
+	}
+	imp.categories[name] = category.ID
+	return category.ID, nil
+}
+
+// brandID 按名称解析品牌
+func (imp *goodsImporter) brandID(ctx context.Context, name string) (int32, error) {
+	if id, ok := imp.brands[name]; ok {
+		return id, nil
+	}
+	var brand Brands
+	if result := imp.s.db.WithContext(ctx).Where("name = ?", name).Limit(1).Find(&brand); result.Error != nil {
+		return 0, result.Error
+	} else if result.RowsAffected == 0 {
+		return 0, errx.ErrorBrandNotFound("brand %q not found", name)
+	}
+	imp.brands[name] = brand.ID
+	return brand.ID, nil
+}
+
+// parseFileInt 解析非负整数列，空值为 0
+func parseFileInt(column, v string) (int32, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || n < 0 {
+		return 0, errx.ErrorInvalidParams("column %s: invalid non-negative integer %q", column, v)
+	}
+	return int32(n), nil
+}
+
+// parseFilePrice 解析非负价格列，空值为 0
+func parseFilePrice(column, v string) (float32, error) {
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 32)
+	if err != nil || f < 0 {
+		return 0, errx.ErrorGoodsPriceInvalid("column %s: invalid price %q", column, v)
+	}
+	return float32(f), nil
+}
+
+// parseFileColumnBool 解析布尔列
+func parseFileColumnBool(column, v string) (bool, error) {
+	b, err := parseFileBool(v)
+	if err != nil {
+		return false, errx.ErrorInvalidParams("column %s: %v", column, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mshop/service/goods/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// indexPollInterval 兜底轮询间隔，防止漏掉通知
+	indexPollInterval = 5 * time.Second
+	// indexBatchSize 每批处理的任务数量
+	indexBatchSize = 100
+	// indexMaxBackoff 失败重试的最大间隔
+	indexMaxBackoff = 5 * time.Minute
+)
+
+// GoodsIndexer 商品 ES 索引同步器
+// 商品写入时在同一事务中登记 GoodsIndexTask，后台协程读取任务并同步到 ES，
+// ES 不可用时任务保留在 MySQL 中按退避策略重试，恢复后自动补齐
+type GoodsIndexer struct {
+	db       *gorm.DB
+	log      *log.Helper
+	searcher data.GoodsSearcher
+
+	notify chan struct{}
+	stop   chan struct{}
+}
+
+// NewGoodsIndexer 创建商品索引同步器
+func NewGoodsIndexer(db *gorm.DB, logger log.Logger, searcher data.GoodsSearcher) *GoodsIndexer {
+	return &GoodsIndexer{
+		db:       db,
+		log:      log.NewHelper(log.With(logger, "module", "biz/indexer")),
+		searcher: searcher,
+		notify:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Enqueue 在事务 tx 中登记商品索引任务，需与商品写入在同一事务中调用
+func (ix *GoodsIndexer) Enqueue(tx *gorm.DB, goodsIDs ...int32) error {
+	if len(goodsIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	tasks := make([]*GoodsIndexTask, 0, len(goodsIDs))
+	for _, id := range goodsIDs {
+		tasks = append(tasks, &GoodsIndexTask{
+			GoodsID:    id,
+			NextRunAt:  now,
+			AddTime:    now,
+			UpdateTime: now,
+		})
+	}
+	return tx.CreateInBatches(&tasks, indexBatchSize).Error
+}
+
+// Notify 唤醒后台同步协程，事务提交后调用
+func (ix *GoodsIndexer) Notify() {
+	select {
+	case ix.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Start 实现 transport.Server，随应用启动后台同步协程
+func (ix *GoodsIndexer) Start(ctx context.Context) error {
+	ix.log.Info("goods indexer started")
+
+	ticker := time.NewTicker(indexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ix.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ix.stop:
+			return nil
+		case <-ticker.C:
+		case <-ix.notify:
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止后台同步协程
+func (ix *GoodsIndexer) Stop(ctx context.Context) error {
+	close(ix.stop)
+	ix.log.Info("goods indexer stopped")
+	return nil
+}
+
+// drain 循环处理到期任务，直到没有可处理的任务为止
+func (ix *GoodsIndexer) drain(ctx context.Context) {
+	for {
+		n, err := ix.processBatch(ctx)
+		if err != nil {
+			ix.log.Errorf("failed to process index tasks: %v", err)
+			return
+		}
+		if n < indexBatchSize {
+			return
+		}
+	}
+}
+
+// processBatch 处理一批到期任务，返回取到的任务数
+func (ix *GoodsIndexer) processBatch(ctx context.Context) (int, error) {
+	var tasks []*GoodsIndexTask
+	if result := ix.db.WithContext(ctx).
+		Where("next_run_at <= ?", time.Now()).
+		Order("id").
+		Limit(indexBatchSize).
+		Find(&tasks); result.Error != nil {
+		return 0, result.Error
+	}
+
+	// 同一商品的多个任务只需同步一次最新状态
+	taskIDs := make(map[int32][]int64)
+	attempts := make(map[int32]int32)
+	order := make([]int32, 0, len(tasks))
+	for _, task := range tasks {
+		if _, ok := taskIDs[task.GoodsID]; !ok {
+			order = append(order, task.GoodsID)
+		}
+		taskIDs[task.GoodsID] = append(taskIDs[task.GoodsID], task.ID)
+		if task.Attempts > attempts[task.GoodsID] {
+			attempts[task.GoodsID] = task.Attempts
+		}
+	}
+
+	for _, goodsID := range order {
+		if err := ix.sync(ctx, goodsID); err != nil {
+			ix.log.Warnf("failed to sync goods %d to elasticsearch: %v", goodsID, err)
+			ix.retryLater(ctx, taskIDs[goodsID], attempts[goodsID]+1, err)
+			continue
+		}
+		if result := ix.db.WithContext(ctx).Delete(&GoodsIndexTask{}, taskIDs[goodsID]); result.Error != nil {
+			ix.log.Errorf("failed to delete index tasks of goods %d: %v", goodsID, result.Error)
+		}
+	}
+
+	return len(tasks), nil
+}
+
+// sync 按商品在 MySQL 中的当前状态同步 ES 文档：存在则覆盖写入，不存在则删除
+func (ix *GoodsIndexer) sync(ctx context.Context, goodsID int32) error {
+	var goods Goods
+	err := ix.db.WithContext(ctx).Preload(EsGoodsPreload).First(&goods, goodsID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ix.searcher.DeleteGoodsDoc(ctx, goodsID)
+	}
+	if err != nil {
+		return err
+	}
+	return ix.searcher.IndexGoods(ctx, goods.ID, NewEsGoods(&goods))
+}
+
+// retryLater 记录失败原因并按指数退避推迟任务
+func (ix *GoodsIndexer) retryLater(ctx context.Context, taskIDs []int64, attempts int32, cause error) {
+	backoff := indexMaxBackoff
+	if attempts < 9 {
+		backoff = min(time.Second<<attempts, indexMaxBackoff)
+	}
+
+	lastError := cause.Error()
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+
+	now := time.Now()
+	if result := ix.db.WithContext(ctx).Model(&GoodsIndexTask{}).
+		Where("id IN ?", taskIDs).
+		Updates(map[string]interface{}{
+			"attempts":    attempts,
+			"last_error":  lastError,
+			"next_run_at": now.Add(backoff),
+			"update_time": now,
+		}); result.Error != nil {
+		ix.log.Errorf("failed to reschedule index tasks: %v", result.Error)
+	}
+}
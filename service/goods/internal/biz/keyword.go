@@ -0,0 +1,309 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxKeywordLen 记录的搜索词最大长度（字符）
+	maxKeywordLen = 50
+	// defaultKeywordHours 默认统计窗口
+	defaultKeywordHours = 24
+	// maxZeroResultHours 无结果搜索词统计窗口上限
+	maxZeroResultHours = 30 * 24
+	// defaultKeywordSize 默认返回数量
+	defaultKeywordSize = 10
+	// maxKeywordSize 返回数量上限
+	maxKeywordSize = 50
+	// hotKeywordFetchSize 热搜词预取数量，过滤屏蔽词后再截取
+	hotKeywordFetchSize = 200
+	// maxBlocklistSize 屏蔽词数量上限
+	maxBlocklistSize = 1000
+	// recordSearchTimeout 记录搜索词的超时时间
+	recordSearchTimeout = 2 * time.Second
+	// recordSearchQueueSize 待记录搜索词队列长度，队列满时丢弃
+	recordSearchQueueSize = 1024
+
+	// zeroResultCleanupInterval 清理过期无结果搜索日志的间隔
+	zeroResultCleanupInterval = time.Hour
+	// zeroResultCleanupBatchSize 每批删除的日志行数
+	zeroResultCleanupBatchSize = 1000
+	// zeroResultCleanupLockKey 清理任务锁，多实例同时只有一个实例清理
+	zeroResultCleanupLockKey = "goods:keyword:cleanup:lock"
+	// zeroResultCleanupLockTTL 清理任务锁的有效期，需大于一次清理的耗时
+	zeroResultCleanupLockTTL = 10 * time.Minute
+)
+
+// normalizeKeyword 统一搜索词格式：去除首尾空白、合并连续空白、转小写并截断
+func normalizeKeyword(keyword string) string {
+	keyword = strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+	if runes := []rune(keyword); len(runes) > maxKeywordLen {
+		keyword = string(runes[:maxKeywordLen])
+	}
+	return keyword
+}
+
+// recordSearch 异步记录关键词搜索，只统计首页请求，翻页不重复计数
+func (s *GoodsUsecase) recordSearch(req *pb.GoodsFilterRequest, total int64) {
+	keyword := normalizeKeyword(req.KeyWords)
+	if keyword == "" || req.PageToken != "" || req.Pages > 1 {
+		return
+	}
+	s.recorder.Record(keyword, total == 0)
+}
+
+// searchRecord 待记录的搜索词
+type searchRecord struct {
+	keyword    string
+	zeroResult bool
+	addTime    time.Time
+}
+
+// SearchRecorder 搜索词记录任务
+// 由单个协程按队列顺序记录搜索词，队列满时丢弃，流量突增时不会堆积协程和数据库写入；
+// 定期删除超出统计窗口的无结果搜索日志，多实例运行时通过 Redis 锁保证同时只有一个实例清理
+type SearchRecorder struct {
+	db       *gorm.DB
+	log      *log.Helper
+	keywords *data.KeywordRepo
+	locker   *data.Locker
+
+	queue   chan searchRecord
+	dropped atomic.Int64
+	stop    chan struct{}
+}
+
+// NewSearchRecorder 创建搜索词记录任务
+func NewSearchRecorder(db *gorm.DB, logger log.Logger, keywords *data.KeywordRepo, locker *data.Locker) *SearchRecorder {
+	return &SearchRecorder{
+		db:       db,
+		log:      log.NewHelper(log.With(logger, "module", "biz/keyword")),
+		keywords: keywords,
+		locker:   locker,
+		queue:    make(chan searchRecord, recordSearchQueueSize),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Record 将搜索词加入记录队列，队列满时丢弃，不阻塞搜索请求
+func (r *SearchRecorder) Record(keyword string, zeroResult bool) {
+	select {
+	case r.queue <- searchRecord{keyword: keyword, zeroResult: zeroResult, addTime: time.Now()}:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Start 实现 transport.Server，随应用启动记录任务
+func (r *SearchRecorder) Start(ctx context.Context) error {
+	r.log.Info("search recorder started")
+
+	ticker := time.NewTicker(zeroResultCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case record := <-r.queue:
+			r.save(record)
+		case <-ticker.C:
+			if n := r.dropped.Swap(0); n > 0 {
+				r.log.Warnf("dropped %d search records because the queue was full", n)
+			}
+			r.cleanup(ctx)
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止记录任务，队列中未记录的搜索词丢弃
+func (r *SearchRecorder) Stop(ctx context.Context) error {
+	close(r.stop)
+	r.log.Info("search recorder stopped")
+	return nil
+}
+
+// save 记录搜索词计数，无结果时同时写入无结果搜索日志
+func (r *SearchRecorder) save(record searchRecord) {
+	ctx, cancel := context.WithTimeout(context.Background(), recordSearchTimeout)
+	defer cancel()
+
+	if err := r.keywords.IncrKeyword(ctx, record.keyword); err != nil {
+		r.log.Warnf("failed to record search keyword %q: %v", record.keyword, err)
+	}
+	if record.zeroResult {
+		entry := &SearchZeroResultLog{Keyword: record.keyword, AddTime: record.addTime}
+		if result := r.db.WithContext(ctx).Create(entry); result.Error != nil {
+			r.log.Warnf("failed to record zero result keyword %q: %v", record.keyword, result.Error)
+		}
+	}
+}
+
+// cleanup 分批删除超出无结果搜索词统计窗口的日志
+func (r *SearchRecorder) cleanup(ctx context.Context) {
+	unlock, err := r.locker.TryLock(ctx, zeroResultCleanupLockKey, zeroResultCleanupLockTTL)
+	if err != nil {
+		r.log.Errorf("failed to acquire zero result cleanup lock: %v", err)
+		return
+	}
+	if unlock == nil {
+		// 其他实例正在清理
+		return
+	}
+	defer unlock()
+
+	before := time.Now().Add(-maxZeroResultHours * time.Hour)
+	var deleted int64
+	for {
+		result := r.db.WithContext(ctx).Where("add_time < ?", before).Limit(zeroResultCleanupBatchSize).Delete(&SearchZeroResultLog{})
+		if result.Error != nil {
+			r.log.Errorf("failed to clean up zero result logs: %v", result.Error)
+			break
+		}
+		deleted += result.RowsAffected
+		if result.RowsAffected < zeroResultCleanupBatchSize {
+			break
+		}
+	}
+	if deleted > 0 {
+		r.log.Infof("cleaned up %d zero result logs", deleted)
+	}
+}
+
+// keywordWindow 返回统计窗口小时数和返回数量
+func keywordWindow(req *pb.SearchKeywordsRequest, maxHours int) (hours, size int) {
+	hours, size = int(req.Hours), int(req.Size)
+	switch {
+	case hours <= 0:
+		hours = defaultKeywordHours
+	case hours > maxHours:
+		hours = maxHours
+	}
+	switch {
+	case size <= 0:
+		size = defaultKeywordSize
+	case size > maxKeywordSize:
+		size = maxKeywordSize
+	}
+	return hours, size
+}
+
+// HotKeywords 获取最近一段时间的热搜关键词，包含屏蔽词的搜索词不返回
+func (s *GoodsUsecase) HotKeywords(ctx context.Context, req *pb.SearchKeywordsRequest) (resp *pb.SearchKeywordsResponse, err error) {
+	hours, size := keywordWindow(req, data.MaxHotKeywordHours)
+
+	blocklist, err := s.keywords.GetBlocklist(ctx)
+	if err != nil {
+		s.log.Errorf("failed to get hot keyword blocklist: %v", err)
+		return nil, errx.ErrorInternalError("get hot keyword blocklist: %v", err)
+	}
+	top, err := s.keywords.TopKeywords(ctx, hours, hotKeywordFetchSize)
+	if err != nil {
+		s.log.Errorf("failed to get hot keywords: %v", err)
+		return nil, errx.ErrorInternalError("get hot keywords: %v", err)
+	}
+
+	resp = &pb.SearchKeywordsResponse{
+		Keywords: make([]*pb.SearchKeyword, 0, size),
+	}
+	for _, k := range top {
+		if len(resp.Keywords) >= size {
+			break
+		}
+		if blocked(k.Keyword, blocklist) {
+			continue
+		}
+		resp.Keywords = append(resp.Keywords, &pb.SearchKeyword{
+			Keyword: k.Keyword,
+			Count:   k.Count,
+		})
+	}
+	return resp, nil
+}
+
+// blocked 判断搜索词是否包含屏蔽词
+func blocked(keyword string, blocklist []string) bool {
+	for _, word := range blocklist {
+		if strings.Contains(keyword, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// ZeroResultKeywords 获取最近一段时间内无结果的搜索词，按搜索次数降序
+func (s *GoodsUsecase) ZeroResultKeywords(ctx context.Context, req *pb.SearchKeywordsRequest) (resp *pb.SearchKeywordsResponse, err error) {
+	hours, size := keywordWindow(req, maxZeroResultHours)
+
+	var rows []struct {
+		Keyword string
+		Count   int64
+	}
+	if result := s.db.WithContext(ctx).Model(&SearchZeroResultLog{}).
+		Select("keyword, COUNT(*) AS count").
+		Where("add_time >= ?", time.Now().Add(-time.Duration(hours)*time.Hour)).
+		Group("keyword").
+		Order("count DESC").
+		Limit(size).
+		Scan(&rows); result.Error != nil {
+		s.log.Errorf("failed to query zero result keywords: %v", result.Error)
+		return nil, errx.ErrorDatabaseError("db error: %v", result.Error)
+	}
+
+	resp = &pb.SearchKeywordsResponse{
+		Keywords: make([]*pb.SearchKeyword, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.Keywords = append(resp.Keywords, &pb.SearchKeyword{
+			Keyword: row.Keyword,
+			Count:   row.Count,
+		})
+	}
+	return resp, nil
+}
+
+// GetHotKeywordBlocklist 获取热搜屏蔽词
+func (s *GoodsUsecase) GetHotKeywordBlocklist(ctx context.Context, req *pb.Empty) (resp *pb.HotKeywordBlocklist, err error) {
+	words, err := s.keywords.GetBlocklist(ctx)
+	if err != nil {
+		s.log.Errorf("failed to get hot keyword blocklist: %v", err)
+		return nil, errx.ErrorInternalError("get hot keyword blocklist: %v", err)
+	}
+	return &pb.HotKeywordBlocklist{Words: words}, nil
+}
+
+// UpdateHotKeywordBlocklist 整体替换热搜屏蔽词，屏蔽词按搜索词相同的规则格式化
+func (s *GoodsUsecase) UpdateHotKeywordBlocklist(ctx context.Context, req *pb.HotKeywordBlocklist) (resp *pb.HotKeywordBlocklist, err error) {
+	if len(req.Words) > maxBlocklistSize {
+		return nil, errx.ErrorInvalidParams("too many blocked words, max %d", maxBlocklistSize)
+	}
+
+	words := make([]string, 0, len(req.Words))
+	seen := make(map[string]bool, len(req.Words))
+	for _, word := range req.Words {
+		word = normalizeKeyword(word)
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+
+	if err := s.keywords.SetBlocklist(ctx, words); err != nil {
+		s.log.Errorf("failed to update hot keyword blocklist: %v", err)
+		return nil, errx.ErrorInternalError("update hot keyword blocklist: %v", err)
+	}
+	return &pb.HotKeywordBlocklist{Words: words}, nil
+}
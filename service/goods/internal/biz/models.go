@@ -1,6 +1,7 @@
 package biz
 
 import (
+	"strconv"
 	"time"
 
 	"gorm.io/gorm"
@@ -63,6 +64,7 @@ func (Category) TableName() string {
 }
 
 // Goods 商品模型
+// LiveGoodsSn 为数据库生成列，未删除商品取 goods_sn，已删除商品为 NULL，商品编号唯一索引只约束未删除的商品
 type Goods struct {
 	ID              int32          `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
 	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
@@ -72,7 +74,7 @@ type Goods struct {
 	CategoryID      int32          `gorm:"column:category_id;not null;index:goods2_category_id" json:"category_id"`
 	BrandID         int32          `gorm:"column:brand_id;not null;index:goods2_brand_id" json:"brand_id"`
 	OnSale          bool           `gorm:"column:on_sale;not null" json:"on_sale"`
-	GoodsSn         string         `gorm:"column:goods_sn;type:varchar(50);not null" json:"goods_sn"`
+	GoodsSn         string         `gorm:"column:goods_sn;type:varchar(50);not null;index:goods2_goods_sn" json:"goods_sn"`
 	Name            string         `gorm:"column:name;type:varchar(100);not null" json:"name"`
 	ClickNum        int32          `gorm:"column:click_num;not null;default:0" json:"click_num"`
 	SoldNum         int32          `gorm:"column:sold_num;not null;default:0" json:"sold_num"`
@@ -87,10 +89,21 @@ type Goods struct {
 	GoodsFrontImage string         `gorm:"column:goods_front_image;type:varchar(200);not null" json:"goods_front_image"`
 	IsNew           bool           `gorm:"column:is_new;not null" json:"is_new"`
 	IsHot           bool           `gorm:"column:is_hot;not null" json:"is_hot"`
+	Attrs           GoodsAttrs     `gorm:"column:attrs;type:json;serializer:json" json:"attrs"`
+	Status          int32          `gorm:"column:status;not null;default:0" json:"status"`
+	OnSaleAt        *time.Time     `gorm:"column:on_sale_at" json:"on_sale_at"`
+	OffSaleAt       *time.Time     `gorm:"column:off_sale_at" json:"off_sale_at"`
+	GoodsDesc       string         `gorm:"column:goods_desc;type:mediumtext" json:"goods_desc"`
+	DescFormat      string         `gorm:"column:desc_format;type:varchar(16);not null;default:''" json:"desc_format"`
+	DescVersion     int32          `gorm:"column:desc_version;not null;default:0" json:"desc_version"`
+	RatingAvg       float32        `gorm:"column:rating_avg;not null;default:0" json:"rating_avg"`
+	RatingCount     int32          `gorm:"column:rating_count;not null;default:0" json:"rating_count"`
+	LiveGoodsSn     *string        `gorm:"column:live_goods_sn;->;type:varchar(50) GENERATED ALWAYS AS (IF(deleted_at IS NULL, goods_sn, NULL)) STORED;uniqueIndex:goods2_live_goods_sn" json:"-"`
 
 	// 外键关联
-	Category *Category `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:RESTRICT,OnDelete:CASCADE" json:"category,omitempty"`
-	Brand    *Brands   `gorm:"foreignKey:BrandID;references:ID;constraint:OnUpdate:RESTRICT,OnDelete:CASCADE" json:"brand,omitempty"`
+	Category *Category   `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:RESTRICT,OnDelete:CASCADE" json:"category,omitempty"`
+	Brand    *Brands     `gorm:"foreignKey:BrandID;references:ID;constraint:OnUpdate:RESTRICT,OnDelete:CASCADE" json:"brand,omitempty"`
+	Skus     []*GoodsSku `gorm:"foreignKey:GoodsID;references:ID" json:"skus,omitempty"`
 }
 
 // TableName 指定表名
@@ -98,23 +111,227 @@ func (Goods) TableName() string {
 	return "goods"
 }
 
+// GoodsStatusLog 商品状态流转记录
+type GoodsStatusLog struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GoodsID    int32     `gorm:"column:goods_id;not null;index:goods_status_log_goods_id" json:"goods_id"`
+	FromStatus int32     `gorm:"column:from_status;not null" json:"from_status"`
+	ToStatus   int32     `gorm:"column:to_status;not null" json:"to_status"`
+	Operator   string    `gorm:"column:operator;type:varchar(64);not null" json:"operator"`
+	Reason     string    `gorm:"column:reason;type:varchar(255);not null" json:"reason"`
+	AddTime    time.Time `gorm:"column:add_time;not null" json:"add_time"`
+}
+
+// TableName 指定表名
+func (GoodsStatusLog) TableName() string {
+	return "goods_status_log"
+}
+
+// GoodsPriceLog 商品价格变动记录
+type GoodsPriceLog struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GoodsID        int32     `gorm:"column:goods_id;not null;index:goods_price_log_goods_id" json:"goods_id"`
+	OldShopPrice   float32   `gorm:"column:old_shop_price;not null" json:"old_shop_price"`
+	NewShopPrice   float32   `gorm:"column:new_shop_price;not null" json:"new_shop_price"`
+	OldMarketPrice float32   `gorm:"column:old_market_price;not null" json:"old_market_price"`
+	NewMarketPrice float32   `gorm:"column:new_market_price;not null" json:"new_market_price"`
+	Operator       string    `gorm:"column:operator;type:varchar(64);not null" json:"operator"`
+	Reason         string    `gorm:"column:reason;type:varchar(255);not null" json:"reason"`
+	AddTime        time.Time `gorm:"column:add_time;not null" json:"add_time"`
+}
+
+// TableName 指定表名
+func (GoodsPriceLog) TableName() string {
+	return "goods_price_log"
+}
+
+// GoodsCoPurchase 商品共同购买关系，由 GoodsRecommender 根据订单服务的统计定期重建
+type GoodsCoPurchase struct {
+	GoodsID        int32     `gorm:"column:goods_id;primaryKey;autoIncrement:false" json:"goods_id"`
+	RelatedGoodsID int32     `gorm:"column:related_goods_id;primaryKey;autoIncrement:false" json:"related_goods_id"`
+	Score          int32     `gorm:"column:score;not null" json:"score"` // 共同购买的订单数
+	UpdateTime     time.Time `gorm:"column:update_time;not null" json:"update_time"`
+}
+
+// TableName 指定表名
+func (GoodsCoPurchase) TableName() string {
+	return "goods_co_purchase"
+}
+
+// GoodsDescVersion 商品描述版本，每次修改描述生成一个新版本
+type GoodsDescVersion struct {
+	ID       int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GoodsID  int32     `gorm:"column:goods_id;not null;uniqueIndex:goods_desc_version_goods_id_version,priority:1" json:"goods_id"`
+	Version  int32     `gorm:"column:version;not null;uniqueIndex:goods_desc_version_goods_id_version,priority:2" json:"version"`
+	Format   string    `gorm:"column:format;type:varchar(16);not null" json:"format"`
+	Content  string    `gorm:"column:content;type:mediumtext;not null" json:"content"`
+	Size     int32     `gorm:"column:size;not null" json:"size"`
+	Operator string    `gorm:"column:operator;type:varchar(64);not null" json:"operator"`
+	Reason   string    `gorm:"column:reason;type:varchar(255);not null" json:"reason"`
+	AddTime  time.Time `gorm:"column:add_time;not null" json:"add_time"`
+}
+
+// TableName 指定表名
+func (GoodsDescVersion) TableName() string {
+	return "goods_desc_version"
+}
+
+// GoodsAttr 商品属性值，名称对应分类属性模板
+type GoodsAttr struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GoodsAttrs 商品属性值列表，按分类属性模板顺序排列
+type GoodsAttrs []GoodsAttr
+
+// CategoryAttribute 分类属性模板
+// 分类的属性模板对其所有下级分类生效，同一条分类链上属性名称唯一
+type CategoryAttribute struct {
+	ID         int32          `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	CategoryID int32          `gorm:"column:category_id;not null;index:category_attribute_category_id" json:"category_id"`
+	Name       string         `gorm:"column:name;type:varchar(50);not null" json:"name"`
+	Type       int32          `gorm:"column:type;not null" json:"type"`
+	Required   bool           `gorm:"column:required;not null" json:"required"`
+	Options    GormList       `gorm:"column:options;type:json;not null;serializer:json" json:"options"`
+	Unit       string         `gorm:"column:unit;type:varchar(20);not null" json:"unit"`
+	Sort       int32          `gorm:"column:sort;not null;default:0" json:"sort"`
+	AddTime    time.Time      `gorm:"column:add_time;not null" json:"add_time"`
+	IsDeleted  bool           `gorm:"column:is_deleted" json:"is_deleted"`
+	UpdateTime time.Time      `gorm:"column:update_time;not null" json:"update_time"`
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+}
+
+// TableName 指定表名
+func (CategoryAttribute) TableName() string {
+	return "category_attribute"
+}
+
+// GoodsSku 商品 SKU 模型
+// 商品存在 SKU 时，商品的 ShopPrice 为在售 SKU 的最低价，Stocks 为 SKU 库存之和
+// LiveSkuCode 为数据库生成列，未删除的 SKU 取 sku_code，SKU 编码唯一索引只约束未删除的 SKU
+type GoodsSku struct {
+	ID          int32          `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GoodsID     int32          `gorm:"column:goods_id;not null;index:goods_sku_goods_id" json:"goods_id"`
+	SkuCode     string         `gorm:"column:sku_code;type:varchar(64);not null;index:goods_sku_sku_code" json:"sku_code"`
+	LiveSkuCode *string        `gorm:"column:live_sku_code;->;type:varchar(64) GENERATED ALWAYS AS (IF(deleted_at IS NULL, sku_code, NULL)) STORED;uniqueIndex:goods_sku_live_sku_code" json:"-"`
+	Specs       SkuSpecs       `gorm:"column:specs;type:json;not null;serializer:json" json:"specs"`
+	ShopPrice   float32        `gorm:"column:shop_price;not null" json:"shop_price"`
+	MarketPrice float32        `gorm:"column:market_price;not null" json:"market_price"`
+	Images      GormList       `gorm:"column:images;type:json;not null;serializer:json" json:"images"`
+	Stocks      int32          `gorm:"column:stocks;not null;default:0" json:"stocks"`
+	OnSale      bool           `gorm:"column:on_sale;not null" json:"on_sale"`
+	AddTime     time.Time      `gorm:"column:add_time;not null" json:"add_time"`
+	IsDeleted   bool           `gorm:"column:is_deleted" json:"is_deleted"`
+	UpdateTime  time.Time      `gorm:"column:update_time;not null" json:"update_time"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+
+	Goods *Goods `gorm:"foreignKey:GoodsID;references:ID" json:"goods,omitempty"`
+}
+
+// TableName 指定表名
+func (GoodsSku) TableName() string {
+	return "goods_sku"
+}
+
+// SkuSpec SKU 规格属性
+type SkuSpec struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SkuSpecs SKU 规格属性列表，按录入顺序展示
+type SkuSpecs []SkuSpec
+
 // es中商品数据模型
 type EsGoods struct {
-	ID         int32 `json:"id"`
-	CategoryID int32 `json:"category_id"`
-	OnSale     bool  `json:"on_sale"`
-	ShipFree   bool  `json:"ship_free"`
-	IsNew      bool  `json:"is_new"`
-	IsHot      bool  `json:"is_hot"`
-
-	Name     string `json:"name"`
-	ClickNum int32  `json:"click_num"`
-	SoldNum  int32  `json:"sold_num"`
-	FavNum   int32  `json:"fav_num"`
+	ID           int32   `json:"id"`
+	CategoryID   int32   `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	CategoryPath []int32 `json:"category_path"`
+	IsTab        bool    `json:"is_tab"`
+	BrandID      int32   `json:"brand_id"`
+	OnSale       bool    `json:"on_sale"`
+	ShipFree     bool    `json:"ship_free"`
+	IsNew        bool    `json:"is_new"`
+	IsHot        bool    `json:"is_hot"`
+
+	Name        string     `json:"name"`
+	NameSuggest *EsSuggest `json:"name_suggest"`
+	ClickNum    int32      `json:"click_num"`
+	SoldNum     int32      `json:"sold_num"`
+	FavNum      int32      `json:"fav_num"`
 
 	MarketPrice float32 `json:"market_price"`
 	GoodsBrief  string  `json:"goods_brief"`
 	ShopPrice   float32 `json:"shop_price"`
+
+	AddTime time.Time `json:"add_time"`
+
+	Attrs GoodsAttrs `json:"attrs"`
+}
+
+// EsGoodsPreload 构建 ES 文档需要预加载的分类及其祖先分类（分类最多三级）
+const EsGoodsPreload = "Category.ParentCategory.ParentCategory"
+
+// NewEsGoods 由商品模型构建 ES 文档，需按 EsGoodsPreload 预加载分类
+func NewEsGoods(g *Goods) *EsGoods {
+	doc := &EsGoods{
+		ID:          g.ID,
+		CategoryID:  g.CategoryID,
+		BrandID:     g.BrandID,
+		OnSale:      g.OnSale,
+		ShipFree:    g.ShipFree,
+		IsNew:       g.IsNew,
+		IsHot:       g.IsHot,
+		Name:        g.Name,
+		ClickNum:    g.ClickNum,
+		SoldNum:     g.SoldNum,
+		FavNum:      g.FavNum,
+		MarketPrice: g.MarketPrice,
+		GoodsBrief:  g.GoodsBrief,
+		ShopPrice:   g.ShopPrice,
+		AddTime:     g.AddTime,
+		Attrs:       g.Attrs,
+	}
+	if g.Category != nil {
+		doc.CategoryName = g.Category.Name
+	}
+	// 记录分类及其所有祖先分类，按上级分类过滤时可匹配整棵子树
+	for c := g.Category; c != nil; c = c.ParentCategory {
+		doc.CategoryPath = append(doc.CategoryPath, c.ID)
+		if c.IsTab {
+			doc.IsTab = true
+		}
+	}
+	doc.NameSuggest = &EsSuggest{
+		Input:    []string{g.Name},
+		Contexts: map[string][]string{"on_sale": {strconv.FormatBool(g.OnSale)}},
+	}
+	return doc
+}
+
+// EsSuggest ES 补全字段
+type EsSuggest struct {
+	Input    []string            `json:"input"`
+	Contexts map[string][]string `json:"contexts"`
+}
+
+// GoodsIndexTask 商品 ES 索引同步任务
+// 与商品写入在同一事务中落库，同步成功后删除，未删除的记录即为待同步积压
+type GoodsIndexTask struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	GoodsID    int32     `gorm:"column:goods_id;not null;index:goods_index_task_goods_id" json:"goods_id"`
+	Attempts   int32     `gorm:"column:attempts;not null;default:0" json:"attempts"`
+	LastError  string    `gorm:"column:last_error;type:varchar(500)" json:"last_error"`
+	NextRunAt  time.Time `gorm:"column:next_run_at;not null;index:goods_index_task_next_run_at" json:"next_run_at"`
+	AddTime    time.Time `gorm:"column:add_time;not null" json:"add_time"`
+	UpdateTime time.Time `gorm:"column:update_time;not null" json:"update_time"`
+}
+
+// TableName 指定表名
+func (GoodsIndexTask) TableName() string {
+	return "goods_index_task"
 }
 
 // GoodsCategoryBrand 商品分类品牌关联模型
@@ -139,3 +356,15 @@ func (GoodsCategoryBrand) TableName() string {
 
 // GormList 自定义类型，用于处理 JSON 数组字段
 type GormList []string
+
+// SearchZeroResultLog 无结果搜索日志
+type SearchZeroResultLog struct {
+	ID      int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Keyword string    `gorm:"column:keyword;type:varchar(100);not null;index:search_zero_result_log_keyword" json:"keyword"`
+	AddTime time.Time `gorm:"column:add_time;not null;index:search_zero_result_log_add_time" json:"add_time"`
+}
+
+// TableName 指定表名
+func (SearchZeroResultLog) TableName() string {
+	return "search_zero_result_log"
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"mshop/pkg/errx"
+	"mshop/pkg/utils"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultMaxPriceChangePercent 未配置时单次改价允许的最大变动百分比
+	defaultMaxPriceChangePercent = 50
+	// importPriceOperator 导入未指定操作人时记录的操作人
+	importPriceOperator = "import"
+)
+
+// goodsPriceChange 商品售价和市场价的一次变动
+type goodsPriceChange struct {
+	oldShopPrice   float32
+	newShopPrice   float32
+	oldMarketPrice float32
+	newMarketPrice float32
+}
+
+// newGoodsPriceChange 以修改前的价格和修改后的商品构建价格变动
+func newGoodsPriceChange(oldShopPrice, oldMarketPrice float32, goods *Goods) goodsPriceChange {
+	return goodsPriceChange{
+		oldShopPrice:   oldShopPrice,
+		newShopPrice:   goods.ShopPrice,
+		oldMarketPrice: oldMarketPrice,
+		newMarketPrice: goods.MarketPrice,
+	}
+}
+
+// changed 价格是否有变化
+func (c goodsPriceChange) changed() bool {
+	return c.oldShopPrice != c.newShopPrice || c.oldMarketPrice != c.newMarketPrice
+}
+
+// maxPriceChangePercent 返回单次改价允许的最大变动百分比，小于 0 表示不限制
+func (s *GoodsUsecase) maxPriceChangePercent() float64 {
+	percent := float64(s.conf.GetMaxPriceChangePercent())
+	if percent == 0 {
+		return defaultMaxPriceChangePercent
+	}
+	return percent
+}
+
+// checkPriceChange 校验售价和市场价的变动幅度，原价为 0 时不限制
+func (s *GoodsUsecase) checkPriceChange(c goodsPriceChange) error {
+	limit := s.maxPriceChangePercent()
+	if limit < 0 {
+		return nil
+	}
+	for _, p := range []struct {
+		name     string
+		old, new float32
+	}{
+		{"shop price", c.oldShopPrice, c.newShopPrice},
+		{"market price", c.oldMarketPrice, c.newMarketPrice},
+	} {
+		if p.old <= 0 || p.old == p.new {
+			continue
+		}
+		percent := math.Abs(float64(p.new-p.old)) / float64(p.old) * 100
+		if percent > limit {
+			return errx.ErrorGoodsPriceChangeTooLarge("%s changes from %v to %v (%.1f%%), exceeds the limit of %v%%, confirm with forcePriceChange",
+				p.name, p.old, p.new, percent, limit)
+		}
+	}
+	return nil
+}
+
+// guardPriceChange 校验改价的操作人和原因，未强制修改时校验变动幅度，返回整理后的操作人和原因
+func (s *GoodsUsecase) guardPriceChange(c goodsPriceChange, operator, reason string, force bool) (string, string, error) {
+	operator, reason, err := checkOperator(operator, reason)
+	if err != nil {
+		return "", "", err
+	}
+	if !force {
+		if err := s.checkPriceChange(c); err != nil {
+			return "", "", err
+		}
+	}
+	return operator, reason, nil
+}
+
+// recordPriceChange 在事务 tx 中记录价格变动，价格未变化时不记录
+func recordPriceChange(tx *gorm.DB, goodsID int32, c goodsPriceChange, operator, reason string) error {
+	if !c.changed() {
+		return nil
+	}
+	return tx.Create(&GoodsPriceLog{
+		GoodsID:        goodsID,
+		OldShopPrice:   c.oldShopPrice,
+		NewShopPrice:   c.newShopPrice,
+		OldMarketPrice: c.oldMarketPrice,
+		NewMarketPrice: c.newMarketPrice,
+		Operator:       operator,
+		Reason:         reason,
+		AddTime:        time.Now(),
+	}).Error
+}
+
+// GoodsPriceHistory 获取商品价格变动记录，按时间倒序
+func (s *GoodsUsecase) GoodsPriceHistory(ctx context.Context, req *pb.GoodsPriceHistoryRequest) (resp *pb.GoodsPriceHistoryResponse, err error) {
+	if req.StartTime > 0 && req.EndTime > 0 && req.StartTime > req.EndTime {
+		return nil, errx.ErrorInvalidParams("start time must not be after end time")
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).Select("id").First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	query := s.db.WithContext(ctx).Model(&GoodsPriceLog{}).Where("goods_id = ?", req.Id)
+	if req.StartTime > 0 {
+		query = query.Where("add_time >= ?", time.Unix(req.StartTime, 0))
+	}
+	if req.EndTime > 0 {
+		query = query.Where("add_time <= ?", time.Unix(req.EndTime, 0))
+	}
+
+	var total int64
+	if result := query.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+	var logs []*GoodsPriceLog
+	if result := query.Scopes(utils.Paginate(req.Pages, req.PagePerNums)).Order("id DESC").Find(&logs); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.GoodsPriceHistoryResponse{
+		Total: int32(total),
+		Data:  make([]*pb.GoodsPriceLogInfo, 0, len(logs)),
+	}
+	for _, l := range logs {
+		resp.Data = append(resp.Data, &pb.GoodsPriceLogInfo{
+			Id:             l.ID,
+			GoodsId:        l.GoodsID,
+			OldShopPrice:   l.OldShopPrice,
+			NewShopPrice:   l.NewShopPrice,
+			OldMarketPrice: l.OldMarketPrice,
+			NewMarketPrice: l.NewMarketPrice,
+			Operator:       l.Operator,
+			Reason:         l.Reason,
+			AddTime:        l.AddTime.Unix(),
+		})
+	}
+	return
+}
@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxOperatorLen 操作人最大长度
+	maxOperatorLen = 64
+	// maxStatusReasonLen 流转原因最大长度（字符）
+	maxStatusReasonLen = 255
+)
+
+// goodsTransitions 允许的商品状态流转
+var goodsTransitions = map[pb.GoodsStatus][]pb.GoodsStatus{
+	pb.GoodsStatus_GOODS_STATUS_DRAFT:          {pb.GoodsStatus_GOODS_STATUS_PENDING_REVIEW, pb.GoodsStatus_GOODS_STATUS_ARCHIVED},
+	pb.GoodsStatus_GOODS_STATUS_PENDING_REVIEW: {pb.GoodsStatus_GOODS_STATUS_APPROVED, pb.GoodsStatus_GOODS_STATUS_DRAFT},
+	pb.GoodsStatus_GOODS_STATUS_APPROVED:       {pb.GoodsStatus_GOODS_STATUS_ON_SALE, pb.GoodsStatus_GOODS_STATUS_DRAFT, pb.GoodsStatus_GOODS_STATUS_ARCHIVED},
+	pb.GoodsStatus_GOODS_STATUS_ON_SALE:        {pb.GoodsStatus_GOODS_STATUS_OFF_SALE},
+	pb.GoodsStatus_GOODS_STATUS_OFF_SALE:       {pb.GoodsStatus_GOODS_STATUS_ON_SALE, pb.GoodsStatus_GOODS_STATUS_DRAFT, pb.GoodsStatus_GOODS_STATUS_ARCHIVED},
+	pb.GoodsStatus_GOODS_STATUS_ARCHIVED:       {pb.GoodsStatus_GOODS_STATUS_DRAFT},
+}
+
+// canTransition 判断是否允许从 from 流转到 to
+func canTransition(from, to pb.GoodsStatus) bool {
+	for _, status := range goodsTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// goodsStatus 返回商品当前状态，流程上线前创建的商品按 OnSale 视为上架或下架
+func goodsStatus(g *Goods) pb.GoodsStatus {
+	if g.Status != int32(pb.GoodsStatus_GOODS_STATUS_UNSPECIFIED) {
+		return pb.GoodsStatus(g.Status)
+	}
+	if g.OnSale {
+		return pb.GoodsStatus_GOODS_STATUS_ON_SALE
+	}
+	return pb.GoodsStatus_GOODS_STATUS_OFF_SALE
+}
+
+// UpdateGoodsStatus 按发布流程流转商品状态并记录操作人和原因
+func (s *GoodsUsecase) UpdateGoodsStatus(ctx context.Context, req *pb.GoodsStatusRequest) (resp *pb.GoodsStatusResponse, err error) {
+	operator, reason, err := checkOperator(req.Operator, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return transitionGoods(tx, s.indexer, &goods, req.Status, operator, reason)
+	}); err != nil {
+		return nil, err
+	}
+	s.indexer.Notify()
+
+	return newGoodsStatusResponse(&goods), nil
+}
+
+// ScheduleGoodsSale 设置计划上下架时间
+// 计划上架要求商品已审核或已下架，计划下架要求商品已上架或同时计划了上架
+func (s *GoodsUsecase) ScheduleGoodsSale(ctx context.Context, req *pb.GoodsScheduleRequest) (resp *pb.GoodsStatusResponse, err error) {
+	operator, _, err := checkOperator(req.Operator, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+	status := goodsStatus(&goods)
+
+	now := time.Now()
+	var onSaleAt, offSaleAt *time.Time
+	if req.OnSaleTime > 0 {
+		t := time.Unix(req.OnSaleTime, 0)
+		if !t.After(now) {
+			return nil, errx.ErrorGoodsScheduleInvalid("on sale time must be in the future")
+		}
+		if status != pb.GoodsStatus_GOODS_STATUS_APPROVED && status != pb.GoodsStatus_GOODS_STATUS_OFF_SALE {
+			return nil, errx.ErrorGoodsScheduleInvalid("goods in status %s cannot be scheduled on sale", status)
+		}
+		onSaleAt = &t
+	}
+	if req.OffSaleTime > 0 {
+		t := time.Unix(req.OffSaleTime, 0)
+		if !t.After(now) {
+			return nil, errx.ErrorGoodsScheduleInvalid("off sale time must be in the future")
+		}
+		if onSaleAt != nil && !t.After(*onSaleAt) {
+			return nil, errx.ErrorGoodsScheduleInvalid("off sale time must be after on sale time")
+		}
+		if onSaleAt == nil && status != pb.GoodsStatus_GOODS_STATUS_ON_SALE {
+			return nil, errx.ErrorGoodsScheduleInvalid("goods in status %s cannot be scheduled off sale", status)
+		}
+		offSaleAt = &t
+	}
+
+	// 同时写入当前状态，流程上线前创建的商品由此获得明确状态，定时任务按状态查询
+	if result := s.db.WithContext(ctx).Model(&Goods{}).
+		Where("id = ? AND status = ?", goods.ID, goods.Status).
+		Updates(map[string]interface{}{
+			"status":      int32(status),
+			"on_sale_at":  onSaleAt,
+			"off_sale_at": offSaleAt,
+			"update_time": now,
+		}); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsStatusTransitionInvalid("goods status changed concurrently, please retry")
+	}
+	s.log.Infof("goods %d sale scheduled by %s: on=%v off=%v", goods.ID, operator, onSaleAt, offSaleAt)
+
+	goods.Status = int32(status)
+	goods.OnSaleAt = onSaleAt
+	goods.OffSaleAt = offSaleAt
+	return newGoodsStatusResponse(&goods), nil
+}
+
+// GoodsStatusLogs 获取商品状态流转记录，按时间倒序
+func (s *GoodsUsecase) GoodsStatusLogs(ctx context.Context, req *pb.GoodInfoRequest) (resp *pb.GoodsStatusLogListResponse, err error) {
+	var logs []*GoodsStatusLog
+	if result := s.db.WithContext(ctx).Where("goods_id = ?", req.Id).Order("id DESC").Find(&logs); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.GoodsStatusLogListResponse{
+		Total: int32(len(logs)),
+		Data:  make([]*pb.GoodsStatusLogInfo, 0, len(logs)),
+	}
+	for _, l := range logs {
+		resp.Data = append(resp.Data, &pb.GoodsStatusLogInfo{
+			Id:         l.ID,
+			GoodsId:    l.GoodsID,
+			FromStatus: pb.GoodsStatus(l.FromStatus),
+			ToStatus:   pb.GoodsStatus(l.ToStatus),
+			Operator:   l.Operator,
+			Reason:     l.Reason,
+			AddTime:    l.AddTime.Unix(),
+		})
+	}
+	return
+}
+
+// transitionGoods 在事务 tx 中将商品流转到 to 状态，同步 OnSale、清理失效的上下架计划、记录流转并登记索引任务
+// 以读取时的状态作为更新条件，并发流转时只有一个成功
+func transitionGoods(tx *gorm.DB, indexer *GoodsIndexer, goods *Goods, to pb.GoodsStatus, operator, reason string) error {
+	from := goodsStatus(goods)
+	if !canTransition(from, to) {
+		return errx.ErrorGoodsStatusTransitionInvalid("cannot change goods status from %s to %s", from, to)
+	}
+
+	onSaleAt, offSaleAt := goods.OnSaleAt, goods.OffSaleAt
+	// 计划上架只对已审核、已下架的商品有效，计划下架只对已上架或计划上架的商品有效
+	if to != pb.GoodsStatus_GOODS_STATUS_APPROVED && to != pb.GoodsStatus_GOODS_STATUS_OFF_SALE {
+		onSaleAt = nil
+	}
+	if to != pb.GoodsStatus_GOODS_STATUS_ON_SALE && onSaleAt == nil {
+		offSaleAt = nil
+	}
+
+	now := time.Now()
+	result := tx.Model(&Goods{}).
+		Where("id = ? AND status = ?", goods.ID, goods.Status).
+		Updates(map[string]interface{}{
+			"status":      int32(to),
+			"on_sale":     to == pb.GoodsStatus_GOODS_STATUS_ON_SALE,
+			"on_sale_at":  onSaleAt,
+			"off_sale_at": offSaleAt,
+			"update_time": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errx.ErrorGoodsStatusTransitionInvalid("goods status changed concurrently, please retry")
+	}
+
+	if err := tx.Create(&GoodsStatusLog{
+		GoodsID:    goods.ID,
+		FromStatus: int32(from),
+		ToStatus:   int32(to),
+		Operator:   operator,
+		Reason:     reason,
+		AddTime:    now,
+	}).Error; err != nil {
+		return err
+	}
+	if err := indexer.Enqueue(tx, goods.ID); err != nil {
+		return err
+	}
+
+	goods.Status = int32(to)
+	goods.OnSale = to == pb.GoodsStatus_GOODS_STATUS_ON_SALE
+	goods.OnSaleAt = onSaleAt
+	goods.OffSaleAt = offSaleAt
+	goods.UpdateTime = now
+	return nil
+}
+
+// checkOperator 校验操作人和原因
+func checkOperator(operator, reason string) (string, string, error) {
+	operator = strings.TrimSpace(operator)
+	reason = strings.TrimSpace(reason)
+	if operator == "" || len(operator) > maxOperatorLen {
+		return "", "", errx.ErrorInvalidParams("operator must be 1-%d characters", maxOperatorLen)
+	}
+	if len([]rune(reason)) > maxStatusReasonLen {
+		return "", "", errx.ErrorInvalidParams("reason is too long, max %d characters", maxStatusReasonLen)
+	}
+	return operator, reason, nil
+}
+
+// unixOrZero 返回时间的 Unix 秒，nil 返回 0
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// newGoodsStatusResponse 构建商品发布状态响应
+func newGoodsStatusResponse(g *Goods) *pb.GoodsStatusResponse {
+	return &pb.GoodsStatusResponse{
+		Id:          g.ID,
+		Status:      goodsStatus(g),
+		OnSaleTime:  unixOrZero(g.OnSaleAt),
+		OffSaleTime: unixOrZero(g.OffSaleAt),
+	}
+}
@@ -0,0 +1,33 @@
+package biz
+
+import (
+	"context"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+)
+
+// UpdateGoodsRating 以订单服务汇总的审核通过评价覆盖商品评分
+func (s *GoodsUsecase) UpdateGoodsRating(ctx context.Context, req *pb.GoodsRatingInfo) (resp *pb.Empty, err error) {
+	if req.RatingCount < 0 || req.RatingAvg < 0 || req.RatingAvg > 5 {
+		return nil, errx.ErrorInvalidParams("invalid rating avg %v or count %d", req.RatingAvg, req.RatingCount)
+	}
+
+	result := s.db.WithContext(ctx).Model(&Goods{}).Where("id = ?", req.GoodsId).Updates(map[string]interface{}{
+		"rating_avg":   req.RatingAvg,
+		"rating_count": req.RatingCount,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := s.db.WithContext(ctx).Model(&Goods{}).Where("id = ?", req.GoodsId).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, errx.ErrorGoodsNotFound("goods not found")
+		}
+	}
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,279 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+	orderV1 "mshop/service/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultRecommendLimit 未指定时返回的推荐数量
+	defaultRecommendLimit = 10
+	// maxRecommendLimit 推荐数量上限
+	maxRecommendLimit = 50
+	// maxRecommendSeeds 单次推荐请求的商品数量上限
+	maxRecommendSeeds = 50
+
+	// recommendReasonCoPurchase 经常一起购买
+	recommendReasonCoPurchase = "co_purchase"
+	// recommendReasonCategoryHot 同类热销
+	recommendReasonCategoryHot = "category_hot"
+
+	// coPurchaseRebuildInterval 共同购买关系的重建间隔
+	coPurchaseRebuildInterval = time.Hour
+	// coPurchaseLookbackDays 统计最近多少天内的订单
+	coPurchaseLookbackDays = 180
+	// coPurchaseMinOrders 至少在多少个订单中共同出现才视为共同购买，过滤偶然的组合
+	coPurchaseMinOrders = 2
+	// coPurchaseTopN 每个商品保留的共同购买商品数量
+	coPurchaseTopN = 50
+	// coPurchaseLockKey 重建任务锁，多实例同时只有一个实例重建
+	coPurchaseLockKey = "goods:recommend:rebuild:lock"
+	// coPurchaseLockTTL 重建任务锁的有效期，需大于一次重建的耗时
+	coPurchaseLockTTL = 10 * time.Minute
+	// coPurchasePageSize 每次从订单服务获取的商品数量
+	coPurchasePageSize = 500
+	// coPurchaseBatchSize 批量写入的行数
+	coPurchaseBatchSize = 1000
+)
+
+// RecommendGoods 推荐与指定商品经常一起购买的在售商品，共同购买数据不足时补充同类热销商品
+func (s *GoodsUsecase) RecommendGoods(ctx context.Context, req *pb.GoodsRecommendRequest) (resp *pb.GoodsRecommendResponse, err error) {
+	if len(req.GoodsIds) == 0 {
+		return nil, errx.ErrorInvalidParams("goods ids is required")
+	}
+	if len(req.GoodsIds) > maxRecommendSeeds {
+		return nil, errx.ErrorInvalidParams("too many goods ids, max %d", maxRecommendSeeds)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRecommendLimit
+	}
+	if limit > maxRecommendLimit {
+		limit = maxRecommendLimit
+	}
+
+	// 按共同购买的订单数汇总各商品的推荐度，多个商品时推荐度累加
+	var pairs []*GoodsCoPurchase
+	if result := s.db.WithContext(ctx).Where("goods_id IN ? AND related_goods_id NOT IN ?", req.GoodsIds, req.GoodsIds).Find(&pairs); result.Error != nil {
+		return nil, result.Error
+	}
+	scores := make(map[int32]int32, len(pairs))
+	for _, p := range pairs {
+		scores[p.RelatedGoodsID] += p.Score
+	}
+	candidates := make([]int32, 0, len(scores))
+	for id := range scores {
+		candidates = append(candidates, id)
+	}
+
+	// 只推荐在售商品
+	if len(candidates) > 0 {
+		var onSale []int32
+		if result := s.db.WithContext(ctx).Model(&Goods{}).Where("id IN ? AND on_sale = ?", candidates, true).Pluck("id", &onSale); result.Error != nil {
+			return nil, result.Error
+		}
+		candidates = onSale
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if scores[candidates[i]] != scores[candidates[j]] {
+			return scores[candidates[i]] > scores[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	// 数据不足时补充同类热销商品
+	var hot []int32
+	if len(candidates) < limit {
+		exclude := append(append([]int32{}, req.GoodsIds...), candidates...)
+		hot, err = s.categoryHotGoods(ctx, req.GoodsIds, exclude, limit-len(candidates))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp = &pb.GoodsRecommendResponse{
+		Data: make([]*pb.RecommendGoodsInfo, 0, len(candidates)+len(hot)),
+	}
+	ids := append(append([]int32{}, candidates...), hot...)
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	goods, err := s.BatchGetGoods(ctx, &pb.BatchGoodsIdInfo{Id: ids})
+	if err != nil {
+		return nil, err
+	}
+	goodsMap := make(map[int32]*pb.GoodsInfoResponse, len(goods.Data))
+	for _, g := range goods.Data {
+		goodsMap[g.Id] = g
+	}
+	for _, id := range candidates {
+		if g, ok := goodsMap[id]; ok {
+			resp.Data = append(resp.Data, &pb.RecommendGoodsInfo{Goods: g, Reason: recommendReasonCoPurchase, Score: scores[id]})
+		}
+	}
+	for _, id := range hot {
+		if g, ok := goodsMap[id]; ok {
+			resp.Data = append(resp.Data, &pb.RecommendGoodsInfo{Goods: g, Reason: recommendReasonCategoryHot})
+		}
+	}
+	return resp, nil
+}
+
+// categoryHotGoods 返回与 seeds 同分类的在售热销商品，按销量和点击量排序
+func (s *GoodsUsecase) categoryHotGoods(ctx context.Context, seeds, exclude []int32, limit int) ([]int32, error) {
+	var categoryIDs []int32
+	if result := s.db.WithContext(ctx).Model(&Goods{}).Distinct("category_id").Where("id IN ?", seeds).Pluck("category_id", &categoryIDs); result.Error != nil {
+		return nil, result.Error
+	}
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	var ids []int32
+	if result := s.db.WithContext(ctx).Model(&Goods{}).
+		Where("category_id IN ? AND on_sale = ? AND id NOT IN ?", categoryIDs, true, exclude).
+		Order("sold_num DESC, click_num DESC, id DESC").
+		Limit(limit).
+		Pluck("id", &ids); result.Error != nil {
+		return nil, result.Error
+	}
+	return ids, nil
+}
+
+// GoodsRecommender 共同购买关系重建任务
+// 定期从订单服务获取已支付订单中同一订单内的商品组合，重建 goods_co_purchase 表，多实例运行时通过 Redis 锁保证同时只有一个实例重建
+type GoodsRecommender struct {
+	db          *gorm.DB
+	log         *log.Helper
+	locker      *data.Locker
+	orderClient orderV1.OrderClient
+
+	stop chan struct{}
+}
+
+// NewGoodsRecommender 创建共同购买关系重建任务
+func NewGoodsRecommender(db *gorm.DB, logger log.Logger, locker *data.Locker, orderClient orderV1.OrderClient) *GoodsRecommender {
+	return &GoodsRecommender{
+		db:          db,
+		log:         log.NewHelper(log.With(logger, "module", "biz/recommend")),
+		locker:      locker,
+		orderClient: orderClient,
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动重建任务
+func (r *GoodsRecommender) Start(ctx context.Context) error {
+	r.log.Info("goods recommender started")
+
+	ticker := time.NewTicker(coPurchaseRebuildInterval)
+	defer ticker.Stop()
+
+	for {
+		r.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止重建任务
+func (r *GoodsRecommender) Stop(ctx context.Context) error {
+	close(r.stop)
+	r.log.Info("goods recommender stopped")
+	return nil
+}
+
+// run 距上次重建超过重建间隔时重建共同购买关系，避免多实例或频繁重启时重复重建
+func (r *GoodsRecommender) run(ctx context.Context) {
+	unlock, err := r.locker.TryLock(ctx, coPurchaseLockKey, coPurchaseLockTTL)
+	if err != nil {
+		r.log.Errorf("failed to acquire co-purchase rebuild lock: %v", err)
+		return
+	}
+	if unlock == nil {
+		// 其他实例正在重建
+		return
+	}
+	defer unlock()
+
+	var last struct{ UpdateTime *time.Time }
+	if result := r.db.WithContext(ctx).Model(&GoodsCoPurchase{}).Select("MAX(update_time) AS update_time").Scan(&last); result.Error != nil {
+		r.log.Errorf("failed to get last co-purchase rebuild time: %v", result.Error)
+		return
+	}
+	// 留出一分钟余量，避免与定时器同时到期时跳过本轮
+	if last.UpdateTime != nil && time.Since(*last.UpdateTime) < coPurchaseRebuildInterval-time.Minute {
+		return
+	}
+
+	start := time.Now()
+	count, err := r.rebuild(ctx)
+	if err != nil {
+		r.log.Errorf("failed to rebuild co-purchase: %v", err)
+		return
+	}
+	r.log.Infof("rebuilt %d co-purchase pairs in %v", count, time.Since(start))
+}
+
+// rebuild 按商品分页获取订单服务统计的共同购买关系，全部获取成功后整表替换
+func (r *GoodsRecommender) rebuild(ctx context.Context) (int, error) {
+	now := time.Now()
+	pairs := make([]*GoodsCoPurchase, 0)
+	var after int32
+	for {
+		resp, err := r.orderClient.GoodsCoPurchaseList(ctx, &orderV1.GoodsCoPurchaseRequest{
+			LookbackDays: coPurchaseLookbackDays,
+			MinOrders:    coPurchaseMinOrders,
+			TopN:         coPurchaseTopN,
+			AfterGoodsId: after,
+			GoodsLimit:   coPurchasePageSize,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range resp.Data {
+			pairs = append(pairs, &GoodsCoPurchase{
+				GoodsID:        p.GoodsId,
+				RelatedGoodsID: p.RelatedGoodsId,
+				Score:          p.Score,
+				UpdateTime:     now,
+			})
+		}
+		if resp.NextGoodsId == 0 {
+			break
+		}
+		after = resp.NextGoodsId
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&GoodsCoPurchase{}).Error; err != nil {
+			return err
+		}
+		if len(pairs) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(pairs, coPurchaseBatchSize).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(pairs), nil
+}
@@ -0,0 +1,266 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultReindexBatchSize 默认每批写入数量
+	defaultReindexBatchSize = 500
+	// maxReindexBatchSize 每批写入数量上限
+	maxReindexBatchSize = 5000
+)
+
+// 索引重建状态
+const (
+	ReindexStateIdle      = "idle"
+	ReindexStateRunning   = "running"
+	ReindexStateSucceeded = "succeeded"
+	ReindexStateFailed    = "failed"
+)
+
+// reindexState 记录本实例最近一次索引重建的进度
+type reindexState struct {
+	mu     sync.Mutex
+	status *pb.ReindexStatusResponse
+}
+
+// snapshot 返回进度快照
+func (r *reindexState) snapshot() *pb.ReindexStatusResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.status == nil {
+		return &pb.ReindexStatusResponse{State: ReindexStateIdle}
+	}
+	return proto.Clone(r.status).(*pb.ReindexStatusResponse)
+}
+
+// running 判断是否有正在进行的重建
+func (r *reindexState) running() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.status != nil && r.status.State == ReindexStateRunning
+}
+
+// update 在锁保护下修改进度
+func (r *reindexState) update(fn func(status *pb.ReindexStatusResponse)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fn(r.status)
+}
+
+// ReindexGoods 全量重建商品索引
+// 新建下一个版本索引后立即返回，由后台协程分批写入并切换别名，进度通过 GetReindexStatus 查询
+func (s *GoodsUsecase) ReindexGoods(ctx context.Context, req *pb.ReindexGoodsRequest) (resp *pb.ReindexStatusResponse, err error) {
+	batchSize := int(req.BatchSize)
+	switch {
+	case batchSize <= 0:
+		batchSize = defaultReindexBatchSize
+	case batchSize > maxReindexBatchSize:
+		batchSize = maxReindexBatchSize
+	}
+
+	s.reindex.mu.Lock()
+	defer s.reindex.mu.Unlock()
+
+	if s.reindex.status != nil && s.reindex.status.State == ReindexStateRunning {
+		return nil, errx.ErrorGoodsReindexRunning("reindex to %s is running", s.reindex.status.Index)
+	}
+
+	// 确定当前索引和下一个版本号
+	current, legacy, err := s.indices.ResolveGoodsAlias(ctx)
+	if err != nil {
+		s.log.Errorf("failed to resolve goods alias: %v", err)
+		return nil, errx.ErrorSearchUnavailable("resolve goods alias: %v", err)
+	}
+	indices, err := s.indices.ListGoodsIndices(ctx)
+	if err != nil {
+		s.log.Errorf("failed to list goods indices: %v", err)
+		return nil, errx.ErrorSearchUnavailable("list goods indices: %v", err)
+	}
+	version := 2
+	if len(indices) > 0 {
+		version = indices[len(indices)-1].Version + 1
+	}
+	index := data.GoodsIndexVersionName(version)
+
+	// 先记录开始时间，重建期间发生的写入在切换别名后补偿同步
+	startTime := time.Now()
+	if err := s.indices.CreateGoodsIndex(ctx, index); err != nil {
+		s.log.Errorf("failed to create index %s: %v", index, err)
+		return nil, errx.ErrorSearchUnavailable("create index %s: %v", index, err)
+	}
+
+	s.reindex.status = &pb.ReindexStatusResponse{
+		State:         ReindexStateRunning,
+		Index:         index,
+		PreviousIndex: current,
+		StartTime:     startTime.Unix(),
+	}
+	resp = proto.Clone(s.reindex.status).(*pb.ReindexStatusResponse)
+
+	go s.runReindex(index, current, legacy, batchSize, startTime)
+
+	return resp, nil
+}
+
+// GetReindexStatus 查询索引重建进度
+func (s *GoodsUsecase) GetReindexStatus(ctx context.Context, req *pb.Empty) (resp *pb.ReindexStatusResponse, err error) {
+	return s.reindex.snapshot(), nil
+}
+
+// RollbackGoodsIndex 将 goods 别名切回上一个版本索引
+func (s *GoodsUsecase) RollbackGoodsIndex(ctx context.Context, req *pb.Empty) (resp *pb.GoodsIndexResponse, err error) {
+	if s.reindex.running() {
+		return nil, errx.ErrorGoodsReindexRunning("reindex is running")
+	}
+
+	current, legacy, err := s.indices.ResolveGoodsAlias(ctx)
+	if err != nil {
+		s.log.Errorf("failed to resolve goods alias: %v", err)
+		return nil, errx.ErrorSearchUnavailable("resolve goods alias: %v", err)
+	}
+	if current == "" || legacy {
+		return nil, errx.ErrorGoodsIndexNoPrevious("goods alias is not managed by versioned indices")
+	}
+
+	indices, err := s.indices.ListGoodsIndices(ctx)
+	if err != nil {
+		s.log.Errorf("failed to list goods indices: %v", err)
+		return nil, errx.ErrorSearchUnavailable("list goods indices: %v", err)
+	}
+
+	// 找到当前索引之前最近的一个版本
+	var currentInfo, previous *data.GoodsIndexInfo
+	for _, info := range indices {
+		if info.Name == current {
+			currentInfo = info
+			break
+		}
+		previous = info
+	}
+	if currentInfo == nil || previous == nil {
+		return nil, errx.ErrorGoodsIndexNoPrevious("no index before %s", current)
+	}
+
+	if err := s.indices.SwitchGoodsAlias(ctx, current, false, previous.Name); err != nil {
+		s.log.Errorf("failed to switch goods alias to %s: %v", previous.Name, err)
+		return nil, errx.ErrorSearchUnavailable("switch goods alias: %v", err)
+	}
+	s.log.Infof("goods alias rolled back from %s to %s", current, previous.Name)
+
+	// 当前索引创建后的写入可能未进入旧索引，重新同步
+	if err := s.enqueueChangedSince(ctx, currentInfo.CreatedAt); err != nil {
+		s.log.Errorf("failed to enqueue goods changed since %s: %v", currentInfo.CreatedAt, err)
+	}
+
+	return &pb.GoodsIndexResponse{
+		Index:         previous.Name,
+		PreviousIndex: current,
+	}, nil
+}
+
+// runReindex 后台执行全量写入并切换别名
+func (s *GoodsUsecase) runReindex(index, current string, legacy bool, batchSize int, startTime time.Time) {
+	ctx := context.Background()
+
+	err := s.buildIndex(ctx, index, batchSize)
+	if err == nil {
+		err = s.indices.SwitchGoodsAlias(ctx, current, legacy, index)
+	}
+	if err != nil {
+		s.log.Errorf("reindex to %s failed: %v", index, err)
+		s.reindex.update(func(status *pb.ReindexStatusResponse) {
+			status.State = ReindexStateFailed
+			status.Error = err.Error()
+			status.FinishTime = time.Now().Unix()
+		})
+		return
+	}
+	s.log.Infof("goods alias switched from %q to %s", current, index)
+
+	// 重建期间的写入进入了旧索引，交给索引同步器补写到新索引
+	if err := s.enqueueChangedSince(ctx, startTime); err != nil {
+		s.log.Errorf("failed to enqueue goods changed since %s: %v", startTime, err)
+	}
+
+	s.reindex.update(func(status *pb.ReindexStatusResponse) {
+		status.State = ReindexStateSucceeded
+		status.FinishTime = time.Now().Unix()
+	})
+}
+
+// buildIndex 按 ID 顺序分批读取 MySQL 商品并写入新索引
+func (s *GoodsUsecase) buildIndex(ctx context.Context, index string, batchSize int) error {
+	var total int64
+	if result := s.db.WithContext(ctx).Model(&Goods{}).Count(&total); result.Error != nil {
+		return result.Error
+	}
+	s.reindex.update(func(status *pb.ReindexStatusResponse) {
+		status.Total = total
+	})
+
+	var lastID int32
+	for {
+		var goods []Goods
+		if result := s.db.WithContext(ctx).
+			Preload(EsGoodsPreload).
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(batchSize).
+			Find(&goods); result.Error != nil {
+			return result.Error
+		}
+		if len(goods) == 0 {
+			break
+		}
+
+		docs := make(map[int32]interface{}, len(goods))
+		for i := range goods {
+			docs[goods[i].ID] = NewEsGoods(&goods[i])
+		}
+		if err := s.indices.BulkIndexGoods(ctx, index, docs); err != nil {
+			return err
+		}
+
+		lastID = goods[len(goods)-1].ID
+		s.reindex.update(func(status *pb.ReindexStatusResponse) {
+			status.Indexed += int64(len(goods))
+		})
+	}
+
+	return s.indices.RefreshGoodsIndex(ctx, index)
+}
+
+// enqueueChangedSince 为 since 之后新增、修改或删除的商品登记索引任务
+func (s *GoodsUsecase) enqueueChangedSince(ctx context.Context, since time.Time) error {
+	var ids []int32
+	if result := s.db.WithContext(ctx).Unscoped().Model(&Goods{}).
+		Where("update_time >= ? OR deleted_at >= ?", since, since).
+		Pluck("id", &ids); result.Error != nil {
+		return result.Error
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return s.indexer.Enqueue(tx, ids...)
+	}); err != nil {
+		return err
+	}
+	s.indexer.Notify()
+	return nil
+}
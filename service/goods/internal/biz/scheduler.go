@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// saleSchedulePollInterval 检查到期上下架计划的间隔
+	saleSchedulePollInterval = 30 * time.Second
+	// saleScheduleBatchSize 每批处理的商品数量
+	saleScheduleBatchSize = 100
+	// saleScheduleOperator 定时任务记录在流转记录中的操作人
+	saleScheduleOperator = "scheduler"
+)
+
+// GoodsSaleScheduler 商品定时上下架任务
+// 定期查询到期的上下架计划并执行状态流转，多实例同时运行时以状态作为更新条件，同一计划只会执行一次
+type GoodsSaleScheduler struct {
+	db      *gorm.DB
+	log     *log.Helper
+	indexer *GoodsIndexer
+
+	stop chan struct{}
+}
+
+// NewGoodsSaleScheduler 创建商品定时上下架任务
+func NewGoodsSaleScheduler(db *gorm.DB, logger log.Logger, indexer *GoodsIndexer) *GoodsSaleScheduler {
+	return &GoodsSaleScheduler{
+		db:      db,
+		log:     log.NewHelper(log.With(logger, "module", "biz/scheduler")),
+		indexer: indexer,
+		stop:    make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动定时任务
+func (sc *GoodsSaleScheduler) Start(ctx context.Context) error {
+	sc.log.Info("goods sale scheduler started")
+
+	ticker := time.NewTicker(saleSchedulePollInterval)
+	defer ticker.Stop()
+
+	for {
+		sc.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sc.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止定时任务
+func (sc *GoodsSaleScheduler) Stop(ctx context.Context) error {
+	close(sc.stop)
+	sc.log.Info("goods sale scheduler stopped")
+	return nil
+}
+
+// run 执行所有到期的上架和下架计划
+func (sc *GoodsSaleScheduler) run(ctx context.Context) {
+	onSale := sc.apply(ctx, "on_sale_at", pb.GoodsStatus_GOODS_STATUS_ON_SALE, "scheduled on sale",
+		pb.GoodsStatus_GOODS_STATUS_APPROVED, pb.GoodsStatus_GOODS_STATUS_OFF_SALE)
+	offSale := sc.apply(ctx, "off_sale_at", pb.GoodsStatus_GOODS_STATUS_OFF_SALE, "scheduled off sale",
+		pb.GoodsStatus_GOODS_STATUS_ON_SALE)
+	if onSale+offSale > 0 {
+		sc.indexer.Notify()
+	}
+}
+
+// apply 将 column 计划时间已到且处于 from 状态的商品流转到 to 状态，返回成功流转的数量
+func (sc *GoodsSaleScheduler) apply(ctx context.Context, column string, to pb.GoodsStatus, reason string, from ...pb.GoodsStatus) int {
+	statuses := make([]int32, 0, len(from))
+	for _, status := range from {
+		statuses = append(statuses, int32(status))
+	}
+
+	applied := 0
+	var lastID int32
+	for {
+		var goods []*Goods
+		if result := sc.db.WithContext(ctx).
+			Where(column+" <= ? AND status IN ? AND id > ?", time.Now(), statuses, lastID).
+			Order("id").
+			Limit(saleScheduleBatchSize).
+			Find(&goods); result.Error != nil {
+			sc.log.Errorf("failed to query goods due for %s: %v", reason, result.Error)
+			return applied
+		}
+
+		for _, g := range goods {
+			lastID = g.ID
+			// 上架后计划上架时间失效，由 transitionGoods 清理；下架计划执行后需显式清理
+			if to == pb.GoodsStatus_GOODS_STATUS_OFF_SALE {
+				g.OffSaleAt = nil
+			}
+			err := sc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				return transitionGoods(tx, sc.indexer, g, to, saleScheduleOperator, reason)
+			})
+			if errx.IsGoodsStatusTransitionInvalid(err) {
+				// 其他实例已处理或状态已被修改
+				continue
+			}
+			if err != nil {
+				sc.log.Errorf("failed to apply %s to goods %d: %v", reason, g.ID, err)
+				continue
+			}
+			sc.log.Infof("goods %d %s", g.ID, reason)
+			applied++
+		}
+
+		if len(goods) < saleScheduleBatchSize {
+			return applied
+		}
+	}
+}
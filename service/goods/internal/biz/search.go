@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"gorm.io/gorm"
+)
+
+// defaultSearchPageSize 未指定分页时的返回数量，与 ES 默认 size 一致
+const defaultSearchPageSize = 10
+
+// newSearchBreaker 创建 ES 搜索熔断器
+func newSearchBreaker() circuitbreaker.CircuitBreaker {
+	return sre.NewBreaker(
+		sre.WithRequest(10),
+		sre.WithWindow(10*time.Second),
+	)
+}
+
+// searchGoods 优先通过 ES 搜索，ES 不可用或熔断打开时降级为 MySQL 搜索
+// 降级结果没有聚合数据，degraded 为 true
+func (s *GoodsUsecase) searchGoods(ctx context.Context, req *pb.GoodsFilterRequest) (result *data.GoodsSearchResult, degraded bool, err error) {
+	// 先校验分页游标，避免无效游标被计入熔断失败
+	if _, err := data.ParsePageToken(req.PageToken, req.Sort); err != nil {
+		return nil, false, errx.ErrorSearchPageTokenInvalid("invalid page token")
+	}
+
+	if err := s.searchBreaker.Allow(); err != nil {
+		s.log.Warnf("elasticsearch circuit breaker open, fallback to MySQL search")
+	} else {
+		result, err := s.searcher.SearchGoodsIDs(ctx, req)
+		if err == nil {
+			s.searchBreaker.MarkSuccess()
+			return result, false, nil
+		}
+		// 请求参数导致的错误直接返回，只有连接错误和 5xx 计入熔断
+		if errors.Is(err, data.ErrSearchRequestInvalid) || errors.Is(err, data.ErrInvalidPageToken) {
+			s.searchBreaker.MarkSuccess()
+			s.log.Warnf("goods search request rejected: %v", err)
+			return nil, false, errx.ErrorInvalidParams("invalid search request, check pages, pagePerNums and filters")
+		}
+		s.searchBreaker.MarkFailed()
+		s.log.Errorf("failed to search goods from ES, fallback to MySQL search: %v", err)
+	}
+
+	result, err = s.searchGoodsFromDB(ctx, req)
+	if errors.Is(err, data.ErrInvalidPageToken) {
+		return nil, true, errx.ErrorSearchPageTokenInvalid("page token can not be used while search is degraded")
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	return result, true, nil
+}
+
+// searchGoodsFromDB 在 MySQL 中按相同的过滤条件搜索商品 ID
+// 只支持偏移量游标，ES 返回的 search_after 游标无法在数据库中续读
+func (s *GoodsUsecase) searchGoodsFromDB(ctx context.Context, req *pb.GoodsFilterRequest) (*data.GoodsSearchResult, error) {
+	token, err := data.ParsePageToken(req.PageToken, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil && len(token.After) > 0 {
+		return nil, data.ErrInvalidPageToken
+	}
+
+	query, err := s.goodsFilterQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if result := query.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+
+	// 分页
+	offset, limit := 0, defaultSearchPageSize
+	if req.PagePerNums > 0 {
+		limit = int(req.PagePerNums)
+	}
+	switch {
+	case token != nil:
+		offset = token.Offset
+	case req.Pages > 0 && req.PagePerNums > 0:
+		offset = int((req.Pages - 1) * req.PagePerNums)
+	}
+
+	var ids []int32
+	if result := query.Order(goodsOrder(req.Sort)).Offset(offset).Limit(limit).Pluck("id", &ids); result.Error != nil {
+		return nil, result.Error
+	}
+
+	result := &data.GoodsSearchResult{
+		IDs:   ids,
+		Total: total,
+	}
+	if int64(offset+limit) < total {
+		next := &data.PageToken{Sort: req.Sort, Offset: offset + limit}
+		result.NextPageToken = next.Encode()
+	}
+	return result, nil
+}
+
+// goodsFilterQuery 按搜索过滤条件构建 MySQL 查询，不含分页和排序，供搜索降级和导出共用
+func (s *GoodsUsecase) goodsFilterQuery(ctx context.Context, req *pb.GoodsFilterRequest) (*gorm.DB, error) {
+	query := s.db.WithContext(ctx).Model(&Goods{})
+
+	// 关键词搜索
+	if req.KeyWords != "" {
+		like := "%" + escapeLike(req.KeyWords) + "%"
+		query = query.Where("name LIKE ? OR goods_brief LIKE ?", like, like)
+	}
+
+	// 价格过滤
+	if req.PriceMin > 0 {
+		query = query.Where("shop_price >= ?", req.PriceMin)
+	}
+	if req.PriceMax > 0 {
+		query = query.Where("shop_price <= ?", req.PriceMax)
+	}
+
+	// 默认只返回在售商品
+	if !req.IncludeOffSale {
+		query = query.Where("on_sale = ?", true)
+	}
+
+	// 布尔值过滤
+	if req.IsHot {
+		query = query.Where("is_hot = ?", true)
+	}
+	if req.IsNew {
+		query = query.Where("is_new = ?", true)
+	}
+
+	// 分类过滤，包含整棵子树
+	if req.TopCategory > 0 {
+		categoryIDs, err := categorySubtreeIDs(s.db.WithContext(ctx), req.TopCategory)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("category_id IN ?", categoryIDs)
+	}
+	if req.IsTab {
+		var tabIDs []int32
+		if result := s.db.WithContext(ctx).Model(&Category{}).Where("is_tab = ?", true).Pluck("id", &tabIDs); result.Error != nil {
+			return nil, result.Error
+		}
+		categoryIDs := make([]int32, 0)
+		for _, id := range tabIDs {
+			ids, err := categorySubtreeIDs(s.db.WithContext(ctx), id)
+			if err != nil {
+				return nil, err
+			}
+			categoryIDs = append(categoryIDs, ids...)
+		}
+		query = query.Where("category_id IN ?", categoryIDs)
+	}
+
+	// 品牌过滤
+	if req.Brand > 0 {
+		query = query.Where("brand_id = ?", req.Brand)
+	}
+
+	// 属性过滤
+	for _, f := range data.GoodsAttrFilters(req) {
+		conds := s.db.WithContext(ctx)
+		for i, v := range f.Values {
+			cond := "JSON_CONTAINS(attrs, JSON_OBJECT('name', ?, 'value', ?))"
+			if i == 0 {
+				conds = conds.Where(cond, f.Name, v)
+			} else {
+				conds = conds.Or(cond, f.Name, v)
+			}
+		}
+		query = query.Where(conds)
+	}
+
+	return query, nil
+}
+
+// goodsOrder 返回与 ES 排序一致的 MySQL 排序条件，无相关度时默认按 ID 倒序
+func goodsOrder(sort pb.GoodsSort) string {
+	switch sort {
+	case pb.GoodsSort_GOODS_SORT_PRICE_ASC:
+		return "shop_price ASC, sold_num DESC, id DESC"
+	case pb.GoodsSort_GOODS_SORT_PRICE_DESC:
+		return "shop_price DESC, sold_num DESC, id DESC"
+	case pb.GoodsSort_GOODS_SORT_SALES:
+		return "sold_num DESC, fav_num DESC, id DESC"
+	case pb.GoodsSort_GOODS_SORT_NEWEST:
+		return "add_time DESC, id DESC"
+	case pb.GoodsSort_GOODS_SORT_POPULAR:
+		return "click_num DESC, fav_num DESC, id DESC"
+	default:
+		return "id DESC"
+	}
+}
@@ -0,0 +1,382 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxSkuSpecs 单个 SKU 的规格属性数量上限
+	maxSkuSpecs = 5
+	// maxSkuSpecLen 规格名和规格值的最大长度（字符）
+	maxSkuSpecLen = 32
+)
+
+// GoodsSkuList 获取商品的 SKU 列表
+func (s *GoodsUsecase) GoodsSkuList(ctx context.Context, req *pb.GoodInfoRequest) (resp *pb.GoodsSkuListResponse, err error) {
+	var goods Goods
+	if result := s.db.WithContext(ctx).First(&goods, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	var skus []*GoodsSku
+	if result := s.db.WithContext(ctx).Where("goods_id = ?", goods.ID).Order("id").Find(&skus); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.GoodsSkuListResponse{
+		Total: int32(len(skus)),
+		Data:  make([]*pb.GoodsSkuInfo, 0, len(skus)),
+	}
+	for _, sku := range skus {
+		info := newGoodsSkuInfo(sku)
+		info.GoodsName = goods.Name
+		resp.Data = append(resp.Data, info)
+	}
+	return
+}
+
+// BatchGetSkus 批量获取 SKU 信息，购物车和订单按 SKU 查询价格和所属商品
+func (s *GoodsUsecase) BatchGetSkus(ctx context.Context, req *pb.BatchSkuIdInfo) (resp *pb.GoodsSkuListResponse, err error) {
+	resp = &pb.GoodsSkuListResponse{
+		Data: make([]*pb.GoodsSkuInfo, 0, len(req.Id)),
+	}
+	if len(req.Id) == 0 {
+		return
+	}
+
+	var skus []*GoodsSku
+	if result := s.db.WithContext(ctx).Preload("Goods").Find(&skus, req.Id); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, sku := range skus {
+		info := newGoodsSkuInfo(sku)
+		if sku.Goods != nil {
+			info.GoodsName = sku.Goods.Name
+		}
+		resp.Data = append(resp.Data, info)
+	}
+	resp.Total = int32(len(resp.Data))
+	return
+}
+
+// CreateGoodsSku 为商品创建 SKU，并同步商品的价格和库存汇总
+func (s *GoodsUsecase) CreateGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (resp *pb.GoodsSkuInfo, err error) {
+	specs, err := normalizeSkuSpecs(req.Specs)
+	if err != nil {
+		return nil, err
+	}
+	skuCode := strings.TrimSpace(req.SkuCode)
+	if skuCode == "" {
+		return nil, errx.ErrorInvalidParams("sku code is required")
+	}
+	if req.ShopPrice <= 0 || req.MarketPrice < 0 {
+		return nil, errx.ErrorGoodsPriceInvalid("invalid sku price")
+	}
+	if req.GetStocks() < 0 {
+		return nil, errx.ErrorInvalidParams("invalid sku stocks")
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).First(&goods, req.GoodsId); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+
+	now := time.Now()
+	sku := &GoodsSku{
+		GoodsID:     goods.ID,
+		SkuCode:     skuCode,
+		Specs:       specs,
+		ShopPrice:   req.ShopPrice,
+		MarketPrice: req.MarketPrice,
+		Images:      req.Images,
+		Stocks:      req.GetStocks(),
+		OnSale:      req.GetOnSale(),
+		AddTime:     now,
+		UpdateTime:  now,
+	}
+	if sku.Images == nil {
+		sku.Images = GormList{}
+	}
+
+	// SKU、商品汇总与索引任务在同一事务中写入
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkGoodsSku(tx, sku); err != nil {
+			return err
+		}
+		if err := tx.Create(sku).Error; err != nil {
+			return goodsSkuCodeError(err, sku.SkuCode)
+		}
+		return s.syncGoodsSkuSummary(tx, goods.ID, req)
+	}); err != nil {
+		return nil, err
+	}
+	s.indexer.Notify()
+
+	resp = newGoodsSkuInfo(sku)
+	resp.GoodsName = goods.Name
+	return
+}
+
+// UpdateGoodsSku 更新 SKU，并同步商品的价格和库存汇总
+func (s *GoodsUsecase) UpdateGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (resp *pb.Empty, err error) {
+	if req.ShopPrice < 0 || req.MarketPrice < 0 {
+		return nil, errx.ErrorGoodsPriceInvalid("invalid sku price")
+	}
+	if req.GetStocks() < 0 {
+		return nil, errx.ErrorInvalidParams("invalid sku stocks")
+	}
+
+	var sku GoodsSku
+	if result := s.db.WithContext(ctx).First(&sku, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsSkuNotFound("sku not found")
+	}
+	oldShopPrice, oldMarketPrice := sku.ShopPrice, sku.MarketPrice
+
+	// 更新字段
+	if len(req.Specs) > 0 {
+		specs, err := normalizeSkuSpecs(req.Specs)
+		if err != nil {
+			return nil, err
+		}
+		sku.Specs = specs
+	}
+	if code := strings.TrimSpace(req.SkuCode); code != "" {
+		sku.SkuCode = code
+	}
+	if req.ShopPrice > 0 {
+		sku.ShopPrice = req.ShopPrice
+	}
+	if req.MarketPrice > 0 {
+		sku.MarketPrice = req.MarketPrice
+	}
+	if len(req.Images) > 0 {
+		sku.Images = req.Images
+	}
+	// SKU 改价需记录操作人，变动幅度超过限制时需确认
+	if price := (goodsPriceChange{
+		oldShopPrice:   oldShopPrice,
+		newShopPrice:   sku.ShopPrice,
+		oldMarketPrice: oldMarketPrice,
+		newMarketPrice: sku.MarketPrice,
+	}); price.changed() {
+		if _, _, err := s.guardPriceChange(price, req.Operator, req.PriceChangeReason, req.ForcePriceChange); err != nil {
+			return nil, err
+		}
+	}
+	// 库存和上下架状态可以改为 0 和 false，以是否传入区分
+	if req.Stocks != nil {
+		sku.Stocks = *req.Stocks
+	}
+	if req.OnSale != nil {
+		sku.OnSale = *req.OnSale
+	}
+	sku.UpdateTime = time.Now()
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkGoodsSku(tx, &sku); err != nil {
+			return err
+		}
+		if err := tx.Save(&sku).Error; err != nil {
+			return goodsSkuCodeError(err, sku.SkuCode)
+		}
+		return s.syncGoodsSkuSummary(tx, sku.GoodsID, req)
+	}); err != nil {
+		return nil, err
+	}
+	s.indexer.Notify()
+
+	return &pb.Empty{}, nil
+}
+
+// DeleteGoodsSku 删除 SKU，并同步商品的价格和库存汇总
+func (s *GoodsUsecase) DeleteGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (resp *pb.Empty, err error) {
+	var sku GoodsSku
+	if result := s.db.WithContext(ctx).First(&sku, req.Id); result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsSkuNotFound("sku not found")
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&sku).Error; err != nil {
+			return err
+		}
+		return s.syncGoodsSkuSummary(tx, sku.GoodsID, req)
+	}); err != nil {
+		return nil, err
+	}
+	s.indexer.Notify()
+
+	return &pb.Empty{}, nil
+}
+
+// syncGoodsSkuSummary 按 SKU 汇总商品售价和库存并登记索引任务，需在事务中调用
+// 售价取在售 SKU 的最低价，没有在售 SKU 时保留原售价；商品下没有 SKU 时库存归零
+// 商品售价随之变化时与直接改价一样校验操作人和变动幅度，并以 req 中的操作人和原因记录价格变动
+func (s *GoodsUsecase) syncGoodsSkuSummary(tx *gorm.DB, goodsID int32, req *pb.GoodsSkuInfo) error {
+	var summary struct {
+		Stocks   int64
+		MinPrice *float32
+	}
+	if result := tx.Model(&GoodsSku{}).
+		Select("COALESCE(SUM(stocks), 0) AS stocks, MIN(CASE WHEN on_sale THEN shop_price END) AS min_price").
+		Where("goods_id = ?", goodsID).
+		Scan(&summary); result.Error != nil {
+		return result.Error
+	}
+	// 删除最后一个 SKU 后库存为 0，售价保留最后的汇总值，之后可直接修改商品售价和库存
+	updates := map[string]interface{}{
+		"stocks":      summary.Stocks,
+		"update_time": time.Now(),
+	}
+	if summary.MinPrice != nil {
+		var goods Goods
+		if err := tx.Select("id", "shop_price", "market_price").First(&goods, goodsID).Error; err != nil {
+			return err
+		}
+		price := goodsPriceChange{
+			oldShopPrice:   goods.ShopPrice,
+			newShopPrice:   *summary.MinPrice,
+			oldMarketPrice: goods.MarketPrice,
+			newMarketPrice: goods.MarketPrice,
+		}
+		if price.changed() {
+			operator, reason, err := s.guardPriceChange(price, req.Operator, req.PriceChangeReason, req.ForcePriceChange)
+			if err != nil {
+				return err
+			}
+			if err := recordPriceChange(tx, goodsID, price, operator, reason); err != nil {
+				return err
+			}
+		}
+		updates["shop_price"] = *summary.MinPrice
+	}
+	if result := tx.Model(&Goods{}).Where("id = ?", goodsID).Updates(updates); result.Error != nil {
+		return result.Error
+	}
+	return s.indexer.Enqueue(tx, goodsID)
+}
+
+// checkGoodsSku 校验 SKU 编码全局唯一、同一商品下规格组合唯一
+func checkGoodsSku(tx *gorm.DB, sku *GoodsSku) error {
+	var existing GoodsSku
+	err := tx.Where("sku_code = ? AND id <> ?", sku.SkuCode, sku.ID).First(&existing).Error
+	if err == nil {
+		return errx.ErrorGoodsSkuCodeExists("sku code %s already exists", sku.SkuCode)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	var siblings []*GoodsSku
+	if result := tx.Where("goods_id = ? AND id <> ?", sku.GoodsID, sku.ID).Find(&siblings); result.Error != nil {
+		return result.Error
+	}
+	key := sku.Specs.key()
+	for _, sibling := range siblings {
+		if sibling.Specs.key() == key {
+			return errx.ErrorGoodsSkuSpecExists("sku with specs %s already exists", key)
+		}
+	}
+	return nil
+}
+
+// goodsSkuCodeError 将并发写入时触发的唯一索引冲突转换为 SKU 编码已存在
+func goodsSkuCodeError(err error, skuCode string) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errx.ErrorGoodsSkuCodeExists("sku code %s already exists", skuCode)
+	}
+	return err
+}
+
+// goodsHasSkus 商品下是否有 SKU，有 SKU 的商品售价和库存由 SKU 汇总，不能直接修改
+func goodsHasSkus(db *gorm.DB, goodsID int32) (bool, error) {
+	var count int64
+	if result := db.Model(&GoodsSku{}).Where("goods_id = ?", goodsID).Count(&count); result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// normalizeSkuSpecs 去除首尾空白并校验规格属性：至少一项，名称和值不能为空，名称不能重复
+func normalizeSkuSpecs(specs []*pb.GoodsSkuSpec) (SkuSpecs, error) {
+	if len(specs) == 0 {
+		return nil, errx.ErrorGoodsSkuSpecInvalid("sku specs are required")
+	}
+	if len(specs) > maxSkuSpecs {
+		return nil, errx.ErrorGoodsSkuSpecInvalid("too many sku specs, max %d", maxSkuSpecs)
+	}
+
+	result := make(SkuSpecs, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		name := strings.TrimSpace(spec.GetName())
+		value := strings.TrimSpace(spec.GetValue())
+		if name == "" || value == "" {
+			return nil, errx.ErrorGoodsSkuSpecInvalid("sku spec name and value are required")
+		}
+		if len([]rune(name)) > maxSkuSpecLen || len([]rune(value)) > maxSkuSpecLen {
+			return nil, errx.ErrorGoodsSkuSpecInvalid("sku spec is too long, max %d characters", maxSkuSpecLen)
+		}
+		if seen[name] {
+			return nil, errx.ErrorGoodsSkuSpecInvalid("duplicate sku spec %s", name)
+		}
+		seen[name] = true
+		result = append(result, SkuSpec{Name: name, Value: value})
+	}
+	return result, nil
+}
+
+// key 返回与录入顺序无关的规格组合标识，用于判断规格是否重复
+func (specs SkuSpecs) key() string {
+	pairs := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		pairs = append(pairs, spec.Name+":"+spec.Value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// skuOrder 预加载 SKU 时按创建顺序排列
+func skuOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
+// newGoodsSkuInfo 构建 SKU 响应
+func newGoodsSkuInfo(sku *GoodsSku) *pb.GoodsSkuInfo {
+	info := &pb.GoodsSkuInfo{
+		Id:          sku.ID,
+		GoodsId:     sku.GoodsID,
+		SkuCode:     sku.SkuCode,
+		Specs:       make([]*pb.GoodsSkuSpec, 0, len(sku.Specs)),
+		ShopPrice:   sku.ShopPrice,
+		MarketPrice: sku.MarketPrice,
+		Images:      sku.Images,
+		Stocks:      proto.Int32(sku.Stocks),
+		OnSale:      proto.Bool(sku.OnSale),
+	}
+	for _, spec := range sku.Specs {
+		info.Specs = append(info.Specs, &pb.GoodsSkuSpec{Name: spec.Name, Value: spec.Value})
+	}
+	return info
+}
+
+// newGoodsSkuInfos 构建商品下的 SKU 响应列表
+func newGoodsSkuInfos(goods *Goods) []*pb.GoodsSkuInfo {
+	infos := make([]*pb.GoodsSkuInfo, 0, len(goods.Skus))
+	for _, sku := range goods.Skus {
+		info := newGoodsSkuInfo(sku)
+		info.GoodsName = goods.Name
+		infos = append(infos, info)
+	}
+	return infos
+}
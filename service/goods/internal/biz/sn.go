@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+
+	"gorm.io/gorm"
+)
+
+// maxBatchGoodsSn 批量解析商品编号的数量上限
+const maxBatchGoodsSn = 1000
+
+// GetGoodsBySn 按商品编号获取商品详情
+func (s *GoodsUsecase) GetGoodsBySn(ctx context.Context, req *pb.GoodsSnRequest) (resp *pb.GoodsInfoResponse, err error) {
+	goodsSn := strings.TrimSpace(req.GoodsSn)
+	if goodsSn == "" {
+		return nil, errx.ErrorInvalidParams("goods sn is required")
+	}
+
+	var goods Goods
+	if result := s.db.WithContext(ctx).Select("id").Where("goods_sn = ?", goodsSn).Limit(1).Find(&goods); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsNotFound("goods not found")
+	}
+	return s.GetGoodsDetail(ctx, &pb.GoodInfoRequest{Id: goods.ID})
+}
+
+// BatchResolveGoodsSn 批量将商品编号解析为商品ID，不存在的编号在 notFound 中返回
+func (s *GoodsUsecase) BatchResolveGoodsSn(ctx context.Context, req *pb.BatchGoodsSnRequest) (resp *pb.BatchGoodsSnResponse, err error) {
+	if len(req.GoodsSn) > maxBatchGoodsSn {
+		return nil, errx.ErrorInvalidParams("too many goods sn, max %d", maxBatchGoodsSn)
+	}
+
+	// 去除空白和重复的编号，保持请求顺序
+	sns := make([]string, 0, len(req.GoodsSn))
+	seen := make(map[string]bool, len(req.GoodsSn))
+	for _, sn := range req.GoodsSn {
+		sn = strings.TrimSpace(sn)
+		if sn == "" || seen[sn] {
+			continue
+		}
+		seen[sn] = true
+		sns = append(sns, sn)
+	}
+
+	resp = &pb.BatchGoodsSnResponse{
+		Data:     make([]*pb.GoodsSnIdInfo, 0, len(sns)),
+		NotFound: make([]string, 0),
+	}
+	if len(sns) == 0 {
+		return resp, nil
+	}
+
+	var goods []*Goods
+	if result := s.db.WithContext(ctx).Select("id", "goods_sn").Where("goods_sn IN ?", sns).Find(&goods); result.Error != nil {
+		return nil, result.Error
+	}
+	ids := make(map[string]int32, len(goods))
+	for _, g := range goods {
+		ids[g.GoodsSn] = g.ID
+	}
+	for _, sn := range sns {
+		if id, ok := ids[sn]; ok {
+			resp.Data = append(resp.Data, &pb.GoodsSnIdInfo{GoodsSn: sn, Id: id})
+		} else {
+			resp.NotFound = append(resp.NotFound, sn)
+		}
+	}
+	return resp, nil
+}
+
+// checkGoodsSn 校验商品编号在未删除的商品中唯一，excludeID 为正在更新的商品
+func checkGoodsSn(tx *gorm.DB, goodsSn string, excludeID int32) error {
+	var count int64
+	if result := tx.Model(&Goods{}).Where("goods_sn = ? AND id <> ?", goodsSn, excludeID).Count(&count); result.Error != nil {
+		return result.Error
+	}
+	if count > 0 {
+		return errx.ErrorGoodsSnExists("goods sn %s already exists", goodsSn)
+	}
+	return nil
+}
+
+// goodsSnError 将并发写入时触发的唯一索引冲突转换为商品编号已存在
+func goodsSnError(err error, goodsSn string) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errx.ErrorGoodsSnExists("goods sn %s already exists", goodsSn)
+	}
+	return err
+}
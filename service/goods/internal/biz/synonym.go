@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"mshop/pkg/errx"
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/data"
+)
+
+// maxSynonymRuleLen 单条同义词规则最大长度（字符）
+const maxSynonymRuleLen = 1024
+
+// GetGoodsSynonyms 获取商品搜索同义词词典
+func (s *GoodsUsecase) GetGoodsSynonyms(ctx context.Context, req *pb.Empty) (resp *pb.GoodsSynonymsResponse, err error) {
+	synonyms, err := s.synonyms.GetGoodsSynonyms(ctx)
+	if err != nil {
+		s.log.Errorf("failed to get goods synonyms: %v", err)
+		return nil, errx.ErrorSearchUnavailable("get goods synonyms: %v", err)
+	}
+	return &pb.GoodsSynonymsResponse{Synonyms: synonyms}, nil
+}
+
+// UpdateGoodsSynonyms 整体替换商品搜索同义词词典
+// 同义词在查询时展开，更新后重新加载搜索分析器即可生效，无需重建索引
+func (s *GoodsUsecase) UpdateGoodsSynonyms(ctx context.Context, req *pb.GoodsSynonymsRequest) (resp *pb.GoodsSynonymsResponse, err error) {
+	if len(req.Synonyms) > data.MaxGoodsSynonyms {
+		return nil, errx.ErrorGoodsSynonymInvalid("too many synonym rules, max %d", data.MaxGoodsSynonyms)
+	}
+
+	// 校验并去重
+	synonyms := make([]string, 0, len(req.Synonyms))
+	seen := make(map[string]bool, len(req.Synonyms))
+	for i, rule := range req.Synonyms {
+		rule = strings.TrimSpace(rule)
+		if err := validateSynonymRule(rule); err != nil {
+			return nil, errx.ErrorGoodsSynonymInvalid("synonym rule %d invalid: %v", i+1, err)
+		}
+		if seen[rule] {
+			continue
+		}
+		seen[rule] = true
+		synonyms = append(synonyms, rule)
+	}
+
+	if err := s.synonyms.PutGoodsSynonyms(ctx, synonyms); err != nil {
+		s.log.Errorf("failed to update goods synonyms: %v", err)
+		return nil, errx.ErrorSearchUnavailable("update goods synonyms: %v", err)
+	}
+	s.log.Infof("goods synonyms updated, %d rules", len(synonyms))
+
+	return &pb.GoodsSynonymsResponse{Synonyms: synonyms}, nil
+}
+
+// validateSynonymRule 校验 Solr 格式的同义词规则："a, b, c" 或 "a, b => c"
+func validateSynonymRule(rule string) error {
+	switch {
+	case rule == "":
+		return errors.New("empty rule")
+	case len([]rune(rule)) > maxSynonymRuleLen:
+		return errors.New("rule too long")
+	case strings.ContainsAny(rule, "\r\n"):
+		return errors.New("rule must be a single line")
+	}
+
+	lhs, rhs, mapping := strings.Cut(rule, "=>")
+	if mapping {
+		if strings.Contains(rhs, "=>") {
+			return errors.New("more than one \"=>\"")
+		}
+		if !hasTerms(lhs, 1) || !hasTerms(rhs, 1) {
+			return errors.New("both sides of \"=>\" need at least one term")
+		}
+		return nil
+	}
+	if !hasTerms(lhs, 2) {
+		return errors.New("need at least two terms separated by \",\"")
+	}
+	return nil
+}
+
+// hasTerms 判断逗号分隔的词中至少有 n 个非空词
+func hasTerms(s string, n int) bool {
+	count := 0
+	for _, term := range strings.Split(s, ",") {
+		if strings.TrimSpace(term) != "" {
+			count++
+		}
+	}
+	return count >= n
+}
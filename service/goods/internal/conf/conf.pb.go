@@ -26,6 +26,9 @@ type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Search        *Search                `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Goods         *Goods                 `protobuf:"bytes,4,opt,name=goods,proto3" json:"goods,omitempty"`
+	Services      *Services              `protobuf:"bytes,5,opt,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,6 +77,27 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
+func (x *Bootstrap) GetSearch() *Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetGoods() *Goods {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetServices() *Services {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -131,6 +155,7 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Elasticsearch *Data_Elasticsearch    `protobuf:"bytes,3,opt,name=elasticsearch,proto3" json:"elasticsearch,omitempty"`
+	SearchBackend string                 `protobuf:"bytes,4,opt,name=search_backend,json=searchBackend,proto3" json:"search_backend,omitempty"` // 商品搜索后端：elasticsearch(默认)、memory(仅用于本地开发和测试)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +211,148 @@ func (x *Data) GetElasticsearch() *Data_Elasticsearch {
 	return nil
 }
 
+func (x *Data) GetSearchBackend() string {
+	if x != nil {
+		return x.SearchBackend
+	}
+	return ""
+}
+
+// 搜索配置，支持运行时热更新
+type Search struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relevance     *Search_Relevance      `protobuf:"bytes,1,opt,name=relevance,proto3" json:"relevance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Search) Reset() {
+	*x = Search{}
+	mi := &file_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search) ProtoMessage() {}
+
+func (x *Search) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search.ProtoReflect.Descriptor instead.
+func (*Search) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Search) GetRelevance() *Search_Relevance {
+	if x != nil {
+		return x.Relevance
+	}
+	return nil
+}
+
+// 商品配置
+type Goods struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MaxPriceChangePercent float32                `protobuf:"fixed32,1,opt,name=max_price_change_percent,json=maxPriceChangePercent,proto3" json:"max_price_change_percent,omitempty"` // 单次改价允许的最大变动百分比，超过时需确认改价，0 使用默认值 50，小于 0 不限制
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Goods) Reset() {
+	*x = Goods{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goods) ProtoMessage() {}
+
+func (x *Goods) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goods.ProtoReflect.Descriptor instead.
+func (*Goods) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Goods) GetMaxPriceChangePercent() float32 {
+	if x != nil {
+		return x.MaxPriceChangePercent
+	}
+	return 0
+}
+
+// 依赖的服务
+type Services struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Services_OrderService `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Services) Reset() {
+	*x = Services{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Services) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Services) ProtoMessage() {}
+
+func (x *Services) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Services.ProtoReflect.Descriptor instead.
+func (*Services) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Services) GetOrder() *Services_OrderService {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -197,7 +364,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +376,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +424,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +436,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +483,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +495,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +537,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +549,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +604,7 @@ type Data_Elasticsearch struct {
 
 func (x *Data_Elasticsearch) Reset() {
 	*x = Data_Elasticsearch{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +616,7 @@ func (x *Data_Elasticsearch) String() string {
 func (*Data_Elasticsearch) ProtoMessage() {}
 
 func (x *Data_Elasticsearch) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,15 +653,187 @@ func (x *Data_Elasticsearch) GetPassword() string {
 	return ""
 }
 
+// 相关度权重，0 使用默认值，小于 0 关闭该项
+type Search_Relevance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameBoost     float32                `protobuf:"fixed32,1,opt,name=name_boost,json=nameBoost,proto3" json:"name_boost,omitempty"`             // name 字段权重，默认 3
+	BriefBoost    float32                `protobuf:"fixed32,2,opt,name=brief_boost,json=briefBoost,proto3" json:"brief_boost,omitempty"`          // goods_brief 字段权重，默认 1
+	CategoryBoost float32                `protobuf:"fixed32,3,opt,name=category_boost,json=categoryBoost,proto3" json:"category_boost,omitempty"` // category_name 字段权重，默认 1
+	SoldWeight    float32                `protobuf:"fixed32,4,opt,name=sold_weight,json=soldWeight,proto3" json:"sold_weight,omitempty"`          // 销量加权，按 log1p(sold_num)，默认 1
+	FavWeight     float32                `protobuf:"fixed32,5,opt,name=fav_weight,json=favWeight,proto3" json:"fav_weight,omitempty"`             // 收藏加权，按 log1p(fav_num)，默认 0.5
+	HotWeight     float32                `protobuf:"fixed32,6,opt,name=hot_weight,json=hotWeight,proto3" json:"hot_weight,omitempty"`             // 热销商品加权，默认 1
+	RecencyWeight float32                `protobuf:"fixed32,7,opt,name=recency_weight,json=recencyWeight,proto3" json:"recency_weight,omitempty"` // 上架时间衰减加权，默认 1
+	RecencyScale  *durationpb.Duration   `protobuf:"bytes,8,opt,name=recency_scale,json=recencyScale,proto3" json:"recency_scale,omitempty"`      // 上架时间衰减尺度，默认 30 天
+	RecencyDecay  float32                `protobuf:"fixed32,9,opt,name=recency_decay,json=recencyDecay,proto3" json:"recency_decay,omitempty"`    // 距今 recency_scale 时的衰减比例，默认 0.5
+	PinyinBoost   float32                `protobuf:"fixed32,10,opt,name=pinyin_boost,json=pinyinBoost,proto3" json:"pinyin_boost,omitempty"`      // name.pinyin 拼音字段权重，默认 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Search_Relevance) Reset() {
+	*x = Search_Relevance{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Search_Relevance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Search_Relevance) ProtoMessage() {}
+
+func (x *Search_Relevance) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Search_Relevance.ProtoReflect.Descriptor instead.
+func (*Search_Relevance) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Search_Relevance) GetNameBoost() float32 {
+	if x != nil {
+		return x.NameBoost
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetBriefBoost() float32 {
+	if x != nil {
+		return x.BriefBoost
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetCategoryBoost() float32 {
+	if x != nil {
+		return x.CategoryBoost
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetSoldWeight() float32 {
+	if x != nil {
+		return x.SoldWeight
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetFavWeight() float32 {
+	if x != nil {
+		return x.FavWeight
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetHotWeight() float32 {
+	if x != nil {
+		return x.HotWeight
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetRecencyWeight() float32 {
+	if x != nil {
+		return x.RecencyWeight
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetRecencyScale() *durationpb.Duration {
+	if x != nil {
+		return x.RecencyScale
+	}
+	return nil
+}
+
+func (x *Search_Relevance) GetRecencyDecay() float32 {
+	if x != nil {
+		return x.RecencyDecay
+	}
+	return 0
+}
+
+func (x *Search_Relevance) GetPinyinBoost() float32 {
+	if x != nil {
+		return x.PinyinBoost
+	}
+	return 0
+}
+
+type Services_OrderService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // order 服务地址 (例如: "127.0.0.1:8300")
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`   // 超时时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Services_OrderService) Reset() {
+	*x = Services_OrderService{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Services_OrderService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Services_OrderService) ProtoMessage() {}
+
+func (x *Services_OrderService) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Services_OrderService.ProtoReflect.Descriptor instead.
+func (*Services_OrderService) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Services_OrderService) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Services_OrderService) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xe4\x01\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12*\n" +
+	"\x06search\x18\x03 \x01(\v2\x12.kratos.api.SearchR\x06search\x12'\n" +
+	"\x05goods\x18\x04 \x01(\v2\x11.kratos.api.GoodsR\x05goods\x120\n" +
+	"\bservices\x18\x05 \x01(\v2\x14.kratos.api.ServicesR\bservices\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -505,11 +844,12 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8a\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb1\x04\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12D\n" +
-	"\relasticsearch\x18\x03 \x01(\v2\x1e.kratos.api.Data.ElasticsearchR\relasticsearch\x1a:\n" +
+	"\relasticsearch\x18\x03 \x01(\v2\x1e.kratos.api.Data.ElasticsearchR\relasticsearch\x12%\n" +
+	"\x0esearch_backend\x18\x04 \x01(\tR\rsearchBackend\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -521,7 +861,33 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\rElasticsearch\x12\x1c\n" +
 	"\taddresses\x18\x01 \x03(\tR\taddresses\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpasswordB(Z&mshop/service/goods/internal/conf;confb\x06proto3"
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"\xc7\x03\n" +
+	"\x06Search\x12:\n" +
+	"\trelevance\x18\x01 \x01(\v2\x1c.kratos.api.Search.RelevanceR\trelevance\x1a\x80\x03\n" +
+	"\tRelevance\x12\x1d\n" +
+	"\n" +
+	"name_boost\x18\x01 \x01(\x02R\tnameBoost\x12\x1f\n" +
+	"\vbrief_boost\x18\x02 \x01(\x02R\n" +
+	"briefBoost\x12%\n" +
+	"\x0ecategory_boost\x18\x03 \x01(\x02R\rcategoryBoost\x12\x1f\n" +
+	"\vsold_weight\x18\x04 \x01(\x02R\n" +
+	"soldWeight\x12\x1d\n" +
+	"\n" +
+	"fav_weight\x18\x05 \x01(\x02R\tfavWeight\x12\x1d\n" +
+	"\n" +
+	"hot_weight\x18\x06 \x01(\x02R\thotWeight\x12%\n" +
+	"\x0erecency_weight\x18\a \x01(\x02R\rrecencyWeight\x12>\n" +
+	"\rrecency_scale\x18\b \x01(\v2\x19.google.protobuf.DurationR\frecencyScale\x12#\n" +
+	"\rrecency_decay\x18\t \x01(\x02R\frecencyDecay\x12!\n" +
+	"\fpinyin_boost\x18\n" +
+	" \x01(\x02R\vpinyinBoost\"@\n" +
+	"\x05Goods\x127\n" +
+	"\x18max_price_change_percent\x18\x01 \x01(\x02R\x15maxPriceChangePercent\"\xa4\x01\n" +
+	"\bServices\x127\n" +
+	"\x05order\x18\x01 \x01(\v2!.kratos.api.Services.OrderServiceR\x05order\x1a_\n" +
+	"\fOrderService\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeoutB(Z&mshop/service/goods/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -535,35 +901,47 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Server_HTTP)(nil),         // 3: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 6: kratos.api.Data.Redis
-	(*Data_Elasticsearch)(nil),  // 7: kratos.api.Data.Elasticsearch
-	(*durationpb.Duration)(nil), // 8: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: kratos.api.Bootstrap
+	(*Server)(nil),                // 1: kratos.api.Server
+	(*Data)(nil),                  // 2: kratos.api.Data
+	(*Search)(nil),                // 3: kratos.api.Search
+	(*Goods)(nil),                 // 4: kratos.api.Goods
+	(*Services)(nil),              // 5: kratos.api.Services
+	(*Server_HTTP)(nil),           // 6: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),           // 7: kratos.api.Server.GRPC
+	(*Data_Database)(nil),         // 8: kratos.api.Data.Database
+	(*Data_Redis)(nil),            // 9: kratos.api.Data.Redis
+	(*Data_Elasticsearch)(nil),    // 10: kratos.api.Data.Elasticsearch
+	(*Search_Relevance)(nil),      // 11: kratos.api.Search.Relevance
+	(*Services_OrderService)(nil), // 12: kratos.api.Services.OrderService
+	(*durationpb.Duration)(nil),   // 13: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3,  // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	5,  // 4: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	6,  // 5: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	7,  // 6: kratos.api.Data.elasticsearch:type_name -> kratos.api.Data.Elasticsearch
-	8,  // 7: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	8,  // 8: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	8,  // 9: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	8,  // 10: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 2: kratos.api.Bootstrap.search:type_name -> kratos.api.Search
+	4,  // 3: kratos.api.Bootstrap.goods:type_name -> kratos.api.Goods
+	5,  // 4: kratos.api.Bootstrap.services:type_name -> kratos.api.Services
+	6,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	7,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	8,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	9,  // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	10, // 9: kratos.api.Data.elasticsearch:type_name -> kratos.api.Data.Elasticsearch
+	11, // 10: kratos.api.Search.relevance:type_name -> kratos.api.Search.Relevance
+	12, // 11: kratos.api.Services.order:type_name -> kratos.api.Services.OrderService
+	13, // 12: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	13, // 13: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	13, // 14: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	13, // 15: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	13, // 16: kratos.api.Search.Relevance.recency_scale:type_name -> google.protobuf.Duration
+	13, // 17: kratos.api.Services.OrderService.timeout:type_name -> google.protobuf.Duration
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -577,7 +955,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,213 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// goodsCounterKeyPrefix 商品待写入的计数增量，hash 字段为 click、fav、sold
+	goodsCounterKeyPrefix = "goods:counter:"
+	// goodsCounterDirtyKey 有待写入增量的商品 ID 集合
+	goodsCounterDirtyKey = "goods:counter:dirty"
+	// goodsCounterInflightKey 已取出、尚未写入数据库的增量，字段为 {goodsId}:{counter}
+	goodsCounterInflightKey = "goods:counter:inflight"
+	// goodsCounterDedupKeyPrefix 计数请求幂等键
+	goodsCounterDedupKeyPrefix = "goods:counter:dedup:"
+
+	// GoodsCounterDedupTTL 幂等键保留时长，相同幂等键在此期间只计一次
+	GoodsCounterDedupTTL = 7 * 24 * time.Hour
+)
+
+// 计数字段
+const (
+	counterClick = "click"
+	counterFav   = "fav"
+	counterSold  = "sold"
+)
+
+// GoodsCounterDelta 商品计数增量
+type GoodsCounterDelta struct {
+	GoodsID int32
+	Click   int64
+	Fav     int64
+	Sold    int64
+}
+
+// IsZero 增量是否全部为 0
+func (d *GoodsCounterDelta) IsZero() bool {
+	return d.Click == 0 && d.Fav == 0 && d.Sold == 0
+}
+
+// incrCountersScript 按幂等键累加计数增量并标记商品
+// KEYS[1] 幂等键，为空字符串时不检查；ARGV[1] 幂等键过期秒数；其后每 4 个参数为 goodsId、click、fav、sold
+var incrCountersScript = redis.NewScript(`
+if KEYS[1] ~= "" then
+	if not redis.call("SET", KEYS[1], 1, "NX", "EX", ARGV[1]) then
+		return 0
+	end
+end
+for i = 2, #ARGV, 4 do
+	local key = "` + goodsCounterKeyPrefix + `" .. ARGV[i]
+	redis.call("HINCRBY", key, "click", ARGV[i + 1])
+	redis.call("HINCRBY", key, "fav", ARGV[i + 2])
+	redis.call("HINCRBY", key, "sold", ARGV[i + 3])
+	redis.call("SADD", "` + goodsCounterDirtyKey + `", ARGV[i])
+end
+return 1
+`)
+
+// takeCountersScript 取出最多 ARGV[1] 个商品的增量并合并到 inflight 中，返回 inflight 的全部内容
+var takeCountersScript = redis.NewScript(`
+local ids = redis.call("SPOP", "` + goodsCounterDirtyKey + `", ARGV[1])
+for _, id in ipairs(ids) do
+	local key = "` + goodsCounterKeyPrefix + `" .. id
+	local values = redis.call("HGETALL", key)
+	redis.call("DEL", key)
+	for i = 1, #values, 2 do
+		redis.call("HINCRBY", "` + goodsCounterInflightKey + `", id .. ":" .. values[i], values[i + 1])
+	end
+end
+return redis.call("HGETALL", "` + goodsCounterInflightKey + `")
+`)
+
+// CounterRepo 商品点击、收藏、销量计数缓冲
+// 增量先累加在 Redis 中，由定时任务批量写入数据库，避免热门商品频繁更新同一行
+type CounterRepo struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+// NewCounterRepo 创建商品计数缓冲
+func NewCounterRepo(data *Data, logger log.Logger) *CounterRepo {
+	return &CounterRepo{
+		rdb: data.rdb,
+		log: log.NewHelper(log.With(logger, "module", "data/counter")),
+	}
+}
+
+// Incr 累加计数增量，dedupKey 不为空时相同幂等键在 GoodsCounterDedupTTL 内只计一次
+// 返回 false 表示幂等键已存在，本次增量被忽略
+func (r *CounterRepo) Incr(ctx context.Context, dedupKey string, deltas []*GoodsCounterDelta) (bool, error) {
+	key := ""
+	if dedupKey != "" {
+		key = goodsCounterDedupKeyPrefix + dedupKey
+	}
+	args := make([]interface{}, 0, 1+len(deltas)*4)
+	args = append(args, int64(GoodsCounterDedupTTL/time.Second))
+	for _, d := range deltas {
+		args = append(args, d.GoodsID, d.Click, d.Fav, d.Sold)
+	}
+
+	applied, err := incrCountersScript.Run(ctx, r.rdb, []string{key}, args...).Int()
+	if err != nil {
+		return false, err
+	}
+	return applied == 1, nil
+}
+
+// Pending 返回商品尚未写入数据库的增量，包括已取出正在写入的部分
+func (r *CounterRepo) Pending(ctx context.Context, goodsIDs []int32) (map[int32]*GoodsCounterDelta, error) {
+	pipe := r.rdb.Pipeline()
+	buffered := make([]*redis.MapStringStringCmd, len(goodsIDs))
+	for i, id := range goodsIDs {
+		buffered[i] = pipe.HGetAll(ctx, goodsCounterKey(id))
+	}
+	inflight := pipe.HGetAll(ctx, goodsCounterInflightKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	pending := make(map[int32]*GoodsCounterDelta, len(goodsIDs))
+	for i, id := range goodsIDs {
+		d := &GoodsCounterDelta{GoodsID: id}
+		for counter, v := range buffered[i].Val() {
+			addCounter(d, counter, v)
+		}
+		pending[id] = d
+	}
+	for field, v := range inflight.Val() {
+		id, counter, ok := parseInflightField(field)
+		if d, found := pending[id]; ok && found {
+			addCounter(d, counter, v)
+		}
+	}
+	return pending, nil
+}
+
+// Take 取出最多 n 个商品的增量，连同上次未写入成功的增量一起返回
+// 写入数据库成功后需调用 Ack，失败时不调用，下次 Take 会再次返回这些增量
+func (r *CounterRepo) Take(ctx context.Context, n int) ([]*GoodsCounterDelta, error) {
+	values, err := takeCountersScript.Run(ctx, r.rdb, nil, n).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := make(map[int32]*GoodsCounterDelta)
+	ids := make([]int32, 0)
+	for i := 0; i+1 < len(values); i += 2 {
+		id, counter, ok := parseInflightField(values[i])
+		if !ok {
+			r.log.Warnf("invalid inflight counter field %q", values[i])
+			continue
+		}
+		d, found := deltas[id]
+		if !found {
+			d = &GoodsCounterDelta{GoodsID: id}
+			deltas[id] = d
+			ids = append(ids, id)
+		}
+		addCounter(d, counter, values[i+1])
+	}
+
+	result := make([]*GoodsCounterDelta, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, deltas[id])
+	}
+	return result, nil
+}
+
+// Ack 确认 Take 返回的增量已写入数据库
+func (r *CounterRepo) Ack(ctx context.Context) error {
+	return r.rdb.Del(ctx, goodsCounterInflightKey).Err()
+}
+
+// goodsCounterKey 返回商品增量的 key
+func goodsCounterKey(goodsID int32) string {
+	return goodsCounterKeyPrefix + strconv.Itoa(int(goodsID))
+}
+
+// parseInflightField 解析 inflight 字段 {goodsId}:{counter}
+func parseInflightField(field string) (int32, string, bool) {
+	idStr, counter, ok := strings.Cut(field, ":")
+	if !ok {
+		return 0, "", false
+	}
+	id, err := strconv.ParseInt(idStr, 10, 32)
+	if err != nil {
+		return 0, "", false
+	}
+	return int32(id), counter, true
+}
+
+// addCounter 将计数字段的值累加到增量中
+func addCounter(d *GoodsCounterDelta, counter, value string) {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return
+	}
+	switch counter {
+	case counterClick:
+		d.Click += n
+	case counterFav:
+		d.Fav += n
+	case counterSold:
+		d.Sold += n
+	}
+}
@@ -6,25 +6,32 @@ import (
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewElasticsearch, NewGoodsRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewElasticsearch, NewRedisClient, NewGoodsRepo, NewKeywordRepo, NewCounterRepo, NewLocker, NewOrderServiceClient,
+	NewGoodsSearcher,
+	wire.Bind(new(GoodsIndexManager), new(*GoodsRepo)),
+	wire.Bind(new(GoodsSynonymStore), new(*GoodsRepo)),
+)
 
 // Data .
 type Data struct {
-	db *gorm.DB
-	es *elasticsearch.Client
+	db  *gorm.DB
+	es  *elasticsearch.Client
+	rdb *redis.Client
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, es *elasticsearch.Client) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, es *elasticsearch.Client, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	return &Data{
-		db: db,
-		es: es,
+		db:  db,
+		es:  es,
+		rdb: rdb,
 	}, cleanup, nil
 }
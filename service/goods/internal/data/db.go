@@ -44,6 +44,8 @@ func NewDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
 
 	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger: gormLogger,
+		// 唯一索引冲突转换为 gorm.ErrDuplicatedKey
+		TranslateError: true,
 	})
 	return db, err
 }
@@ -2,7 +2,6 @@ package data
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,6 +26,25 @@ var GoodsMapping = map[string]interface{}{
 			"category_id": map[string]interface{}{
 				"type": "integer",
 			},
+			"category_name": map[string]interface{}{
+				"type":     "text",
+				"analyzer": "ik_max_word",
+				"fields": map[string]interface{}{
+					"keyword": map[string]interface{}{
+						"type":         "keyword",
+						"ignore_above": 256,
+					},
+				},
+			},
+			"category_path": map[string]interface{}{
+				"type": "integer",
+			},
+			"is_tab": map[string]interface{}{
+				"type": "boolean",
+			},
+			"brand_id": map[string]interface{}{
+				"type": "integer",
+			},
 			"on_sale": map[string]interface{}{
 				"type": "boolean",
 			},
@@ -40,13 +58,27 @@ var GoodsMapping = map[string]interface{}{
 				"type": "boolean",
 			},
 			"name": map[string]interface{}{
-				"type":     "text",
-				"analyzer": "ik_max_word",
+				"type":            "text",
+				"analyzer":        "ik_max_word",
+				"search_analyzer": "goods_search",
 				"fields": map[string]interface{}{
 					"keyword": map[string]interface{}{
 						"type":         "keyword",
 						"ignore_above": 256,
 					},
+					// 拼音子字段，支持 pingguo、pg 等拼音和首字母搜索
+					"pinyin": map[string]interface{}{
+						"type":            "text",
+						"analyzer":        "goods_pinyin",
+						"search_analyzer": "goods_pinyin_search",
+					},
+				},
+			},
+			// 搜索联想使用的补全字段，按 on_sale 上下文过滤
+			"name_suggest": map[string]interface{}{
+				"type": "completion",
+				"contexts": []map[string]interface{}{
+					{"name": "on_sale", "type": "category"},
 				},
 			},
 			"click_num": map[string]interface{}{
@@ -64,15 +96,68 @@ var GoodsMapping = map[string]interface{}{
 			"shop_price": map[string]interface{}{
 				"type": "float",
 			},
+			"add_time": map[string]interface{}{
+				"type": "date",
+			},
 			"goods_brief": map[string]interface{}{
-				"type":     "text",
-				"analyzer": "ik_max_word",
+				"type":            "text",
+				"analyzer":        "ik_max_word",
+				"search_analyzer": "goods_search",
+			},
+			// 分类属性值，nested 保证按属性过滤时名称和值来自同一个属性
+			"attrs": map[string]interface{}{
+				"type": "nested",
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{
+						"type": "keyword",
+					},
+					"value": map[string]interface{}{
+						"type": "keyword",
+					},
+				},
 			},
 		},
 	},
 	"settings": map[string]interface{}{
 		"number_of_shards":   3,
 		"number_of_replicas": 1,
+		"analysis": map[string]interface{}{
+			"filter": map[string]interface{}{
+				// 同义词在查询时展开，词典更新后重新加载搜索分析器即可生效，无需重建索引
+				"goods_synonym": map[string]interface{}{
+					"type":         "synonym_graph",
+					"synonyms_set": GoodsSynonymSet,
+					"updateable":   true,
+				},
+				"goods_pinyin": map[string]interface{}{
+					"type":                         "pinyin",
+					"keep_full_pinyin":             false,
+					"keep_joined_full_pinyin":      true,
+					"keep_first_letter":            true,
+					"keep_original":                false,
+					"limit_first_letter_length":    16,
+					"remove_duplicated_term":       true,
+					"none_chinese_pinyin_tokenize": false,
+				},
+			},
+			"analyzer": map[string]interface{}{
+				"goods_search": map[string]interface{}{
+					"type":      "custom",
+					"tokenizer": "ik_smart",
+					"filter":    []string{"lowercase", "goods_synonym"},
+				},
+				"goods_pinyin": map[string]interface{}{
+					"type":      "custom",
+					"tokenizer": "ik_max_word",
+					"filter":    []string{"goods_pinyin"},
+				},
+				"goods_pinyin_search": map[string]interface{}{
+					"type":      "custom",
+					"tokenizer": "whitespace",
+					"filter":    []string{"lowercase"},
+				},
+			},
+		},
 	},
 }
 
@@ -126,10 +211,11 @@ func NewElasticsearch(c *conf.Data, logger log.Logger) (*elasticsearch.Client, e
 }
 
 // initGoodsIndex 初始化商品索引
+// 索引以版本号命名（goods_v1），通过 goods 别名对外提供读写，便于后续无停机重建
 func initGoodsIndex(client *elasticsearch.Client, helper *log.Helper) error {
 	ctx := context.Background()
 
-	// 检查索引是否存在
+	// 检查索引或别名是否存在
 	res, err := client.Indices.Exists([]string{GoodsIndexName})
 	if err != nil {
 		return fmt.Errorf("check index exists error: %w", err)
@@ -142,14 +228,20 @@ func initGoodsIndex(client *elasticsearch.Client, helper *log.Helper) error {
 		return nil
 	}
 
-	// 创建索引
-	mappingJSON, err := json.Marshal(GoodsMapping)
+	// 索引引用的同义词集合需先于索引存在
+	if err := ensureGoodsSynonymSet(ctx, client); err != nil {
+		return fmt.Errorf("ensure synonym set error: %w", err)
+	}
+
+	// 创建首个版本索引并挂载别名
+	mappingJSON, err := newGoodsIndexBody(GoodsIndexName)
 	if err != nil {
 		return fmt.Errorf("marshal mapping error: %w", err)
 	}
 
+	index := GoodsIndexVersionName(1)
 	res, err = client.Indices.Create(
-		GoodsIndexName,
+		index,
 		client.Indices.Create.WithBody(strings.NewReader(string(mappingJSON))),
 		client.Indices.Create.WithContext(ctx),
 	)
@@ -162,6 +254,6 @@ func initGoodsIndex(client *elasticsearch.Client, helper *log.Helper) error {
 		return fmt.Errorf("create index failed: %s", res.String())
 	}
 
-	helper.Infof("index [%s] created successfully", GoodsIndexName)
+	helper.Infof("index [%s] created successfully with alias [%s]", index, GoodsIndexName)
 	return nil
 }
@@ -5,6 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
 	pb "mshop/service/goods/api/goods/v1"
 
 	"github.com/elastic/go-elasticsearch/v8"
@@ -14,34 +18,91 @@ import (
 // GoodsRepo 商品数据仓库
 type GoodsRepo struct {
 	esClient *elasticsearch.Client
+	search   *SearchConfig
 	log      *log.Helper
 }
 
 // NewGoodsRepo 创建商品数据仓库
-func NewGoodsRepo(data *Data, logger log.Logger) *GoodsRepo {
+func NewGoodsRepo(data *Data, search *SearchConfig, logger log.Logger) *GoodsRepo {
 	return &GoodsRepo{
 		esClient: data.es,
+		search:   search,
 		log:      log.NewHelper(log.With(logger, "module", "data/goods")),
 	}
 }
 
-// SearchGoodsIDs 在 ES 中搜索商品，返回商品 ID 列表和总数
-func (r *GoodsRepo) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterRequest) (ids []int32, total int64, err error) {
+// defaultPriceInterval 默认价格聚合区间宽度
+const defaultPriceInterval = 50
+
+// priceIntervalOf 返回请求的价格聚合区间宽度
+func priceIntervalOf(req *pb.GoodsFilterRequest) int32 {
+	if req.PriceInterval <= 0 {
+		return defaultPriceInterval
+	}
+	return req.PriceInterval
+}
+
+// GoodsAttrFilter 按属性名称分组的属性过滤条件，Values 之间为或
+type GoodsAttrFilter struct {
+	Name   string
+	Values []string
+}
+
+// GoodsAttrFilters 将请求中的属性过滤条件按名称分组，忽略名称或值为空的条件
+func GoodsAttrFilters(req *pb.GoodsFilterRequest) []*GoodsAttrFilter {
+	filters := make([]*GoodsAttrFilter, 0, len(req.Attrs))
+	byName := make(map[string]*GoodsAttrFilter, len(req.Attrs))
+	for _, attr := range req.Attrs {
+		name := strings.TrimSpace(attr.GetName())
+		value := strings.TrimSpace(attr.GetValue())
+		if name == "" || value == "" {
+			continue
+		}
+		f, ok := byName[name]
+		if !ok {
+			f = &GoodsAttrFilter{Name: name}
+			byName[name] = f
+			filters = append(filters, f)
+		}
+		f.Values = append(f.Values, value)
+	}
+	return filters
+}
+
+// GoodsSearchResult 商品搜索结果
+type GoodsSearchResult struct {
+	IDs           []int32
+	Total         int64
+	Facets        *pb.GoodsFacets
+	NextPageToken string
+	// Highlights 关键词搜索的高亮片段，按商品 ID 索引
+	Highlights map[int32]*pb.GoodsHighlight
+}
+
+// SearchGoodsIDs 在 ES 中搜索商品，返回商品 ID 列表、总数以及同一查询下的聚合结果
+// 聚合结果中的品牌、分类名称需由调用方补全
+func (r *GoodsRepo) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterRequest) (*GoodsSearchResult, error) {
 	if r.esClient == nil {
 		r.log.Warn("elasticsearch client is nil, skip search")
-		return nil, 0, fmt.Errorf("elasticsearch client is not initialized")
+		return nil, fmt.Errorf("elasticsearch client is not initialized")
 	}
 
+	token, err := ParsePageToken(req.PageToken, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	rel := r.search.relevance()
+
 	// 构建查询条件
 	must := make([]map[string]interface{}, 0)
 	filter := make([]map[string]interface{}, 0)
 
-	// 关键词搜索 - 使用 multi_match 搜索多个字段
+	// 关键词搜索 - 使用 multi_match 按权重搜索多个字段
 	if req.KeyWords != "" {
 		must = append(must, map[string]interface{}{
 			"multi_match": map[string]interface{}{
 				"query":  req.KeyWords,
-				"fields": []string{"name", "goods_brief"},
+				"fields": rel.keywordFields(),
 			},
 		})
 	}
@@ -62,6 +123,15 @@ func (r *GoodsRepo) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterReque
 		})
 	}
 
+	// 默认只返回在售商品
+	if !req.IncludeOffSale {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"on_sale": true,
+			},
+		})
+	}
+
 	// 布尔值过滤
 	if req.IsHot {
 		filter = append(filter, map[string]interface{}{
@@ -78,38 +148,127 @@ func (r *GoodsRepo) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterReque
 		})
 	}
 
-	// 分类过滤
+	// 分类过滤，category_path 包含商品分类及其祖先分类，匹配整棵子树
 	if req.TopCategory > 0 {
 		filter = append(filter, map[string]interface{}{
 			"term": map[string]interface{}{
-				"category_id": req.TopCategory,
+				"category_path": req.TopCategory,
+			},
+		})
+	}
+	if req.IsTab {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"is_tab": true,
 			},
 		})
 	}
 
-	// 构建完整查询
-	query := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must":   must,
-				"filter": filter,
+	// 品牌过滤
+	if req.Brand > 0 {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"brand_id": req.Brand,
 			},
+		})
+	}
+
+	// 属性过滤，attrs 为 nested 字段，名称和值需在同一个属性内匹配
+	for _, f := range GoodsAttrFilters(req) {
+		filter = append(filter, map[string]interface{}{
+			"nested": map[string]interface{}{
+				"path": "attrs",
+				"query": map[string]interface{}{
+					"bool": map[string]interface{}{
+						"filter": []map[string]interface{}{
+							{"term": map[string]interface{}{"attrs.name": f.Name}},
+							{"terms": map[string]interface{}{"attrs.value": f.Values}},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	// 价格聚合区间
+	priceInterval := priceIntervalOf(req)
+
+	boolQuery := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must":   must,
+			"filter": filter,
 		},
+	}
+	// 关键词搜索时结合销量、收藏、热销和上架时间调整相关度
+	if req.KeyWords != "" {
+		boolQuery = rel.functionScore(boolQuery)
+	}
+
+	// 构建完整查询
+	query := map[string]interface{}{
+		"query": boolQuery,
+		"sort":  goodsSort(req.Sort),
 		// 只返回 ID 字段
 		"_source": []string{"id"},
+		// 聚合与查询共用同一组过滤条件
+		"aggs": map[string]interface{}{
+			"brands": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "brand_id", "size": 50},
+			},
+			"categories": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "category_id", "size": 50},
+			},
+			"prices": map[string]interface{}{
+				"histogram": map[string]interface{}{
+					"field":         "shop_price",
+					"interval":      priceInterval,
+					"min_doc_count": 1,
+				},
+			},
+			"is_new": map[string]interface{}{
+				"filter": map[string]interface{}{"term": map[string]interface{}{"is_new": true}},
+			},
+			"is_hot": map[string]interface{}{
+				"filter": map[string]interface{}{"term": map[string]interface{}{"is_hot": true}},
+			},
+			"ship_free": map[string]interface{}{
+				"filter": map[string]interface{}{"term": map[string]interface{}{"ship_free": true}},
+			},
+		},
+	}
+
+	// 关键词搜索时返回命中片段，商品名称返回完整内容
+	if req.KeyWords != "" {
+		query["highlight"] = map[string]interface{}{
+			"encoder":   "html",
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields": map[string]interface{}{
+				"name":        map[string]interface{}{"number_of_fragments": 0},
+				"goods_brief": map[string]interface{}{"fragment_size": 100, "number_of_fragments": 3},
+			},
+		}
 	}
 
-	// 添加分页
-	if req.Pages > 0 && req.PagePerNums > 0 {
-		query["from"] = (req.Pages - 1) * req.PagePerNums
-		query["size"] = req.PagePerNums
+	// 添加分页，游标续读时使用 search_after，不受 from + size 不超过 10000 的限制
+	from, size := pageWindow(req, token)
+	query["size"] = size
+	switch {
+	case token != nil && len(token.After) > 0:
+		query["search_after"] = token.After
+	case from > 0:
+		query["from"] = from
+	}
+	// 续读页只返回商品，聚合结果由首页返回
+	if token != nil {
+		delete(query, "aggs")
 	}
 
 	// 序列化查询
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
 		r.log.Errorf("failed to encode query: %v", err)
-		return nil, 0, err
+		return nil, err
 	}
 
 	// 执行搜索
@@ -121,63 +280,197 @@ func (r *GoodsRepo) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterReque
 	)
 	if err != nil {
 		r.log.Errorf("failed to search goods: %v", err)
-		return nil, 0, err
+		return nil, err
 	}
 	defer res.Body.Close()
 
 	if res.IsError() {
 		r.log.Errorf("elasticsearch search error: %s", res.String())
-		return nil, 0, fmt.Errorf("elasticsearch search error: %s", res.String())
+		return nil, searchStatusError(res.StatusCode, res.String())
 	}
 
 	// 解析响应
-	var result map[string]interface{}
+	var result searchResponse
 	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
 		r.log.Errorf("failed to decode response: %v", err)
-		return nil, 0, err
+		return nil, err
+	}
+
+	// 提取商品 ID 和高亮片段
+	ids := make([]int32, 0, len(result.Hits.Hits))
+	var highlights map[int32]*pb.GoodsHighlight
+	for _, hit := range result.Hits.Hits {
+		ids = append(ids, hit.Source.ID)
+		if len(hit.Highlight) > 0 {
+			if highlights == nil {
+				highlights = make(map[int32]*pb.GoodsHighlight, len(result.Hits.Hits))
+			}
+			highlights[hit.Source.ID] = &pb.GoodsHighlight{
+				Name:       hit.Highlight["name"],
+				GoodsBrief: hit.Highlight["goods_brief"],
+			}
+		}
+	}
+
+	r.log.Infof("search goods found %d results, total: %d", len(ids), result.Hits.Total.Value)
+	searchResult := &GoodsSearchResult{
+		IDs:        ids,
+		Total:      result.Hits.Total.Value,
+		Highlights: highlights,
+	}
+	if token == nil {
+		searchResult.Facets = result.Aggregations.facets(float32(priceInterval))
 	}
+	// 本页已满时用最后一条的 sort 值作为下一页游标
+	if hits := result.Hits.Hits; len(hits) == size {
+		next := &PageToken{Sort: req.Sort, After: hits[len(hits)-1].Sort}
+		searchResult.NextPageToken = next.Encode()
+	}
+	return searchResult, nil
+}
+
+// goodsSort 构建排序条件，主排序相同时依次按相关度、商品 ID 排序，保证分页结果稳定
+func goodsSort(sort pb.GoodsSort) []map[string]interface{} {
+	desc := map[string]interface{}{"order": "desc"}
+	asc := map[string]interface{}{"order": "asc"}
+
+	var fields []map[string]interface{}
+	switch sort {
+	case pb.GoodsSort_GOODS_SORT_PRICE_ASC:
+		fields = append(fields, map[string]interface{}{"shop_price": asc}, map[string]interface{}{"sold_num": desc})
+	case pb.GoodsSort_GOODS_SORT_PRICE_DESC:
+		fields = append(fields, map[string]interface{}{"shop_price": desc}, map[string]interface{}{"sold_num": desc})
+	case pb.GoodsSort_GOODS_SORT_SALES:
+		fields = append(fields, map[string]interface{}{"sold_num": desc}, map[string]interface{}{"fav_num": desc})
+	case pb.GoodsSort_GOODS_SORT_NEWEST:
+		fields = append(fields, map[string]interface{}{"add_time": desc})
+	case pb.GoodsSort_GOODS_SORT_POPULAR:
+		fields = append(fields, map[string]interface{}{"click_num": desc}, map[string]interface{}{"fav_num": desc})
+	}
+
+	return append(fields,
+		map[string]interface{}{"_score": desc},
+		map[string]interface{}{"id": desc},
+	)
+}
+
+// searchResponse ES 商品搜索响应
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source struct {
+				ID int32 `json:"id"`
+			} `json:"_source"`
+			Sort      json.RawMessage     `json:"sort"`
+			Highlight map[string][]string `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations searchAggregations `json:"aggregations"`
+}
+
+// aggBucket ES 聚合桶
+type aggBucket struct {
+	Key      float64 `json:"key"`
+	DocCount int64   `json:"doc_count"`
+}
+
+// searchAggregations ES 商品搜索聚合结果
+type searchAggregations struct {
+	Brands struct {
+		Buckets []aggBucket `json:"buckets"`
+	} `json:"brands"`
+	Categories struct {
+		Buckets []aggBucket `json:"buckets"`
+	} `json:"categories"`
+	Prices struct {
+		Buckets []aggBucket `json:"buckets"`
+	} `json:"prices"`
+	IsNew struct {
+		DocCount int64 `json:"doc_count"`
+	} `json:"is_new"`
+	IsHot struct {
+		DocCount int64 `json:"doc_count"`
+	} `json:"is_hot"`
+	ShipFree struct {
+		DocCount int64 `json:"doc_count"`
+	} `json:"ship_free"`
+}
 
-	// 提取总数
-	hits, ok := result["hits"].(map[string]interface{})
-	if !ok {
-		return nil, 0, fmt.Errorf("invalid response format: hits not found")
+// facets 转换为接口聚合结果
+func (a *searchAggregations) facets(priceInterval float32) *pb.GoodsFacets {
+	facets := &pb.GoodsFacets{
+		Brands:     make([]*pb.FacetBucket, 0, len(a.Brands.Buckets)),
+		Categories: make([]*pb.FacetBucket, 0, len(a.Categories.Buckets)),
+		Prices:     make([]*pb.PriceFacetBucket, 0, len(a.Prices.Buckets)),
+		IsNew:      a.IsNew.DocCount,
+		IsHot:      a.IsHot.DocCount,
+		ShipFree:   a.ShipFree.DocCount,
+	}
+	for _, b := range a.Brands.Buckets {
+		facets.Brands = append(facets.Brands, &pb.FacetBucket{Id: int32(b.Key), Count: b.DocCount})
 	}
+	for _, b := range a.Categories.Buckets {
+		facets.Categories = append(facets.Categories, &pb.FacetBucket{Id: int32(b.Key), Count: b.DocCount})
+	}
+	for _, b := range a.Prices.Buckets {
+		facets.Prices = append(facets.Prices, &pb.PriceFacetBucket{
+			From:  float32(b.Key),
+			To:    float32(b.Key) + priceInterval,
+			Count: b.DocCount,
+		})
+	}
+	return facets
+}
 
-	totalObj, ok := hits["total"].(map[string]interface{})
-	if !ok {
-		return nil, 0, fmt.Errorf("invalid response format: total not found")
+// IndexGoods 写入（覆盖）单个商品文档，doc 为 ES 中的商品数据
+func (r *GoodsRepo) IndexGoods(ctx context.Context, id int32, doc interface{}) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
 	}
 
-	totalValue, ok := totalObj["value"].(float64)
-	if !ok {
-		return nil, 0, fmt.Errorf("invalid response format: total value not found")
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return err
 	}
-	total = int64(totalValue)
 
-	// 提取商品 ID
-	hitsArray, ok := hits["hits"].([]interface{})
-	if !ok {
-		return nil, 0, fmt.Errorf("invalid response format: hits array not found")
+	res, err := r.esClient.Index(
+		GoodsIndexName,
+		bytes.NewReader(body),
+		r.esClient.Index.WithContext(ctx),
+		r.esClient.Index.WithDocumentID(strconv.Itoa(int(id))),
+	)
+	if err != nil {
+		return err
 	}
+	defer res.Body.Close()
 
-	ids = make([]int32, 0, len(hitsArray))
-	for _, hit := range hitsArray {
-		hitMap, ok := hit.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	if res.IsError() {
+		return fmt.Errorf("elasticsearch index error: %s", res.String())
+	}
+	return nil
+}
 
-		source, ok := hitMap["_source"].(map[string]interface{})
-		if !ok {
-			continue
-		}
+// DeleteGoodsDoc 删除单个商品文档，文档不存在视为成功
+func (r *GoodsRepo) DeleteGoodsDoc(ctx context.Context, id int32) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
 
-		id, ok := source["id"].(float64)
-		if ok {
-			ids = append(ids, int32(id))
-		}
+	res, err := r.esClient.Delete(
+		GoodsIndexName,
+		strconv.Itoa(int(id)),
+		r.esClient.Delete.WithContext(ctx),
+	)
+	if err != nil {
+		return err
 	}
+	defer res.Body.Close()
 
-	r.log.Infof("search goods found %d results, total: %d", len(ids), total)
-	return ids, total, nil
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("elasticsearch delete error: %s", res.String())
+	}
+	return nil
 }
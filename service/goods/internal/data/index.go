@@ -0,0 +1,303 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GoodsIndexInfo 商品版本索引信息
+type GoodsIndexInfo struct {
+	Name      string
+	Version   int
+	CreatedAt time.Time
+}
+
+// GoodsIndexVersionName 返回指定版本的商品索引名称，例如 goods_v2
+func GoodsIndexVersionName(version int) string {
+	return fmt.Sprintf("%s_v%d", GoodsIndexName, version)
+}
+
+// parseGoodsIndexVersion 解析版本索引名称中的版本号
+func parseGoodsIndexVersion(name string) (int, bool) {
+	v, ok := strings.CutPrefix(name, GoodsIndexName+"_v")
+	if !ok {
+		return 0, false
+	}
+	version, err := strconv.Atoi(v)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// newGoodsIndexBody 构建创建索引的请求体，alias 不为空时同时挂载别名
+func newGoodsIndexBody(alias string) ([]byte, error) {
+	body := make(map[string]interface{}, len(GoodsMapping)+1)
+	for k, v := range GoodsMapping {
+		body[k] = v
+	}
+	if alias != "" {
+		body["aliases"] = map[string]interface{}{
+			alias: map[string]interface{}{"is_write_index": true},
+		}
+	}
+	return json.Marshal(body)
+}
+
+// ResolveGoodsAlias 返回 goods 别名当前指向的索引
+// legacy 为 true 表示 goods 是未使用别名的旧索引；两者都不存在时返回空字符串
+func (r *GoodsRepo) ResolveGoodsAlias(ctx context.Context) (index string, legacy bool, err error) {
+	if r.esClient == nil {
+		return "", false, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	res, err := r.esClient.Indices.GetAlias(
+		r.esClient.Indices.GetAlias.WithContext(ctx),
+		r.esClient.Indices.GetAlias.WithName(GoodsIndexName),
+	)
+	if err != nil {
+		return "", false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		exists, err := r.esClient.Indices.Exists(
+			[]string{GoodsIndexName},
+			r.esClient.Indices.Exists.WithContext(ctx),
+		)
+		if err != nil {
+			return "", false, err
+		}
+		defer exists.Body.Close()
+		if exists.StatusCode == http.StatusOK {
+			return GoodsIndexName, true, nil
+		}
+		return "", false, nil
+	}
+	if res.IsError() {
+		return "", false, fmt.Errorf("elasticsearch get alias error: %s", res.String())
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return "", false, err
+	}
+	for name := range result {
+		return name, false, nil
+	}
+	return "", false, nil
+}
+
+// ListGoodsIndices 列出所有商品版本索引，按版本号升序
+func (r *GoodsRepo) ListGoodsIndices(ctx context.Context) ([]*GoodsIndexInfo, error) {
+	if r.esClient == nil {
+		return nil, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	res, err := r.esClient.Indices.GetSettings(
+		r.esClient.Indices.GetSettings.WithContext(ctx),
+		r.esClient.Indices.GetSettings.WithIndex(GoodsIndexName+"_v*"),
+		r.esClient.Indices.GetSettings.WithName("index.creation_date"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("elasticsearch get settings error: %s", res.String())
+	}
+
+	var result map[string]struct {
+		Settings struct {
+			Index struct {
+				CreationDate string `json:"creation_date"`
+			} `json:"index"`
+		} `json:"settings"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	indices := make([]*GoodsIndexInfo, 0, len(result))
+	for name, info := range result {
+		version, ok := parseGoodsIndexVersion(name)
+		if !ok {
+			continue
+		}
+		millis, _ := strconv.ParseInt(info.Settings.Index.CreationDate, 10, 64)
+		indices = append(indices, &GoodsIndexInfo{
+			Name:      name,
+			Version:   version,
+			CreatedAt: time.UnixMilli(millis),
+		})
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i].Version < indices[j].Version
+	})
+	return indices, nil
+}
+
+// CreateGoodsIndex 按当前 GoodsMapping 创建指定名称的索引，不挂载别名
+func (r *GoodsRepo) CreateGoodsIndex(ctx context.Context, index string) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	if err := ensureGoodsSynonymSet(ctx, r.esClient); err != nil {
+		return err
+	}
+
+	body, err := newGoodsIndexBody("")
+	if err != nil {
+		return err
+	}
+
+	res, err := r.esClient.Indices.Create(
+		index,
+		r.esClient.Indices.Create.WithContext(ctx),
+		r.esClient.Indices.Create.WithBody(bytes.NewReader(body)),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("create index failed: %s", res.String())
+	}
+	return nil
+}
+
+// BulkIndexGoods 批量写入商品文档到指定索引，docs 为商品 ID 到 ES 文档的映射
+func (r *GoodsRepo) BulkIndexGoods(ctx context.Context, index string, docs map[int32]interface{}) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for id, doc := range docs {
+		meta := map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": index,
+				"_id":    strconv.Itoa(int(id)),
+			},
+		}
+		if err := enc.Encode(meta); err != nil {
+			return err
+		}
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+	}
+
+	res, err := r.esClient.Bulk(
+		&buf,
+		r.esClient.Bulk.WithContext(ctx),
+		r.esClient.Bulk.WithIndex(index),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("elasticsearch bulk error: %s", res.String())
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string          `json:"_id"`
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return err
+	}
+	if !result.Errors {
+		return nil
+	}
+	for _, item := range result.Items {
+		for _, op := range item {
+			if len(op.Error) > 0 {
+				return fmt.Errorf("elasticsearch bulk item %s error: %s", op.ID, op.Error)
+			}
+		}
+	}
+	return fmt.Errorf("elasticsearch bulk error")
+}
+
+// RefreshGoodsIndex 刷新索引使写入可见
+func (r *GoodsRepo) RefreshGoodsIndex(ctx context.Context, index string) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	res, err := r.esClient.Indices.Refresh(
+		r.esClient.Indices.Refresh.WithContext(ctx),
+		r.esClient.Indices.Refresh.WithIndex(index),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("elasticsearch refresh error: %s", res.String())
+	}
+	return nil
+}
+
+// SwitchGoodsAlias 原子地将 goods 别名从 from 切换到 to
+// from 为未使用别名的旧 goods 索引时，旧索引会在同一操作中被删除
+func (r *GoodsRepo) SwitchGoodsAlias(ctx context.Context, from string, legacy bool, to string) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	actions := make([]map[string]interface{}, 0, 2)
+	switch {
+	case from != "" && legacy:
+		actions = append(actions, map[string]interface{}{
+			"remove_index": map[string]interface{}{"index": from},
+		})
+	case from != "":
+		actions = append(actions, map[string]interface{}{
+			"remove": map[string]interface{}{"index": from, "alias": GoodsIndexName},
+		})
+	}
+	actions = append(actions, map[string]interface{}{
+		"add": map[string]interface{}{"index": to, "alias": GoodsIndexName, "is_write_index": true},
+	})
+
+	body, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return err
+	}
+
+	res, err := r.esClient.Indices.UpdateAliases(
+		bytes.NewReader(body),
+		r.esClient.Indices.UpdateAliases.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("elasticsearch update aliases error: %s", res.String())
+	}
+	return nil
+}
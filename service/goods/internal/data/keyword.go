@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// hotKeywordKeyPrefix 按小时分桶的热搜词有序集合，例如 goods:search:hot:2025010215
+	hotKeywordKeyPrefix = "goods:search:hot:"
+	// hotKeywordCacheKeyPrefix 按窗口合并后的热搜词缓存
+	hotKeywordCacheKeyPrefix = "goods:search:hot:cache:"
+	// hotKeywordBlocklistKey 热搜屏蔽词集合
+	hotKeywordBlocklistKey = "goods:search:hot:blocklist"
+
+	// MaxHotKeywordHours 热搜统计窗口上限，小时分桶保留时长与之一致
+	MaxHotKeywordHours = 7 * 24
+	// hotKeywordCacheTTL 合并结果缓存时长
+	hotKeywordCacheTTL = time.Minute
+)
+
+// KeywordScore 关键词及其次数
+type KeywordScore struct {
+	Keyword string
+	Count   int64
+}
+
+// KeywordRepo 搜索关键词统计仓库
+type KeywordRepo struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+// NewKeywordRepo 创建搜索关键词统计仓库
+func NewKeywordRepo(data *Data, logger log.Logger) *KeywordRepo {
+	return &KeywordRepo{
+		rdb: data.rdb,
+		log: log.NewHelper(log.With(logger, "module", "data/keyword")),
+	}
+}
+
+// hotKeywordKey 返回 t 所在小时的分桶 key
+func hotKeywordKey(t time.Time) string {
+	return hotKeywordKeyPrefix + t.Format("2006010215")
+}
+
+// IncrKeyword 在当前小时的分桶中为关键词计数
+func (r *KeywordRepo) IncrKeyword(ctx context.Context, keyword string) error {
+	key := hotKeywordKey(time.Now())
+
+	pipe := r.rdb.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, keyword)
+	pipe.Expire(ctx, key, (MaxHotKeywordHours+1)*time.Hour)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TopKeywords 返回最近 hours 小时内搜索次数最多的 size 个关键词，合并结果缓存一分钟
+func (r *KeywordRepo) TopKeywords(ctx context.Context, hours, size int) ([]*KeywordScore, error) {
+	cacheKey := fmt.Sprintf("%s%d", hotKeywordCacheKeyPrefix, hours)
+
+	exists, err := r.rdb.Exists(ctx, cacheKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		now := time.Now()
+		keys := make([]string, 0, hours)
+		for i := 0; i < hours; i++ {
+			keys = append(keys, hotKeywordKey(now.Add(-time.Duration(i)*time.Hour)))
+		}
+
+		pipe := r.rdb.TxPipeline()
+		pipe.ZUnionStore(ctx, cacheKey, &redis.ZStore{Keys: keys})
+		pipe.Expire(ctx, cacheKey, hotKeywordCacheTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := r.rdb.ZRevRangeWithScores(ctx, cacheKey, 0, int64(size)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := make([]*KeywordScore, 0, len(members))
+	for _, m := range members {
+		keyword, _ := m.Member.(string)
+		keywords = append(keywords, &KeywordScore{
+			Keyword: keyword,
+			Count:   int64(m.Score),
+		})
+	}
+	return keywords, nil
+}
+
+// GetBlocklist 返回热搜屏蔽词
+func (r *KeywordRepo) GetBlocklist(ctx context.Context) ([]string, error) {
+	return r.rdb.SMembers(ctx, hotKeywordBlocklistKey).Result()
+}
+
+// SetBlocklist 整体替换热搜屏蔽词
+func (r *KeywordRepo) SetBlocklist(ctx context.Context, words []string) error {
+	pipe := r.rdb.TxPipeline()
+	pipe.Del(ctx, hotKeywordBlocklistKey)
+	if len(words) > 0 {
+		members := make([]interface{}, 0, len(words))
+		for _, w := range words {
+			members = append(members, w)
+		}
+		pipe.SAdd(ctx, hotKeywordBlocklistKey, members...)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
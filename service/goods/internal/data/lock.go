@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// unlockScript 只释放自己持有的锁
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Locker 基于 Redis 的任务锁，多实例运行的后台任务通过它保证同时只有一个实例执行
+type Locker struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+// NewLocker 创建任务锁
+func NewLocker(data *Data, logger log.Logger) *Locker {
+	return &Locker{
+		rdb: data.rdb,
+		log: log.NewHelper(log.With(logger, "module", "data/lock")),
+	}
+}
+
+// TryLock 获取锁 key，返回释放锁的函数，锁已被其他实例持有时返回 nil
+// ttl 需大于任务执行耗时，持有锁的实例异常退出时锁在 ttl 后自动释放
+func (l *Locker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), error) {
+	token := uuid.NewString()
+	ok, err := l.rdb.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, err
+	}
+	return func() {
+		if err := unlockScript.Run(context.Background(), l.rdb, []string{key}, token).Err(); err != nil {
+			l.log.Errorf("failed to release lock %s: %v", key, err)
+		}
+	}, nil
+}
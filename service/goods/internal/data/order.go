@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"mshop/service/goods/internal/conf"
+	orderV1 "mshop/service/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewOrderServiceClient 创建 Order 服务客户端，用于获取订单统计数据
+func NewOrderServiceClient(conf *conf.Services, logger log.Logger) (orderV1.OrderClient, error) {
+	l := log.NewHelper(logger)
+
+	// 设置超时时间
+	timeout := 5 * time.Second
+	if conf.Order != nil && conf.Order.Timeout != nil {
+		timeout = conf.Order.Timeout.AsDuration()
+	}
+
+	// 创建 gRPC 连接
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(conf.GetOrder().GetEndpoint()),
+		grpc.WithTimeout(timeout),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+		),
+	)
+	if err != nil {
+		l.Errorf("Failed to connect to order service: %v", err)
+		return nil, err
+	}
+
+	l.Infof("Connected to order service at: %s", conf.GetOrder().GetEndpoint())
+
+	// 创建 Order 客户端
+	return orderV1.NewOrderClient(conn), nil
+}
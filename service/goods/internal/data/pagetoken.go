@@ -0,0 +1,62 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	pb "mshop/service/goods/api/goods/v1"
+)
+
+// ErrInvalidPageToken 分页游标无法解析或与请求的排序方式不一致
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// PageToken 游标分页令牌，对客户端不透明
+// ES 使用上一页最后一条的 sort 值（search_after），数据库实现使用偏移量
+type PageToken struct {
+	Sort   pb.GoodsSort    `json:"s"`
+	After  json.RawMessage `json:"a,omitempty"`
+	Offset int             `json:"o,omitempty"`
+}
+
+// ParsePageToken 解析分页令牌，token 为空时返回 nil
+func ParsePageToken(token string, sort pb.GoodsSort) (*PageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var t PageToken
+	if err := json.Unmarshal(body, &t); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if t.Sort != sort || t.Offset < 0 || (len(t.After) == 0 && t.Offset == 0) {
+		return nil, ErrInvalidPageToken
+	}
+	return &t, nil
+}
+
+// Encode 编码为分页令牌字符串
+func (t *PageToken) Encode() string {
+	body, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+// pageWindow 返回本次查询的偏移量和数量
+// 有游标时按游标续读，否则沿用 pages/pagePerNums，未指定时与 ES 默认 size 一致返回 10 条
+func pageWindow(req *pb.GoodsFilterRequest, token *PageToken) (from, size int) {
+	size = 10
+	if req.PagePerNums > 0 {
+		size = int(req.PagePerNums)
+	}
+	switch {
+	case token != nil:
+		from = token.Offset
+	case req.Pages > 0 && req.PagePerNums > 0:
+		from = int((req.Pages - 1) * req.PagePerNums)
+	}
+	return from, size
+}
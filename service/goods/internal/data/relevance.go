@@ -0,0 +1,167 @@
+package data
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"mshop/service/goods/internal/conf"
+)
+
+// 相关度默认权重
+const (
+	defaultNameBoost     = 3
+	defaultBriefBoost    = 1
+	defaultCategoryBoost = 1
+	defaultPinyinBoost   = 1
+	defaultSoldWeight    = 1
+	defaultFavWeight     = 0.5
+	defaultHotWeight     = 1
+	defaultRecencyWeight = 1
+	defaultRecencyScale  = 30 * 24 * time.Hour
+	defaultRecencyDecay  = 0.5
+)
+
+// SearchConfig 搜索配置，配置中心变更时通过 Store 热更新
+type SearchConfig struct {
+	v atomic.Pointer[conf.Search]
+}
+
+// NewSearchConfig 创建搜索配置
+func NewSearchConfig(c *conf.Search) *SearchConfig {
+	sc := &SearchConfig{}
+	sc.Store(c)
+	return sc
+}
+
+// Store 替换当前搜索配置
+func (sc *SearchConfig) Store(c *conf.Search) {
+	if c == nil {
+		c = &conf.Search{}
+	}
+	sc.v.Store(c)
+}
+
+// relevance 补全默认值后的相关度权重，权重为 0 表示关闭
+type relevance struct {
+	nameBoost     float32
+	briefBoost    float32
+	categoryBoost float32
+	pinyinBoost   float32
+	soldWeight    float32
+	favWeight     float32
+	hotWeight     float32
+	recencyWeight float32
+	recencyScale  time.Duration
+	recencyDecay  float32
+}
+
+// relevance 返回当前相关度权重
+func (sc *SearchConfig) relevance() relevance {
+	c := sc.v.Load().GetRelevance()
+
+	r := relevance{
+		nameBoost:     weightOrDefault(c.GetNameBoost(), defaultNameBoost),
+		briefBoost:    weightOrDefault(c.GetBriefBoost(), defaultBriefBoost),
+		categoryBoost: weightOrDefault(c.GetCategoryBoost(), defaultCategoryBoost),
+		pinyinBoost:   weightOrDefault(c.GetPinyinBoost(), defaultPinyinBoost),
+		soldWeight:    weightOrDefault(c.GetSoldWeight(), defaultSoldWeight),
+		favWeight:     weightOrDefault(c.GetFavWeight(), defaultFavWeight),
+		hotWeight:     weightOrDefault(c.GetHotWeight(), defaultHotWeight),
+		recencyWeight: weightOrDefault(c.GetRecencyWeight(), defaultRecencyWeight),
+		recencyScale:  defaultRecencyScale,
+		recencyDecay:  defaultRecencyDecay,
+	}
+	if scale := c.GetRecencyScale().AsDuration(); scale >= time.Second {
+		r.recencyScale = scale
+	}
+	if decay := c.GetRecencyDecay(); decay > 0 && decay < 1 {
+		r.recencyDecay = decay
+	}
+	return r
+}
+
+// weightOrDefault 0 使用默认值，小于 0 关闭
+func weightOrDefault(w, def float32) float32 {
+	switch {
+	case w == 0:
+		return def
+	case w < 0:
+		return 0
+	}
+	return w
+}
+
+// keywordFields 返回带权重的关键词搜索字段
+func (r relevance) keywordFields() []string {
+	fields := make([]string, 0, 4)
+	for _, f := range []struct {
+		name  string
+		boost float32
+	}{
+		{"name", r.nameBoost},
+		{"goods_brief", r.briefBoost},
+		{"category_name", r.categoryBoost},
+		{"name.pinyin", r.pinyinBoost},
+	} {
+		if f.boost > 0 {
+			fields = append(fields, fmt.Sprintf("%s^%g", f.name, f.boost))
+		}
+	}
+	return fields
+}
+
+// functionScore 使用销量、收藏、热销和上架时间对文本相关度加权
+// 各加权项求和后与文本得分相乘，基础权重 1 保证没有业务信号的商品仍按文本相关度排序
+func (r relevance) functionScore(query map[string]interface{}) map[string]interface{} {
+	functions := []map[string]interface{}{
+		{"weight": 1},
+	}
+	if r.soldWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"field_value_factor": map[string]interface{}{
+				"field":    "sold_num",
+				"modifier": "log1p",
+				"missing":  0,
+			},
+			"weight": r.soldWeight,
+		})
+	}
+	if r.favWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"field_value_factor": map[string]interface{}{
+				"field":    "fav_num",
+				"modifier": "log1p",
+				"missing":  0,
+			},
+			"weight": r.favWeight,
+		})
+	}
+	if r.hotWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"filter": map[string]interface{}{"term": map[string]interface{}{"is_hot": true}},
+			"weight": r.hotWeight,
+		})
+	}
+	if r.recencyWeight > 0 {
+		functions = append(functions, map[string]interface{}{
+			"gauss": map[string]interface{}{
+				"add_time": map[string]interface{}{
+					"origin": "now",
+					"scale":  fmt.Sprintf("%ds", int64(r.recencyScale/time.Second)),
+					"decay":  r.recencyDecay,
+				},
+			},
+			"weight": r.recencyWeight,
+		})
+	}
+
+	return map[string]interface{}{
+		"function_score": map[string]interface{}{
+			"query":      query,
+			"functions":  functions,
+			"score_mode": "sum",
+			"boost_mode": "multiply",
+		},
+	}
+}
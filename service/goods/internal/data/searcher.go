@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	pb "mshop/service/goods/api/goods/v1"
+	"mshop/service/goods/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 商品搜索后端
+const (
+	SearchBackendElasticsearch = "elasticsearch"
+	SearchBackendMemory        = "memory"
+)
+
+// ErrSearchRequestInvalid 搜索后端因请求参数拒绝了请求（如超出 max_result_window），后端本身正常，不计入熔断
+var ErrSearchRequestInvalid = errors.New("search request rejected by backend")
+
+// searchStatusError 按搜索后端的响应状态码包装错误，429 以外的 4xx 视为请求参数错误
+func searchStatusError(status int, body string) error {
+	if status >= 400 && status < 500 && status != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %s", ErrSearchRequestInvalid, body)
+	}
+	return fmt.Errorf("elasticsearch search error: %s", body)
+}
+
+// GoodsSearcher 商品搜索后端，GoodsRepo 为基于 ES 的实现，MemoryGoodsSearcher 为内存实现
+type GoodsSearcher interface {
+	// SearchGoodsIDs 按过滤条件搜索商品，返回商品 ID 列表、总数及聚合结果
+	SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterRequest) (*GoodsSearchResult, error)
+	// IndexGoods 写入（覆盖）单个商品文档
+	IndexGoods(ctx context.Context, id int32, doc interface{}) error
+	// DeleteGoodsDoc 删除单个商品文档，文档不存在视为成功
+	DeleteGoodsDoc(ctx context.Context, id int32) error
+	// SuggestGoods 返回在售商品的名称联想
+	SuggestGoods(ctx context.Context, prefix string, size int) ([]*pb.GoodsSuggestion, error)
+}
+
+// GoodsIndexManager 商品版本索引管理，用于全量重建和回滚
+type GoodsIndexManager interface {
+	// ResolveGoodsAlias 返回 goods 别名当前指向的索引，legacy 表示 goods 是未使用别名的旧索引
+	ResolveGoodsAlias(ctx context.Context) (index string, legacy bool, err error)
+	// ListGoodsIndices 列出所有商品版本索引，按版本号升序
+	ListGoodsIndices(ctx context.Context) ([]*GoodsIndexInfo, error)
+	// CreateGoodsIndex 创建指定名称的索引，不挂载别名
+	CreateGoodsIndex(ctx context.Context, index string) error
+	// BulkIndexGoods 批量写入商品文档到指定索引
+	BulkIndexGoods(ctx context.Context, index string, docs map[int32]interface{}) error
+	// RefreshGoodsIndex 刷新索引使写入可见
+	RefreshGoodsIndex(ctx context.Context, index string) error
+	// SwitchGoodsAlias 原子地将 goods 别名从 from 切换到 to
+	SwitchGoodsAlias(ctx context.Context, from string, legacy bool, to string) error
+}
+
+// GoodsSynonymStore 商品搜索同义词存储
+type GoodsSynonymStore interface {
+	// GetGoodsSynonyms 返回全部同义词规则
+	GetGoodsSynonyms(ctx context.Context) ([]string, error)
+	// PutGoodsSynonyms 整体替换同义词规则并使其生效
+	PutGoodsSynonyms(ctx context.Context, synonyms []string) error
+}
+
+// NewGoodsSearcher 按配置选择商品搜索后端，未配置时使用 ES
+func NewGoodsSearcher(c *conf.Data, repo *GoodsRepo, logger log.Logger) (GoodsSearcher, error) {
+	switch c.SearchBackend {
+	case "", SearchBackendElasticsearch:
+		return repo, nil
+	case SearchBackendMemory:
+		log.NewHelper(log.With(logger, "module", "data/searcher")).Warn("using in-memory goods searcher, documents are only written by incremental indexing")
+		return NewMemoryGoodsSearcher(), nil
+	}
+	return nil, fmt.Errorf("unsupported search backend %q", c.SearchBackend)
+}
+
+var (
+	_ GoodsSearcher     = (*GoodsRepo)(nil)
+	_ GoodsIndexManager = (*GoodsRepo)(nil)
+	_ GoodsSynonymStore = (*GoodsRepo)(nil)
+)
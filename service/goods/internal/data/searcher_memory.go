@@ -0,0 +1,408 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "mshop/service/goods/api/goods/v1"
+)
+
+// memoryFacetSize 品牌、分类聚合返回的桶数量，与 ES 查询一致
+const memoryFacetSize = 50
+
+// memoryGoodsDoc 内存中的商品文档，字段与 GoodsMapping 一致
+type memoryGoodsDoc struct {
+	ID           int32   `json:"id"`
+	CategoryID   int32   `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	CategoryPath []int32 `json:"category_path"`
+	IsTab        bool    `json:"is_tab"`
+	BrandID      int32   `json:"brand_id"`
+	OnSale       bool    `json:"on_sale"`
+	ShipFree     bool    `json:"ship_free"`
+	IsNew        bool    `json:"is_new"`
+	IsHot        bool    `json:"is_hot"`
+
+	Name     string `json:"name"`
+	ClickNum int32  `json:"click_num"`
+	SoldNum  int32  `json:"sold_num"`
+	FavNum   int32  `json:"fav_num"`
+
+	MarketPrice float32 `json:"market_price"`
+	GoodsBrief  string  `json:"goods_brief"`
+	ShopPrice   float32 `json:"shop_price"`
+
+	AddTime time.Time `json:"add_time"`
+
+	Attrs []memoryGoodsAttr `json:"attrs"`
+}
+
+// memoryGoodsAttr 内存文档中的商品属性值
+type memoryGoodsAttr struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MemoryGoodsSearcher 基于内存的商品搜索实现，用于本地开发和测试，文档只由增量索引写入
+// 过滤、排序、分页和聚合语义与 ES 实现一致，关键词按子串匹配计算相关度，不支持 search_after 游标
+type MemoryGoodsSearcher struct {
+	mu   sync.RWMutex
+	docs map[int32]*memoryGoodsDoc
+}
+
+// NewMemoryGoodsSearcher 创建内存商品搜索实现
+func NewMemoryGoodsSearcher() *MemoryGoodsSearcher {
+	return &MemoryGoodsSearcher{
+		docs: make(map[int32]*memoryGoodsDoc),
+	}
+}
+
+var _ GoodsSearcher = (*MemoryGoodsSearcher)(nil)
+
+// IndexGoods 写入（覆盖）单个商品文档，doc 按 JSON 字段解析，与写入 ES 的文档一致
+func (m *MemoryGoodsSearcher) IndexGoods(ctx context.Context, id int32, doc interface{}) error {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	var d memoryGoodsDoc
+	if err := json.Unmarshal(body, &d); err != nil {
+		return err
+	}
+	d.ID = id
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.docs[id] = &d
+	return nil
+}
+
+// DeleteGoodsDoc 删除单个商品文档，文档不存在视为成功
+func (m *MemoryGoodsSearcher) DeleteGoodsDoc(ctx context.Context, id int32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.docs, id)
+	return nil
+}
+
+// SearchGoodsIDs 在内存中搜索商品，返回商品 ID 列表、总数以及聚合结果
+func (m *MemoryGoodsSearcher) SearchGoodsIDs(ctx context.Context, req *pb.GoodsFilterRequest) (*GoodsSearchResult, error) {
+	token, err := ParsePageToken(req.PageToken, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil && len(token.After) > 0 {
+		return nil, ErrInvalidPageToken
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	terms := strings.Fields(strings.ToLower(req.KeyWords))
+
+	type hit struct {
+		doc   *memoryGoodsDoc
+		score int
+	}
+	hits := make([]hit, 0, len(m.docs))
+	for _, doc := range m.docs {
+		if !matchGoodsFilter(doc, req) {
+			continue
+		}
+		score := 0
+		if len(terms) > 0 {
+			score = keywordScore(doc, terms)
+			if score == 0 {
+				continue
+			}
+		}
+		hits = append(hits, hit{doc: doc, score: score})
+	}
+
+	// 排序，主排序相同时依次按相关度、商品 ID 排序
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if c := compareGoodsSort(a.doc, b.doc, req.Sort); c != 0 {
+			return c < 0
+		}
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		return a.doc.ID > b.doc.ID
+	})
+
+	// 聚合与查询共用同一组过滤条件
+	priceInterval := priceIntervalOf(req)
+	brands := make(map[float64]int64)
+	categories := make(map[float64]int64)
+	prices := make(map[float64]int64)
+	var aggs searchAggregations
+	for _, h := range hits {
+		brands[float64(h.doc.BrandID)]++
+		categories[float64(h.doc.CategoryID)]++
+		prices[math.Floor(float64(h.doc.ShopPrice)/float64(priceInterval))*float64(priceInterval)]++
+		if h.doc.IsNew {
+			aggs.IsNew.DocCount++
+		}
+		if h.doc.IsHot {
+			aggs.IsHot.DocCount++
+		}
+		if h.doc.ShipFree {
+			aggs.ShipFree.DocCount++
+		}
+	}
+	aggs.Brands.Buckets = termsBuckets(brands, memoryFacetSize)
+	aggs.Categories.Buckets = termsBuckets(categories, memoryFacetSize)
+	aggs.Prices.Buckets = histogramBuckets(prices)
+
+	// 分页
+	from, size := pageWindow(req, token)
+	ids := make([]int32, 0, size)
+	var highlights map[int32]*pb.GoodsHighlight
+	for i := from; i < len(hits) && i < from+size; i++ {
+		doc := hits[i].doc
+		ids = append(ids, doc.ID)
+		if len(terms) > 0 {
+			if highlights == nil {
+				highlights = make(map[int32]*pb.GoodsHighlight, size)
+			}
+			highlights[doc.ID] = &pb.GoodsHighlight{
+				Name:       highlightTerms(doc.Name, terms),
+				GoodsBrief: highlightTerms(doc.GoodsBrief, terms),
+			}
+		}
+	}
+
+	result := &GoodsSearchResult{
+		IDs:        ids,
+		Total:      int64(len(hits)),
+		Highlights: highlights,
+	}
+	if token == nil {
+		result.Facets = aggs.facets(float32(priceInterval))
+	}
+	if from+size < len(hits) {
+		next := &PageToken{Sort: req.Sort, Offset: from + size}
+		result.NextPageToken = next.Encode()
+	}
+	return result, nil
+}
+
+// SuggestGoods 返回名称以 prefix 开头的在售商品
+func (m *MemoryGoodsSearcher) SuggestGoods(ctx context.Context, prefix string, size int) ([]*pb.GoodsSuggestion, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	prefix = strings.ToLower(prefix)
+	suggestions := make([]*pb.GoodsSuggestion, 0, size)
+	seen := make(map[string]bool)
+	for _, doc := range m.docs {
+		if !doc.OnSale || !strings.HasPrefix(strings.ToLower(doc.Name), prefix) || seen[doc.Name] {
+			continue
+		}
+		seen[doc.Name] = true
+		suggestions = append(suggestions, &pb.GoodsSuggestion{Id: doc.ID, Name: doc.Name})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		return suggestions[i].Name < suggestions[j].Name
+	})
+	if len(suggestions) > size {
+		suggestions = suggestions[:size]
+	}
+	return suggestions, nil
+}
+
+// matchGoodsFilter 判断文档是否满足关键词以外的过滤条件
+func matchGoodsFilter(doc *memoryGoodsDoc, req *pb.GoodsFilterRequest) bool {
+	if req.PriceMin > 0 && doc.ShopPrice < float32(req.PriceMin) {
+		return false
+	}
+	if req.PriceMax > 0 && doc.ShopPrice > float32(req.PriceMax) {
+		return false
+	}
+	if !req.IncludeOffSale && !doc.OnSale {
+		return false
+	}
+	if req.IsHot && !doc.IsHot {
+		return false
+	}
+	if req.IsNew && !doc.IsNew {
+		return false
+	}
+	if req.IsTab && !doc.IsTab {
+		return false
+	}
+	if req.Brand > 0 && doc.BrandID != req.Brand {
+		return false
+	}
+	if req.TopCategory > 0 {
+		found := false
+		for _, id := range doc.CategoryPath {
+			if id == req.TopCategory {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, f := range GoodsAttrFilters(req) {
+		if !matchAttrFilter(doc, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAttrFilter 判断文档是否有同名属性且值为任一过滤值
+func matchAttrFilter(doc *memoryGoodsDoc, f *GoodsAttrFilter) bool {
+	for _, attr := range doc.Attrs {
+		if attr.Name != f.Name {
+			continue
+		}
+		for _, v := range f.Values {
+			if attr.Value == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// keywordScore 计算关键词在 name、goods_brief、category_name 中命中的次数
+func keywordScore(doc *memoryGoodsDoc, terms []string) int {
+	fields := []string{
+		strings.ToLower(doc.Name),
+		strings.ToLower(doc.GoodsBrief),
+		strings.ToLower(doc.CategoryName),
+	}
+	score := 0
+	for _, term := range terms {
+		for _, field := range fields {
+			if strings.Contains(field, term) {
+				score++
+			}
+		}
+	}
+	return score
+}
+
+// highlightTerms 对 text 做 HTML 转义并用 <em></em> 包裹命中的关键词，未命中时返回 nil
+func highlightTerms(text string, terms []string) []string {
+	lower := strings.ToLower(text)
+	if len(lower) != len(text) {
+		// 大小写转换改变了字节长度时按原文匹配，保证下标一致
+		lower = text
+	}
+	matched := make([]bool, len(text))
+	hit := false
+	for _, term := range terms {
+		for start := 0; ; {
+			i := strings.Index(lower[start:], term)
+			if i < 0 {
+				break
+			}
+			for j := start + i; j < start+i+len(term); j++ {
+				matched[j] = true
+			}
+			start += i + len(term)
+			hit = true
+		}
+	}
+	if !hit {
+		return nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		j := i
+		for j < len(text) && matched[j] == matched[i] {
+			j++
+		}
+		if matched[i] {
+			b.WriteString("<em>" + html.EscapeString(text[i:j]) + "</em>")
+		} else {
+			b.WriteString(html.EscapeString(text[i:j]))
+		}
+		i = j
+	}
+	return []string{b.String()}
+}
+
+// compareGoodsSort 按排序方式比较两个文档，规则与 goodsSort 一致
+func compareGoodsSort(a, b *memoryGoodsDoc, sort pb.GoodsSort) int {
+	switch sort {
+	case pb.GoodsSort_GOODS_SORT_PRICE_ASC:
+		return compareFirst(compareNum(a.ShopPrice, b.ShopPrice), compareNum(b.SoldNum, a.SoldNum))
+	case pb.GoodsSort_GOODS_SORT_PRICE_DESC:
+		return compareFirst(compareNum(b.ShopPrice, a.ShopPrice), compareNum(b.SoldNum, a.SoldNum))
+	case pb.GoodsSort_GOODS_SORT_SALES:
+		return compareFirst(compareNum(b.SoldNum, a.SoldNum), compareNum(b.FavNum, a.FavNum))
+	case pb.GoodsSort_GOODS_SORT_NEWEST:
+		return b.AddTime.Compare(a.AddTime)
+	case pb.GoodsSort_GOODS_SORT_POPULAR:
+		return compareFirst(compareNum(b.ClickNum, a.ClickNum), compareNum(b.FavNum, a.FavNum))
+	}
+	return 0
+}
+
+// compareNum 比较两个数值
+func compareNum[T int32 | float32](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareFirst 返回第一个不相等的比较结果
+func compareFirst(results ...int) int {
+	for _, c := range results {
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// termsBuckets 按数量降序、键升序取前 size 个桶，与 ES terms 聚合一致
+func termsBuckets(counts map[float64]int64, size int) []aggBucket {
+	buckets := make([]aggBucket, 0, len(counts))
+	for key, count := range counts {
+		buckets = append(buckets, aggBucket{Key: key, DocCount: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].DocCount != buckets[j].DocCount {
+			return buckets[i].DocCount > buckets[j].DocCount
+		}
+		return buckets[i].Key < buckets[j].Key
+	})
+	if len(buckets) > size {
+		buckets = buckets[:size]
+	}
+	return buckets
+}
+
+// histogramBuckets 按键升序返回桶，与 ES histogram 聚合一致
+func histogramBuckets(counts map[float64]int64) []aggBucket {
+	buckets := make([]aggBucket, 0, len(counts))
+	for key, count := range counts {
+		buckets = append(buckets, aggBucket{Key: key, DocCount: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Key < buckets[j].Key
+	})
+	return buckets
+}
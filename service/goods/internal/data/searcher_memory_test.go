@@ -0,0 +1,271 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "mshop/service/goods/api/goods/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// testGoodsDocs 测试用商品文档，字段与索引文档一致
+func testGoodsDocs() map[int32]map[string]interface{} {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return map[int32]map[string]interface{}{
+		1: {
+			"category_id": 11, "category_name": "苹果", "category_path": []int32{1, 11}, "is_tab": true,
+			"brand_id": 100, "on_sale": true, "ship_free": true, "is_new": true, "is_hot": false,
+			"name": "红富士苹果", "goods_brief": "脆甜多汁", "shop_price": 30, "sold_num": 50, "fav_num": 5, "click_num": 300,
+			"add_time": base,
+			"attrs":    []map[string]string{{"name": "产地", "value": "山东"}},
+		},
+		2: {
+			"category_id": 11, "category_name": "苹果", "category_path": []int32{1, 11}, "is_tab": true,
+			"brand_id": 101, "on_sale": true, "ship_free": false, "is_new": false, "is_hot": true,
+			"name": "阿克苏苹果", "goods_brief": "冰糖心", "shop_price": 80, "sold_num": 200, "fav_num": 20, "click_num": 100,
+			"add_time": base.Add(24 * time.Hour),
+			"attrs":    []map[string]string{{"name": "产地", "value": "新疆"}},
+		},
+		3: {
+			"category_id": 12, "category_name": "香蕉", "category_path": []int32{1, 12}, "is_tab": false,
+			"brand_id": 100, "on_sale": true, "ship_free": true, "is_new": false, "is_hot": true,
+			"name": "海南香蕉", "goods_brief": "香甜软糯", "shop_price": 45, "sold_num": 200, "fav_num": 30, "click_num": 100,
+			"add_time": base.Add(48 * time.Hour),
+		},
+		4: {
+			"category_id": 21, "category_name": "牛奶", "category_path": []int32{2, 21}, "is_tab": false,
+			"brand_id": 102, "on_sale": true, "ship_free": false, "is_new": true, "is_hot": false,
+			"name": "纯牛奶", "goods_brief": "苹果味不是", "shop_price": 120, "sold_num": 10, "fav_num": 1, "click_num": 500,
+			"add_time": base.Add(72 * time.Hour),
+		},
+		5: {
+			"category_id": 11, "category_name": "苹果", "category_path": []int32{1, 11}, "is_tab": true,
+			"brand_id": 100, "on_sale": false, "ship_free": true, "is_new": true, "is_hot": true,
+			"name": "下架苹果", "goods_brief": "", "shop_price": 10, "sold_num": 999, "fav_num": 99, "click_num": 999,
+			"add_time": base.Add(96 * time.Hour),
+		},
+	}
+}
+
+// newTestMemoryGoodsSearcher 创建写入测试文档的内存搜索实现
+func newTestMemoryGoodsSearcher(t *testing.T) *MemoryGoodsSearcher {
+	t.Helper()
+	m := NewMemoryGoodsSearcher()
+	for id, doc := range testGoodsDocs() {
+		if err := m.IndexGoods(context.Background(), id, doc); err != nil {
+			t.Fatalf("index goods %d: %v", id, err)
+		}
+	}
+	return m
+}
+
+func TestMemoryGoodsSearcherFilter(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+
+	tests := []struct {
+		name string
+		req  *pb.GoodsFilterRequest
+		want []int32
+	}{
+		{"default only on sale", &pb.GoodsFilterRequest{}, []int32{4, 3, 2, 1}},
+		{"include off sale", &pb.GoodsFilterRequest{IncludeOffSale: true}, []int32{5, 4, 3, 2, 1}},
+		{"price range", &pb.GoodsFilterRequest{PriceMin: 40, PriceMax: 100}, []int32{3, 2}},
+		{"hot", &pb.GoodsFilterRequest{IsHot: true}, []int32{3, 2}},
+		{"new", &pb.GoodsFilterRequest{IsNew: true}, []int32{4, 1}},
+		{"tab", &pb.GoodsFilterRequest{IsTab: true}, []int32{2, 1}},
+		{"brand", &pb.GoodsFilterRequest{Brand: 100}, []int32{3, 1}},
+		{"category subtree", &pb.GoodsFilterRequest{TopCategory: 1}, []int32{3, 2, 1}},
+		{"leaf category", &pb.GoodsFilterRequest{TopCategory: 12}, []int32{3}},
+		{"attr", &pb.GoodsFilterRequest{Attrs: []*pb.GoodsAttrValue{{Name: "产地", Value: "新疆"}}}, []int32{2}},
+		{"attr values or", &pb.GoodsFilterRequest{Attrs: []*pb.GoodsAttrValue{{Name: "产地", Value: "新疆"}, {Name: "产地", Value: "山东"}}}, []int32{2, 1}},
+		{"attr missing", &pb.GoodsFilterRequest{Attrs: []*pb.GoodsAttrValue{{Name: "产地", Value: "云南"}}}, []int32{}},
+		{"keyword ranks by hits", &pb.GoodsFilterRequest{KeyWords: "苹果"}, []int32{2, 1, 4}},
+		{"keyword and filter", &pb.GoodsFilterRequest{KeyWords: "苹果", Brand: 100}, []int32{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := m.SearchGoodsIDs(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			if !reflect.DeepEqual(result.IDs, tt.want) {
+				t.Errorf("ids = %v, want %v", result.IDs, tt.want)
+			}
+			if result.Total != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d", result.Total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestMemoryGoodsSearcherSort(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+
+	tests := []struct {
+		name string
+		sort pb.GoodsSort
+		want []int32
+	}{
+		{"default by id desc", pb.GoodsSort_GOODS_SORT_DEFAULT, []int32{4, 3, 2, 1}},
+		{"price asc", pb.GoodsSort_GOODS_SORT_PRICE_ASC, []int32{1, 3, 2, 4}},
+		{"price desc", pb.GoodsSort_GOODS_SORT_PRICE_DESC, []int32{4, 2, 3, 1}},
+		{"sales then fav", pb.GoodsSort_GOODS_SORT_SALES, []int32{3, 2, 1, 4}},
+		{"newest", pb.GoodsSort_GOODS_SORT_NEWEST, []int32{4, 3, 2, 1}},
+		{"popular then fav", pb.GoodsSort_GOODS_SORT_POPULAR, []int32{4, 1, 3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := m.SearchGoodsIDs(context.Background(), &pb.GoodsFilterRequest{Sort: tt.sort})
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			if !reflect.DeepEqual(result.IDs, tt.want) {
+				t.Errorf("ids = %v, want %v", result.IDs, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryGoodsSearcherPaging(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+
+	tests := []struct {
+		name     string
+		req      *pb.GoodsFilterRequest
+		want     []int32
+		wantNext bool
+	}{
+		{"first page", &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC, Pages: 1, PagePerNums: 3}, []int32{1, 3, 2}, true},
+		{"last page", &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC, Pages: 2, PagePerNums: 3}, []int32{4}, false},
+		{"beyond last page", &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC, Pages: 3, PagePerNums: 3}, []int32{}, false},
+		{"default size", &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC}, []int32{1, 3, 2, 4}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := m.SearchGoodsIDs(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			if !reflect.DeepEqual(result.IDs, tt.want) {
+				t.Errorf("ids = %v, want %v", result.IDs, tt.want)
+			}
+			if result.Total != 4 {
+				t.Errorf("total = %d, want 4", result.Total)
+			}
+			if (result.NextPageToken != "") != tt.wantNext {
+				t.Errorf("next page token = %q, want next %v", result.NextPageToken, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestMemoryGoodsSearcherPageToken(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+	req := &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC, PagePerNums: 2}
+
+	var got []int32
+	for page := 0; ; page++ {
+		if page > 3 {
+			t.Fatal("too many pages")
+		}
+		result, err := m.SearchGoodsIDs(context.Background(), req)
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if page > 0 && result.Facets != nil {
+			t.Errorf("page %d: facets returned with page token", page)
+		}
+		got = append(got, result.IDs...)
+		if result.NextPageToken == "" {
+			break
+		}
+		req.PageToken = result.NextPageToken
+	}
+	if want := []int32{1, 3, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ids = %v, want %v", got, want)
+	}
+
+	// 令牌与排序方式不一致时拒绝
+	first, err := m.SearchGoodsIDs(context.Background(), &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_PRICE_ASC, PagePerNums: 2})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if _, err := m.SearchGoodsIDs(context.Background(), &pb.GoodsFilterRequest{Sort: pb.GoodsSort_GOODS_SORT_SALES, PageToken: first.NextPageToken}); err == nil {
+		t.Error("expected error for page token of another sort")
+	}
+}
+
+func TestMemoryGoodsSearcherFacets(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+
+	tests := []struct {
+		name string
+		req  *pb.GoodsFilterRequest
+		want *pb.GoodsFacets
+	}{
+		{
+			name: "all on sale",
+			req:  &pb.GoodsFilterRequest{},
+			want: &pb.GoodsFacets{
+				Brands:     []*pb.FacetBucket{{Id: 100, Count: 2}, {Id: 101, Count: 1}, {Id: 102, Count: 1}},
+				Categories: []*pb.FacetBucket{{Id: 11, Count: 2}, {Id: 12, Count: 1}, {Id: 21, Count: 1}},
+				Prices:     []*pb.PriceFacetBucket{{From: 0, To: 50, Count: 2}, {From: 50, To: 100, Count: 1}, {From: 100, To: 150, Count: 1}},
+				IsNew:      2,
+				IsHot:      2,
+				ShipFree:   2,
+			},
+		},
+		{
+			name: "filtered with price interval",
+			req:  &pb.GoodsFilterRequest{TopCategory: 1, PriceInterval: 40},
+			want: &pb.GoodsFacets{
+				Brands:     []*pb.FacetBucket{{Id: 100, Count: 2}, {Id: 101, Count: 1}},
+				Categories: []*pb.FacetBucket{{Id: 11, Count: 2}, {Id: 12, Count: 1}},
+				Prices:     []*pb.PriceFacetBucket{{From: 0, To: 40, Count: 1}, {From: 40, To: 80, Count: 1}, {From: 80, To: 120, Count: 1}},
+				IsNew:      1,
+				IsHot:      2,
+				ShipFree:   2,
+			},
+		},
+		{
+			name: "no hits",
+			req:  &pb.GoodsFilterRequest{Brand: 999},
+			want: &pb.GoodsFacets{
+				Brands:     []*pb.FacetBucket{},
+				Categories: []*pb.FacetBucket{},
+				Prices:     []*pb.PriceFacetBucket{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := m.SearchGoodsIDs(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			if !proto.Equal(result.Facets, tt.want) {
+				t.Errorf("facets = %v, want %v", result.Facets, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryGoodsSearcherDelete(t *testing.T) {
+	m := newTestMemoryGoodsSearcher(t)
+	if err := m.DeleteGoodsDoc(context.Background(), 2); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := m.DeleteGoodsDoc(context.Background(), 404); err != nil {
+		t.Fatalf("delete missing doc: %v", err)
+	}
+	result, err := m.SearchGoodsIDs(context.Background(), &pb.GoodsFilterRequest{})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if want := []int32{4, 3, 1}; !reflect.DeepEqual(result.IDs, want) {
+		t.Errorf("ids = %v, want %v", result.IDs, want)
+	}
+}
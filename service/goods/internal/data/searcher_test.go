@@ -0,0 +1,25 @@
+package data
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSearchStatusError(t *testing.T) {
+	tests := []struct {
+		status  int
+		invalid bool
+	}{
+		{400, true},
+		{404, true},
+		{429, false},
+		{500, false},
+		{503, false},
+	}
+	for _, tt := range tests {
+		err := searchStatusError(tt.status, "body")
+		if got := errors.Is(err, ErrSearchRequestInvalid); got != tt.invalid {
+			t.Errorf("status %d: invalid = %v, want %v", tt.status, got, tt.invalid)
+		}
+	}
+}
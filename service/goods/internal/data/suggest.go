@@ -0,0 +1,80 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "mshop/service/goods/api/goods/v1"
+)
+
+// SuggestGoods 通过 name_suggest 补全字段返回在售商品的名称联想
+func (r *GoodsRepo) SuggestGoods(ctx context.Context, prefix string, size int) ([]*pb.GoodsSuggestion, error) {
+	if r.esClient == nil {
+		return nil, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	query := map[string]interface{}{
+		"_source": []string{"id"},
+		"suggest": map[string]interface{}{
+			"goods": map[string]interface{}{
+				"prefix": prefix,
+				"completion": map[string]interface{}{
+					"field":           "name_suggest",
+					"size":            size,
+					"skip_duplicates": true,
+					"contexts": map[string]interface{}{
+						"on_sale": []string{"true"},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, err
+	}
+
+	res, err := r.esClient.Search(
+		r.esClient.Search.WithContext(ctx),
+		r.esClient.Search.WithIndex(GoodsIndexName),
+		r.esClient.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("elasticsearch suggest error: %s", res.String())
+	}
+
+	var result struct {
+		Suggest struct {
+			Goods []struct {
+				Options []struct {
+					Text   string `json:"text"`
+					Source struct {
+						ID int32 `json:"id"`
+					} `json:"_source"`
+				} `json:"options"`
+			} `json:"goods"`
+		} `json:"suggest"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*pb.GoodsSuggestion, 0, size)
+	for _, entry := range result.Suggest.Goods {
+		for _, option := range entry.Options {
+			suggestions = append(suggestions, &pb.GoodsSuggestion{
+				Id:   option.Source.ID,
+				Name: option.Text,
+			})
+		}
+	}
+	return suggestions, nil
+}
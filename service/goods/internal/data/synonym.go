@@ -0,0 +1,141 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/elastic/go-elasticsearch/v8"
+)
+
+const (
+	// GoodsSynonymSet 商品同义词集合名称
+	GoodsSynonymSet = "goods-synonyms"
+	// MaxGoodsSynonyms 同义词规则数量上限
+	MaxGoodsSynonyms = 10000
+)
+
+// ensureGoodsSynonymSet 同义词集合不存在时创建空集合
+func ensureGoodsSynonymSet(ctx context.Context, client *elasticsearch.Client) error {
+	res, err := client.SynonymsGetSynonym(
+		GoodsSynonymSet,
+		client.SynonymsGetSynonym.WithContext(ctx),
+		client.SynonymsGetSynonym.WithSize(0),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound {
+		if res.IsError() {
+			return fmt.Errorf("elasticsearch get synonyms error: %s", res.String())
+		}
+		return nil
+	}
+
+	put, err := client.SynonymsPutSynonym(
+		GoodsSynonymSet,
+		bytes.NewReader([]byte(`{"synonyms_set":[]}`)),
+		client.SynonymsPutSynonym.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer put.Body.Close()
+
+	if put.IsError() {
+		return fmt.Errorf("elasticsearch put synonyms error: %s", put.String())
+	}
+	return nil
+}
+
+// GetGoodsSynonyms 返回同义词集合中的全部规则
+func (r *GoodsRepo) GetGoodsSynonyms(ctx context.Context) ([]string, error) {
+	if r.esClient == nil {
+		return nil, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	res, err := r.esClient.SynonymsGetSynonym(
+		GoodsSynonymSet,
+		r.esClient.SynonymsGetSynonym.WithContext(ctx),
+		r.esClient.SynonymsGetSynonym.WithSize(MaxGoodsSynonyms),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return []string{}, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("elasticsearch get synonyms error: %s", res.String())
+	}
+
+	var result struct {
+		SynonymsSet []struct {
+			ID       string `json:"id"`
+			Synonyms string `json:"synonyms"`
+		} `json:"synonyms_set"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	synonyms := make([]string, 0, len(result.SynonymsSet))
+	for _, rule := range result.SynonymsSet {
+		synonyms = append(synonyms, rule.Synonyms)
+	}
+	return synonyms, nil
+}
+
+// PutGoodsSynonyms 用 synonyms 整体替换同义词集合，并重新加载 goods 别名下索引的搜索分析器
+func (r *GoodsRepo) PutGoodsSynonyms(ctx context.Context, synonyms []string) error {
+	if r.esClient == nil {
+		return fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	rules := make([]map[string]string, 0, len(synonyms))
+	for i, synonym := range synonyms {
+		rules = append(rules, map[string]string{
+			"id":       "rule-" + strconv.Itoa(i+1),
+			"synonyms": synonym,
+		})
+	}
+	body, err := json.Marshal(map[string]interface{}{"synonyms_set": rules})
+	if err != nil {
+		return err
+	}
+
+	res, err := r.esClient.SynonymsPutSynonym(
+		GoodsSynonymSet,
+		bytes.NewReader(body),
+		r.esClient.SynonymsPutSynonym.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("elasticsearch put synonyms error: %s", res.String())
+	}
+
+	reload, err := r.esClient.Indices.ReloadSearchAnalyzers(
+		[]string{GoodsIndexName},
+		r.esClient.Indices.ReloadSearchAnalyzers.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer reload.Body.Close()
+
+	if reload.IsError() {
+		return fmt.Errorf("elasticsearch reload search analyzers error: %s", reload.String())
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ func NewHTTPServer(c *conf.Server, goods *service.GoodsService, logger log.Logge
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	// 导出为文件下载，需在 /v1/goods/{id} 之前注册
+	srv.Route("/").GET("/v1/goods/export", goods.ExportGoodsHTTP)
 	v1.RegisterGoodsHTTPServer(srv, goods)
 	return srv
 }
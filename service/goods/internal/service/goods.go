@@ -1,10 +1,15 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"mime"
 
 	pb "mshop/service/goods/api/goods/v1"
 	"mshop/service/goods/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 type GoodsService struct {
@@ -21,9 +26,33 @@ func NewGoodsService(goodsUsecase *biz.GoodsUsecase) *GoodsService {
 func (s *GoodsService) GoodsList(ctx context.Context, req *pb.GoodsFilterRequest) (*pb.GoodsListResponse, error) {
 	return s.goodsUsecase.GoodsList(ctx, req)
 }
+func (s *GoodsService) AdminGoodsList(ctx context.Context, req *pb.GoodsFilterRequest) (*pb.GoodsListResponse, error) {
+	return s.goodsUsecase.AdminGoodsList(ctx, req)
+}
+func (s *GoodsService) SuggestGoods(ctx context.Context, req *pb.SuggestGoodsRequest) (*pb.SuggestGoodsResponse, error) {
+	return s.goodsUsecase.SuggestGoods(ctx, req)
+}
 func (s *GoodsService) BatchGetGoods(ctx context.Context, req *pb.BatchGoodsIdInfo) (*pb.GoodsListResponse, error) {
 	return s.goodsUsecase.BatchGetGoods(ctx, req)
 }
+func (s *GoodsService) GetGoodsBySn(ctx context.Context, req *pb.GoodsSnRequest) (*pb.GoodsInfoResponse, error) {
+	return s.goodsUsecase.GetGoodsBySn(ctx, req)
+}
+func (s *GoodsService) BatchResolveGoodsSn(ctx context.Context, req *pb.BatchGoodsSnRequest) (*pb.BatchGoodsSnResponse, error) {
+	return s.goodsUsecase.BatchResolveGoodsSn(ctx, req)
+}
+func (s *GoodsService) RecommendGoods(ctx context.Context, req *pb.GoodsRecommendRequest) (*pb.GoodsRecommendResponse, error) {
+	return s.goodsUsecase.RecommendGoods(ctx, req)
+}
+func (s *GoodsService) IncrGoodsCounters(ctx context.Context, req *pb.IncrGoodsCountersRequest) (*pb.Empty, error) {
+	return s.goodsUsecase.IncrGoodsCounters(ctx, req)
+}
+func (s *GoodsService) GetGoodsCounters(ctx context.Context, req *pb.BatchGoodsIdInfo) (*pb.GoodsCountersResponse, error) {
+	return s.goodsUsecase.GetGoodsCounters(ctx, req)
+}
+func (s *GoodsService) UpdateGoodsRating(ctx context.Context, req *pb.GoodsRatingInfo) (*pb.Empty, error) {
+	return s.goodsUsecase.UpdateGoodsRating(ctx, req)
+}
 func (s *GoodsService) CreateGoods(ctx context.Context, req *pb.CreateGoodsInfo) (*pb.GoodsInfoResponse, error) {
 	return s.goodsUsecase.CreateGoods(ctx, req)
 }
@@ -37,6 +66,179 @@ func (s *GoodsService) GetGoodsDetail(ctx context.Context, req *pb.GoodInfoReque
 	return s.goodsUsecase.GetGoodsDetail(ctx, req)
 }
 
+func (s *GoodsService) ReindexGoods(ctx context.Context, req *pb.ReindexGoodsRequest) (*pb.ReindexStatusResponse, error) {
+	return s.goodsUsecase.ReindexGoods(ctx, req)
+}
+func (s *GoodsService) GetReindexStatus(ctx context.Context, req *pb.Empty) (*pb.ReindexStatusResponse, error) {
+	return s.goodsUsecase.GetReindexStatus(ctx, req)
+}
+func (s *GoodsService) RollbackGoodsIndex(ctx context.Context, req *pb.Empty) (*pb.GoodsIndexResponse, error) {
+	return s.goodsUsecase.RollbackGoodsIndex(ctx, req)
+}
+func (s *GoodsService) GetGoodsSynonyms(ctx context.Context, req *pb.Empty) (*pb.GoodsSynonymsResponse, error) {
+	return s.goodsUsecase.GetGoodsSynonyms(ctx, req)
+}
+func (s *GoodsService) UpdateGoodsSynonyms(ctx context.Context, req *pb.GoodsSynonymsRequest) (*pb.GoodsSynonymsResponse, error) {
+	return s.goodsUsecase.UpdateGoodsSynonyms(ctx, req)
+}
+
+func (s *GoodsService) HotKeywords(ctx context.Context, req *pb.SearchKeywordsRequest) (*pb.SearchKeywordsResponse, error) {
+	return s.goodsUsecase.HotKeywords(ctx, req)
+}
+func (s *GoodsService) ZeroResultKeywords(ctx context.Context, req *pb.SearchKeywordsRequest) (*pb.SearchKeywordsResponse, error) {
+	return s.goodsUsecase.ZeroResultKeywords(ctx, req)
+}
+func (s *GoodsService) GetHotKeywordBlocklist(ctx context.Context, req *pb.Empty) (*pb.HotKeywordBlocklist, error) {
+	return s.goodsUsecase.GetHotKeywordBlocklist(ctx, req)
+}
+func (s *GoodsService) UpdateHotKeywordBlocklist(ctx context.Context, req *pb.HotKeywordBlocklist) (*pb.HotKeywordBlocklist, error) {
+	return s.goodsUsecase.UpdateHotKeywordBlocklist(ctx, req)
+}
+
+func (s *GoodsService) ImportGoods(ctx context.Context, req *pb.ImportGoodsRequest) (*pb.ImportGoodsResponse, error) {
+	return s.goodsUsecase.ImportGoods(ctx, req, bytes.NewReader(req.File))
+}
+
+// ImportGoodsStream 首个消息携带导入选项，各消息的 file 依次拼接为完整文件
+func (s *GoodsService) ImportGoodsStream(stream pb.Goods_ImportGoodsStreamServer) error {
+	opts, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		if _, err := pw.Write(opts.File); err != nil {
+			return
+		}
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err := pw.Write(chunk.File); err != nil {
+				return
+			}
+		}
+	}()
+
+	resp, err := s.goodsUsecase.ImportGoods(stream.Context(), opts, pr)
+	// 导入提前结束时关闭管道，使接收协程退出
+	pr.Close()
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// ExportGoods 导出商品，文件内容按顺序分片返回，首个分片携带文件名和类型
+func (s *GoodsService) ExportGoods(req *pb.ExportGoodsRequest, stream pb.Goods_ExportGoodsServer) error {
+	fileName, contentType, err := biz.GoodsExportFileInfo(req.Format)
+	if err != nil {
+		return err
+	}
+	w := &exportChunkWriter{
+		stream: stream,
+		first:  &pb.ExportGoodsChunk{FileName: fileName, ContentType: contentType},
+	}
+	if err := s.goodsUsecase.ExportGoods(stream.Context(), req, w); err != nil {
+		return err
+	}
+	if w.first != nil {
+		return stream.Send(w.first)
+	}
+	return nil
+}
+
+// ExportGoodsHTTP 以附件形式下载导出的商品，查询参数与 ExportGoodsRequest 相同，例如 format=xlsx&filter.topCategory=1
+func (s *GoodsService) ExportGoodsHTTP(ctx http.Context) error {
+	var req pb.ExportGoodsRequest
+	if err := ctx.BindQuery(&req); err != nil {
+		return err
+	}
+	fileName, contentType, err := biz.GoodsExportFileInfo(req.Format)
+	if err != nil {
+		return err
+	}
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	// 导出耗时可能超过服务端请求超时，不继承超时；客户端断开后写入失败，导出随之结束
+	return s.goodsUsecase.ExportGoods(context.WithoutCancel(ctx), &req, w)
+}
+
+// exportChunkMaxSize 导出分片的最大字节数
+const exportChunkMaxSize = 64 << 10
+
+// exportChunkWriter 将写入的内容按分片发送到 gRPC 流
+type exportChunkWriter struct {
+	stream pb.Goods_ExportGoodsServer
+	// first 尚未发送的首个分片
+	first *pb.ExportGoodsChunk
+}
+
+// Write 实现 io.Writer
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		size := min(len(p), exportChunkMaxSize)
+		chunk := &pb.ExportGoodsChunk{}
+		if w.first != nil {
+			chunk, w.first = w.first, nil
+		}
+		chunk.Data = p[:size]
+		if err := w.stream.Send(chunk); err != nil {
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+	return n, nil
+}
+func (s *GoodsService) UpdateGoodsStatus(ctx context.Context, req *pb.GoodsStatusRequest) (*pb.GoodsStatusResponse, error) {
+	return s.goodsUsecase.UpdateGoodsStatus(ctx, req)
+}
+func (s *GoodsService) ScheduleGoodsSale(ctx context.Context, req *pb.GoodsScheduleRequest) (*pb.GoodsStatusResponse, error) {
+	return s.goodsUsecase.ScheduleGoodsSale(ctx, req)
+}
+func (s *GoodsService) GoodsStatusLogs(ctx context.Context, req *pb.GoodInfoRequest) (*pb.GoodsStatusLogListResponse, error) {
+	return s.goodsUsecase.GoodsStatusLogs(ctx, req)
+}
+func (s *GoodsService) GoodsPriceHistory(ctx context.Context, req *pb.GoodsPriceHistoryRequest) (*pb.GoodsPriceHistoryResponse, error) {
+	return s.goodsUsecase.GoodsPriceHistory(ctx, req)
+}
+func (s *GoodsService) GoodsDescVersions(ctx context.Context, req *pb.GoodsDescVersionListRequest) (*pb.GoodsDescVersionListResponse, error) {
+	return s.goodsUsecase.GoodsDescVersions(ctx, req)
+}
+func (s *GoodsService) GetGoodsDescVersion(ctx context.Context, req *pb.GoodsDescVersionRequest) (*pb.GoodsDescVersionInfo, error) {
+	return s.goodsUsecase.GetGoodsDescVersion(ctx, req)
+}
+func (s *GoodsService) RollbackGoodsDesc(ctx context.Context, req *pb.GoodsDescVersionRequest) (*pb.GoodsDescVersionInfo, error) {
+	return s.goodsUsecase.RollbackGoodsDesc(ctx, req)
+}
+
+func (s *GoodsService) GoodsSkuList(ctx context.Context, req *pb.GoodInfoRequest) (*pb.GoodsSkuListResponse, error) {
+	return s.goodsUsecase.GoodsSkuList(ctx, req)
+}
+func (s *GoodsService) BatchGetSkus(ctx context.Context, req *pb.BatchSkuIdInfo) (*pb.GoodsSkuListResponse, error) {
+	return s.goodsUsecase.BatchGetSkus(ctx, req)
+}
+func (s *GoodsService) CreateGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (*pb.GoodsSkuInfo, error) {
+	return s.goodsUsecase.CreateGoodsSku(ctx, req)
+}
+func (s *GoodsService) UpdateGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (*pb.Empty, error) {
+	return s.goodsUsecase.UpdateGoodsSku(ctx, req)
+}
+func (s *GoodsService) DeleteGoodsSku(ctx context.Context, req *pb.GoodsSkuInfo) (*pb.Empty, error) {
+	return s.goodsUsecase.DeleteGoodsSku(ctx, req)
+}
+
 func (s *GoodsService) GetAllCategorysList(ctx context.Context, req *pb.Empty) (*pb.CategoryListResponse, error) {
 	return s.goodsUsecase.GetAllCategorysList(ctx, req)
 }
@@ -52,6 +254,18 @@ func (s *GoodsService) DeleteCategory(ctx context.Context, req *pb.DeleteCategor
 func (s *GoodsService) UpdateCategory(ctx context.Context, req *pb.CategoryInfoRequest) (*pb.Empty, error) {
 	return s.goodsUsecase.UpdateCategory(ctx, req)
 }
+func (s *GoodsService) CategoryAttributeList(ctx context.Context, req *pb.CategoryListRequest) (*pb.CategoryAttributeListResponse, error) {
+	return s.goodsUsecase.CategoryAttributeList(ctx, req)
+}
+func (s *GoodsService) CreateCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (*pb.CategoryAttributeInfo, error) {
+	return s.goodsUsecase.CreateCategoryAttribute(ctx, req)
+}
+func (s *GoodsService) UpdateCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (*pb.Empty, error) {
+	return s.goodsUsecase.UpdateCategoryAttribute(ctx, req)
+}
+func (s *GoodsService) DeleteCategoryAttribute(ctx context.Context, req *pb.CategoryAttributeInfo) (*pb.Empty, error) {
+	return s.goodsUsecase.DeleteCategoryAttribute(ctx, req)
+}
 
 func (s *GoodsService) BrandList(ctx context.Context, req *pb.BrandFilterRequest) (*pb.BrandListResponse, error) {
 	return s.goodsUsecase.BrandList(ctx, req)
@@ -915,6 +915,1229 @@ func (x *CartItemListResponse) GetData() []*ShopCartInfoResponse {
 	return nil
 }
 
+// 收藏请求
+type UserFavRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 商品ID
+	GoodsId       int32 `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavRequest) Reset() {
+	*x = UserFavRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavRequest) ProtoMessage() {}
+
+func (x *UserFavRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavRequest.ProtoReflect.Descriptor instead.
+func (*UserFavRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserFavRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserFavRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+// 收藏列表查询请求
+type UserFavFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 页码，从1开始
+	Pages int32 `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`
+	// 每页数量，1-100
+	PagePerNums   int32 `protobuf:"varint,3,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavFilterRequest) Reset() {
+	*x = UserFavFilterRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavFilterRequest) ProtoMessage() {}
+
+func (x *UserFavFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavFilterRequest.ProtoReflect.Descriptor instead.
+func (*UserFavFilterRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserFavFilterRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserFavFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *UserFavFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+// 收藏信息响应
+type UserFavInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 收藏ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 商品ID
+	GoodsId int32 `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 收藏时间
+	AddTime string `protobuf:"bytes,4,opt,name=addTime,proto3" json:"addTime,omitempty"`
+	// 商品名称，商品已删除时为空
+	GoodsName string `protobuf:"bytes,5,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	// 商品封面图URL
+	GoodsImage string `protobuf:"bytes,6,opt,name=goodsImage,proto3" json:"goodsImage,omitempty"`
+	// 商品售价
+	ShopPrice float32 `protobuf:"fixed32,7,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`
+	// 是否上架
+	OnSale bool `protobuf:"varint,8,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	// 商品是否存在
+	GoodsExists   bool `protobuf:"varint,9,opt,name=goodsExists,proto3" json:"goodsExists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavInfoResponse) Reset() {
+	*x = UserFavInfoResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavInfoResponse) ProtoMessage() {}
+
+func (x *UserFavInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavInfoResponse.ProtoReflect.Descriptor instead.
+func (*UserFavInfoResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserFavInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserFavInfoResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserFavInfoResponse) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *UserFavInfoResponse) GetAddTime() string {
+	if x != nil {
+		return x.AddTime
+	}
+	return ""
+}
+
+func (x *UserFavInfoResponse) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *UserFavInfoResponse) GetGoodsImage() string {
+	if x != nil {
+		return x.GoodsImage
+	}
+	return ""
+}
+
+func (x *UserFavInfoResponse) GetShopPrice() float32 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *UserFavInfoResponse) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *UserFavInfoResponse) GetGoodsExists() bool {
+	if x != nil {
+		return x.GoodsExists
+	}
+	return false
+}
+
+// 收藏列表响应
+type UserFavListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 总记录数
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// 收藏列表，按收藏时间倒序
+	Data          []*UserFavInfoResponse `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavListResponse) Reset() {
+	*x = UserFavListResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavListResponse) ProtoMessage() {}
+
+func (x *UserFavListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavListResponse.ProtoReflect.Descriptor instead.
+func (*UserFavListResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserFavListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserFavListResponse) GetData() []*UserFavInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 批量查询收藏状态请求
+type UserFavStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 商品ID列表，最多100个
+	GoodsIds      []int32 `protobuf:"varint,2,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavStatusRequest) Reset() {
+	*x = UserFavStatusRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavStatusRequest) ProtoMessage() {}
+
+func (x *UserFavStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavStatusRequest.ProtoReflect.Descriptor instead.
+func (*UserFavStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserFavStatusRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserFavStatusRequest) GetGoodsIds() []int32 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+// 商品收藏状态
+type UserFavStatusInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品ID
+	GoodsId int32 `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 是否已收藏
+	Favorited     bool `protobuf:"varint,2,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavStatusInfo) Reset() {
+	*x = UserFavStatusInfo{}
+	mi := &file_order_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavStatusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavStatusInfo) ProtoMessage() {}
+
+func (x *UserFavStatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavStatusInfo.ProtoReflect.Descriptor instead.
+func (*UserFavStatusInfo) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserFavStatusInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *UserFavStatusInfo) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+// 批量查询收藏状态响应
+type UserFavStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 收藏状态列表，与请求顺序一致
+	Data          []*UserFavStatusInfo `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavStatusResponse) Reset() {
+	*x = UserFavStatusResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavStatusResponse) ProtoMessage() {}
+
+func (x *UserFavStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavStatusResponse.ProtoReflect.Descriptor instead.
+func (*UserFavStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserFavStatusResponse) GetData() []*UserFavStatusInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 创建评价请求
+type GoodsReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 订单商品ID，每个订单商品只能评价一次
+	OrderGoodsId int32 `protobuf:"varint,2,opt,name=orderGoodsId,proto3" json:"orderGoodsId,omitempty"`
+	// 星级，1-5
+	Star int32 `protobuf:"varint,3,opt,name=star,proto3" json:"star,omitempty"`
+	// 评价内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 评价图片URL，最多9张
+	Images        []string `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReviewRequest) Reset() {
+	*x = GoodsReviewRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReviewRequest) ProtoMessage() {}
+
+func (x *GoodsReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReviewRequest.ProtoReflect.Descriptor instead.
+func (*GoodsReviewRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GoodsReviewRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GoodsReviewRequest) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *GoodsReviewRequest) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *GoodsReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GoodsReviewRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 评价审核请求
+type ModerateGoodsReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评价ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 审核结果：2(通过)、3(驳回)
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 驳回原因
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateGoodsReviewRequest) Reset() {
+	*x = ModerateGoodsReviewRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateGoodsReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateGoodsReviewRequest) ProtoMessage() {}
+
+func (x *ModerateGoodsReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateGoodsReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateGoodsReviewRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ModerateGoodsReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateGoodsReviewRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerateGoodsReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 商家回复请求
+type ReplyGoodsReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评价ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 回复内容，为空时删除回复
+	Reply         string `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyGoodsReviewRequest) Reset() {
+	*x = ReplyGoodsReviewRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyGoodsReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyGoodsReviewRequest) ProtoMessage() {}
+
+func (x *ReplyGoodsReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyGoodsReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyGoodsReviewRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplyGoodsReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplyGoodsReviewRequest) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+// 评价列表查询请求，只返回审核通过的评价
+type GoodsReviewFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品ID
+	GoodsId int32 `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 星级，0表示全部
+	Star int32 `protobuf:"varint,2,opt,name=star,proto3" json:"star,omitempty"`
+	// 只看有图评价
+	WithImages bool `protobuf:"varint,3,opt,name=withImages,proto3" json:"withImages,omitempty"`
+	// 页码，从1开始
+	Pages int32 `protobuf:"varint,5,opt,name=pages,proto3" json:"pages,omitempty"`
+	// 每页数量，1-100
+	PagePerNums   int32 `protobuf:"varint,6,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReviewFilterRequest) Reset() {
+	*x = GoodsReviewFilterRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReviewFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReviewFilterRequest) ProtoMessage() {}
+
+func (x *GoodsReviewFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReviewFilterRequest.ProtoReflect.Descriptor instead.
+func (*GoodsReviewFilterRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GoodsReviewFilterRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsReviewFilterRequest) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *GoodsReviewFilterRequest) GetWithImages() bool {
+	if x != nil {
+		return x.WithImages
+	}
+	return false
+}
+
+func (x *GoodsReviewFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsReviewFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+// 评价审核列表查询请求
+type AdminGoodsReviewFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品ID，为0时不限商品
+	GoodsId int32 `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 星级，0表示全部
+	Star int32 `protobuf:"varint,2,opt,name=star,proto3" json:"star,omitempty"`
+	// 只看有图评价
+	WithImages bool `protobuf:"varint,3,opt,name=withImages,proto3" json:"withImages,omitempty"`
+	// 审核状态：0(全部)、1(待审核)、2(通过)、3(驳回)
+	Status int32 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 页码，从1开始
+	Pages int32 `protobuf:"varint,5,opt,name=pages,proto3" json:"pages,omitempty"`
+	// 每页数量，1-100
+	PagePerNums   int32 `protobuf:"varint,6,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGoodsReviewFilterRequest) Reset() {
+	*x = AdminGoodsReviewFilterRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGoodsReviewFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGoodsReviewFilterRequest) ProtoMessage() {}
+
+func (x *AdminGoodsReviewFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGoodsReviewFilterRequest.ProtoReflect.Descriptor instead.
+func (*AdminGoodsReviewFilterRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetWithImages() bool {
+	if x != nil {
+		return x.WithImages
+	}
+	return false
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *AdminGoodsReviewFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+// 评价信息响应
+type GoodsReviewInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评价ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 用户ID
+	UserId int32 `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 商品ID
+	GoodsId int32 `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 订单商品ID
+	OrderGoodsId int32 `protobuf:"varint,4,opt,name=orderGoodsId,proto3" json:"orderGoodsId,omitempty"`
+	// 星级
+	Star int32 `protobuf:"varint,5,opt,name=star,proto3" json:"star,omitempty"`
+	// 评价内容
+	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	// 评价图片URL
+	Images []string `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	// 审核状态：1(待审核)、2(通过)、3(驳回)
+	Status int32 `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	// 驳回原因
+	RejectReason string `protobuf:"bytes,9,opt,name=rejectReason,proto3" json:"rejectReason,omitempty"`
+	// 商家回复
+	Reply string `protobuf:"bytes,10,opt,name=reply,proto3" json:"reply,omitempty"`
+	// 回复时间，未回复时为空
+	ReplyTime string `protobuf:"bytes,11,opt,name=replyTime,proto3" json:"replyTime,omitempty"`
+	// 评价时间
+	AddTime       string `protobuf:"bytes,12,opt,name=addTime,proto3" json:"addTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReviewInfoResponse) Reset() {
+	*x = GoodsReviewInfoResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReviewInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReviewInfoResponse) ProtoMessage() {}
+
+func (x *GoodsReviewInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReviewInfoResponse.ProtoReflect.Descriptor instead.
+func (*GoodsReviewInfoResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GoodsReviewInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GoodsReviewInfoResponse) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *GoodsReviewInfoResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GoodsReviewInfoResponse) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *GoodsReviewInfoResponse) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *GoodsReviewInfoResponse) GetReplyTime() string {
+	if x != nil {
+		return x.ReplyTime
+	}
+	return ""
+}
+
+func (x *GoodsReviewInfoResponse) GetAddTime() string {
+	if x != nil {
+		return x.AddTime
+	}
+	return ""
+}
+
+// 评价列表响应
+type GoodsReviewListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 总记录数
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// 评价列表，按评价时间倒序
+	Data          []*GoodsReviewInfoResponse `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReviewListResponse) Reset() {
+	*x = GoodsReviewListResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReviewListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReviewListResponse) ProtoMessage() {}
+
+func (x *GoodsReviewListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReviewListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsReviewListResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GoodsReviewListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsReviewListResponse) GetData() []*GoodsReviewInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 商品共同购买统计请求
+type GoodsCoPurchaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 统计最近多少天内的订单
+	LookbackDays int32 `protobuf:"varint,1,opt,name=lookbackDays,proto3" json:"lookbackDays,omitempty"`
+	// 至少在多少个订单中共同出现才返回，过滤偶然的组合
+	MinOrders int32 `protobuf:"varint,2,opt,name=minOrders,proto3" json:"minOrders,omitempty"`
+	// 每个商品返回的共同购买商品数量上限
+	TopN int32 `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	// 从该商品ID之后开始统计，第一页为0
+	AfterGoodsId int32 `protobuf:"varint,4,opt,name=afterGoodsId,proto3" json:"afterGoodsId,omitempty"`
+	// 每页统计的商品数量
+	GoodsLimit    int32 `protobuf:"varint,5,opt,name=goodsLimit,proto3" json:"goodsLimit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsCoPurchaseRequest) Reset() {
+	*x = GoodsCoPurchaseRequest{}
+	mi := &file_order_v1_message_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsCoPurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsCoPurchaseRequest) ProtoMessage() {}
+
+func (x *GoodsCoPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsCoPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*GoodsCoPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GoodsCoPurchaseRequest) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseRequest) GetMinOrders() int32 {
+	if x != nil {
+		return x.MinOrders
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseRequest) GetAfterGoodsId() int32 {
+	if x != nil {
+		return x.AfterGoodsId
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseRequest) GetGoodsLimit() int32 {
+	if x != nil {
+		return x.GoodsLimit
+	}
+	return 0
+}
+
+// 商品共同购买关系
+type GoodsCoPurchaseInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品ID
+	GoodsId int32 `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 共同购买的商品ID
+	RelatedGoodsId int32 `protobuf:"varint,2,opt,name=relatedGoodsId,proto3" json:"relatedGoodsId,omitempty"`
+	// 共同购买的订单数
+	Score         int32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsCoPurchaseInfo) Reset() {
+	*x = GoodsCoPurchaseInfo{}
+	mi := &file_order_v1_message_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsCoPurchaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsCoPurchaseInfo) ProtoMessage() {}
+
+func (x *GoodsCoPurchaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsCoPurchaseInfo.ProtoReflect.Descriptor instead.
+func (*GoodsCoPurchaseInfo) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GoodsCoPurchaseInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseInfo) GetRelatedGoodsId() int32 {
+	if x != nil {
+		return x.RelatedGoodsId
+	}
+	return 0
+}
+
+func (x *GoodsCoPurchaseInfo) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 商品共同购买统计响应
+type GoodsCoPurchaseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 共同购买关系，按商品ID、订单数倒序排列
+	Data []*GoodsCoPurchaseInfo `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// 下一页的 afterGoodsId，为0时没有下一页
+	NextGoodsId   int32 `protobuf:"varint,2,opt,name=nextGoodsId,proto3" json:"nextGoodsId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsCoPurchaseResponse) Reset() {
+	*x = GoodsCoPurchaseResponse{}
+	mi := &file_order_v1_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsCoPurchaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsCoPurchaseResponse) ProtoMessage() {}
+
+func (x *GoodsCoPurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsCoPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*GoodsCoPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GoodsCoPurchaseResponse) GetData() []*GoodsCoPurchaseInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GoodsCoPurchaseResponse) GetNextGoodsId() int32 {
+	if x != nil {
+		return x.NextGoodsId
+	}
+	return 0
+}
+
 var File_order_v1_message_proto protoreflect.FileDescriptor
 
 const file_order_v1_message_proto_rawDesc = "" +
@@ -992,7 +2215,102 @@ const file_order_v1_message_proto_rawDesc = "" +
 	"\x04data\x18\x02 \x03(\v2#.service.order.v1.OrderInfoResponseR\x04data\"h\n" +
 	"\x14CartItemListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12:\n" +
-	"\x04data\x18\x02 \x03(\v2&.service.order.v1.ShopCartInfoResponseR\x04dataBC\n" +
+	"\x04data\x18\x02 \x03(\v2&.service.order.v1.ShopCartInfoResponseR\x04data\"T\n" +
+	"\x0eUserFavRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06userId\x12!\n" +
+	"\agoodsId\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\agoodsId\"\x83\x01\n" +
+	"\x14UserFavFilterRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06userId\x12\x1d\n" +
+	"\x05pages\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x05pages\x12+\n" +
+	"\vpagePerNums\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\vpagePerNums\"\x87\x02\n" +
+	"\x13UserFavInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x05R\agoodsId\x12\x18\n" +
+	"\aaddTime\x18\x04 \x01(\tR\aaddTime\x12\x1c\n" +
+	"\tgoodsName\x18\x05 \x01(\tR\tgoodsName\x12\x1e\n" +
+	"\n" +
+	"goodsImage\x18\x06 \x01(\tR\n" +
+	"goodsImage\x12\x1c\n" +
+	"\tshopPrice\x18\a \x01(\x02R\tshopPrice\x12\x16\n" +
+	"\x06onSale\x18\b \x01(\bR\x06onSale\x12 \n" +
+	"\vgoodsExists\x18\t \x01(\bR\vgoodsExists\"f\n" +
+	"\x13UserFavListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x129\n" +
+	"\x04data\x18\x02 \x03(\v2%.service.order.v1.UserFavInfoResponseR\x04data\"_\n" +
+	"\x14UserFavStatusRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06userId\x12&\n" +
+	"\bgoodsIds\x18\x02 \x03(\x05B\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\bgoodsIds\"K\n" +
+	"\x11UserFavStatusInfo\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x1c\n" +
+	"\tfavorited\x18\x02 \x01(\bR\tfavorited\"P\n" +
+	"\x15UserFavStatusResponse\x127\n" +
+	"\x04data\x18\x01 \x03(\v2#.service.order.v1.UserFavStatusInfoR\x04data\"\xc7\x01\n" +
+	"\x12GoodsReviewRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06userId\x12+\n" +
+	"\forderGoodsId\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\forderGoodsId\x12\x1d\n" +
+	"\x04star\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x01R\x04star\x12\"\n" +
+	"\acontent\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\acontent\x12 \n" +
+	"\x06images\x18\x05 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\tR\x06images\"z\n" +
+	"\x1aModerateGoodsReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x02id\x12!\n" +
+	"\x06status\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x040\x020\x03R\x06status\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06reason\"R\n" +
+	"\x17ReplyGoodsReviewRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x02id\x12\x1e\n" +
+	"\x05reply\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x05reply\"\xce\x01\n" +
+	"\x18GoodsReviewFilterRequest\x12!\n" +
+	"\agoodsId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\agoodsId\x12\x1d\n" +
+	"\x04star\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\x04star\x12\x1e\n" +
+	"\n" +
+	"withImages\x18\x03 \x01(\bR\n" +
+	"withImages\x12\x1d\n" +
+	"\x05pages\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x05pages\x12+\n" +
+	"\vpagePerNums\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\vpagePerNumsJ\x04\b\x04\x10\x05\"\xf0\x01\n" +
+	"\x1dAdminGoodsReviewFilterRequest\x12!\n" +
+	"\agoodsId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\agoodsId\x12\x1d\n" +
+	"\x04star\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x00R\x04star\x12\x1e\n" +
+	"\n" +
+	"withImages\x18\x03 \x01(\bR\n" +
+	"withImages\x12!\n" +
+	"\x06status\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x03(\x00R\x06status\x12\x1d\n" +
+	"\x05pages\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x05pages\x12+\n" +
+	"\vpagePerNums\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\vpagePerNums\"\xcf\x02\n" +
+	"\x17GoodsReviewInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x05R\agoodsId\x12\"\n" +
+	"\forderGoodsId\x18\x04 \x01(\x05R\forderGoodsId\x12\x12\n" +
+	"\x04star\x18\x05 \x01(\x05R\x04star\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\"\n" +
+	"\frejectReason\x18\t \x01(\tR\frejectReason\x12\x14\n" +
+	"\x05reply\x18\n" +
+	" \x01(\tR\x05reply\x12\x1c\n" +
+	"\treplyTime\x18\v \x01(\tR\treplyTime\x12\x18\n" +
+	"\aaddTime\x18\f \x01(\tR\aaddTime\"n\n" +
+	"\x17GoodsReviewListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12=\n" +
+	"\x04data\x18\x02 \x03(\v2).service.order.v1.GoodsReviewInfoResponseR\x04data\"\xe7\x01\n" +
+	"\x16GoodsCoPurchaseRequest\x12.\n" +
+	"\flookbackDays\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x01R\flookbackDays\x12%\n" +
+	"\tminOrders\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\tminOrders\x12\x1d\n" +
+	"\x04topN\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\x04topN\x12+\n" +
+	"\fafterGoodsId\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\fafterGoodsId\x12*\n" +
+	"\n" +
+	"goodsLimit\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\n" +
+	"goodsLimit\"m\n" +
+	"\x13GoodsCoPurchaseInfo\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12&\n" +
+	"\x0erelatedGoodsId\x18\x02 \x01(\x05R\x0erelatedGoodsId\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\"v\n" +
+	"\x17GoodsCoPurchaseResponse\x129\n" +
+	"\x04data\x18\x01 \x03(\v2%.service.order.v1.GoodsCoPurchaseInfoR\x04data\x12 \n" +
+	"\vnextGoodsId\x18\x02 \x01(\x05R\vnextGoodsIdBC\n" +
 	"\x1aservice.order.api.order.v1P\x01Z#mshop/service/order/api/order/v1;v1b\x06proto3"
 
 var (
@@ -1007,31 +2325,52 @@ func file_order_v1_message_proto_rawDescGZIP() []byte {
 	return file_order_v1_message_proto_rawDescData
 }
 
-var file_order_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_order_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_order_v1_message_proto_goTypes = []any{
-	(*Empty)(nil),                   // 0: service.order.v1.Empty
-	(*UserInfo)(nil),                // 1: service.order.v1.UserInfo
-	(*OrderStatus)(nil),             // 2: service.order.v1.OrderStatus
-	(*CartItemRequest)(nil),         // 3: service.order.v1.CartItemRequest
-	(*OrderRequest)(nil),            // 4: service.order.v1.OrderRequest
-	(*OrderInfoResponse)(nil),       // 5: service.order.v1.OrderInfoResponse
-	(*ShopCartInfoResponse)(nil),    // 6: service.order.v1.ShopCartInfoResponse
-	(*OrderItemResponse)(nil),       // 7: service.order.v1.OrderItemResponse
-	(*OrderInfoDetailResponse)(nil), // 8: service.order.v1.OrderInfoDetailResponse
-	(*OrderFilterRequest)(nil),      // 9: service.order.v1.OrderFilterRequest
-	(*OrderListResponse)(nil),       // 10: service.order.v1.OrderListResponse
-	(*CartItemListResponse)(nil),    // 11: service.order.v1.CartItemListResponse
+	(*Empty)(nil),                         // 0: service.order.v1.Empty
+	(*UserInfo)(nil),                      // 1: service.order.v1.UserInfo
+	(*OrderStatus)(nil),                   // 2: service.order.v1.OrderStatus
+	(*CartItemRequest)(nil),               // 3: service.order.v1.CartItemRequest
+	(*OrderRequest)(nil),                  // 4: service.order.v1.OrderRequest
+	(*OrderInfoResponse)(nil),             // 5: service.order.v1.OrderInfoResponse
+	(*ShopCartInfoResponse)(nil),          // 6: service.order.v1.ShopCartInfoResponse
+	(*OrderItemResponse)(nil),             // 7: service.order.v1.OrderItemResponse
+	(*OrderInfoDetailResponse)(nil),       // 8: service.order.v1.OrderInfoDetailResponse
+	(*OrderFilterRequest)(nil),            // 9: service.order.v1.OrderFilterRequest
+	(*OrderListResponse)(nil),             // 10: service.order.v1.OrderListResponse
+	(*CartItemListResponse)(nil),          // 11: service.order.v1.CartItemListResponse
+	(*UserFavRequest)(nil),                // 12: service.order.v1.UserFavRequest
+	(*UserFavFilterRequest)(nil),          // 13: service.order.v1.UserFavFilterRequest
+	(*UserFavInfoResponse)(nil),           // 14: service.order.v1.UserFavInfoResponse
+	(*UserFavListResponse)(nil),           // 15: service.order.v1.UserFavListResponse
+	(*UserFavStatusRequest)(nil),          // 16: service.order.v1.UserFavStatusRequest
+	(*UserFavStatusInfo)(nil),             // 17: service.order.v1.UserFavStatusInfo
+	(*UserFavStatusResponse)(nil),         // 18: service.order.v1.UserFavStatusResponse
+	(*GoodsReviewRequest)(nil),            // 19: service.order.v1.GoodsReviewRequest
+	(*ModerateGoodsReviewRequest)(nil),    // 20: service.order.v1.ModerateGoodsReviewRequest
+	(*ReplyGoodsReviewRequest)(nil),       // 21: service.order.v1.ReplyGoodsReviewRequest
+	(*GoodsReviewFilterRequest)(nil),      // 22: service.order.v1.GoodsReviewFilterRequest
+	(*AdminGoodsReviewFilterRequest)(nil), // 23: service.order.v1.AdminGoodsReviewFilterRequest
+	(*GoodsReviewInfoResponse)(nil),       // 24: service.order.v1.GoodsReviewInfoResponse
+	(*GoodsReviewListResponse)(nil),       // 25: service.order.v1.GoodsReviewListResponse
+	(*GoodsCoPurchaseRequest)(nil),        // 26: service.order.v1.GoodsCoPurchaseRequest
+	(*GoodsCoPurchaseInfo)(nil),           // 27: service.order.v1.GoodsCoPurchaseInfo
+	(*GoodsCoPurchaseResponse)(nil),       // 28: service.order.v1.GoodsCoPurchaseResponse
 }
 var file_order_v1_message_proto_depIdxs = []int32{
-	5, // 0: service.order.v1.OrderInfoDetailResponse.orderInfo:type_name -> service.order.v1.OrderInfoResponse
-	7, // 1: service.order.v1.OrderInfoDetailResponse.goods:type_name -> service.order.v1.OrderItemResponse
-	5, // 2: service.order.v1.OrderListResponse.data:type_name -> service.order.v1.OrderInfoResponse
-	6, // 3: service.order.v1.CartItemListResponse.data:type_name -> service.order.v1.ShopCartInfoResponse
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5,  // 0: service.order.v1.OrderInfoDetailResponse.orderInfo:type_name -> service.order.v1.OrderInfoResponse
+	7,  // 1: service.order.v1.OrderInfoDetailResponse.goods:type_name -> service.order.v1.OrderItemResponse
+	5,  // 2: service.order.v1.OrderListResponse.data:type_name -> service.order.v1.OrderInfoResponse
+	6,  // 3: service.order.v1.CartItemListResponse.data:type_name -> service.order.v1.ShopCartInfoResponse
+	14, // 4: service.order.v1.UserFavListResponse.data:type_name -> service.order.v1.UserFavInfoResponse
+	17, // 5: service.order.v1.UserFavStatusResponse.data:type_name -> service.order.v1.UserFavStatusInfo
+	24, // 6: service.order.v1.GoodsReviewListResponse.data:type_name -> service.order.v1.GoodsReviewInfoResponse
+	27, // 7: service.order.v1.GoodsCoPurchaseResponse.data:type_name -> service.order.v1.GoodsCoPurchaseInfo
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_order_v1_message_proto_init() }
@@ -1045,7 +2384,7 @@ func file_order_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_v1_message_proto_rawDesc), len(file_order_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
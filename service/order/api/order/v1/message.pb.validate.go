@@ -1602,3 +1602,2278 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CartItemListResponseValidationError{}
+
+// Validate checks the field values on UserFavRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserFavRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserFavRequestMultiError,
+// or nil if none found.
+func (m *UserFavRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UserFavRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsId() <= 0 {
+		err := UserFavRequestValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserFavRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavRequestMultiError is an error wrapping multiple validation errors
+// returned by UserFavRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserFavRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavRequestMultiError) AllErrors() []error { return m }
+
+// UserFavRequestValidationError is the validation error returned by
+// UserFavRequest.Validate if the designated constraints aren't met.
+type UserFavRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavRequestValidationError) ErrorName() string { return "UserFavRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserFavRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavRequestValidationError{}
+
+// Validate checks the field values on UserFavFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserFavFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavFilterRequestMultiError, or nil if none found.
+func (m *UserFavFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UserFavFilterRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPages() < 1 {
+		err := UserFavFilterRequestValidationError{
+			field:  "Pages",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPagePerNums(); val < 1 || val > 100 {
+		err := UserFavFilterRequestValidationError{
+			field:  "PagePerNums",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserFavFilterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavFilterRequestMultiError is an error wrapping multiple validation
+// errors returned by UserFavFilterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserFavFilterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavFilterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavFilterRequestMultiError) AllErrors() []error { return m }
+
+// UserFavFilterRequestValidationError is the validation error returned by
+// UserFavFilterRequest.Validate if the designated constraints aren't met.
+type UserFavFilterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavFilterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavFilterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavFilterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavFilterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavFilterRequestValidationError) ErrorName() string {
+	return "UserFavFilterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavFilterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavFilterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavFilterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavFilterRequestValidationError{}
+
+// Validate checks the field values on UserFavInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserFavInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavInfoResponseMultiError, or nil if none found.
+func (m *UserFavInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for GoodsId
+
+	// no validation rules for AddTime
+
+	// no validation rules for GoodsName
+
+	// no validation rules for GoodsImage
+
+	// no validation rules for ShopPrice
+
+	// no validation rules for OnSale
+
+	// no validation rules for GoodsExists
+
+	if len(errors) > 0 {
+		return UserFavInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by UserFavInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserFavInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavInfoResponseMultiError) AllErrors() []error { return m }
+
+// UserFavInfoResponseValidationError is the validation error returned by
+// UserFavInfoResponse.Validate if the designated constraints aren't met.
+type UserFavInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavInfoResponseValidationError) ErrorName() string {
+	return "UserFavInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavInfoResponseValidationError{}
+
+// Validate checks the field values on UserFavListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserFavListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavListResponseMultiError, or nil if none found.
+func (m *UserFavListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserFavListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserFavListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserFavListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserFavListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavListResponseMultiError is an error wrapping multiple validation
+// errors returned by UserFavListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserFavListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavListResponseMultiError) AllErrors() []error { return m }
+
+// UserFavListResponseValidationError is the validation error returned by
+// UserFavListResponse.Validate if the designated constraints aren't met.
+type UserFavListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavListResponseValidationError) ErrorName() string {
+	return "UserFavListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavListResponseValidationError{}
+
+// Validate checks the field values on UserFavStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserFavStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavStatusRequestMultiError, or nil if none found.
+func (m *UserFavStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UserFavStatusRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetGoodsIds()); l < 1 || l > 100 {
+		err := UserFavStatusRequestValidationError{
+			field:  "GoodsIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserFavStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by UserFavStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserFavStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavStatusRequestMultiError) AllErrors() []error { return m }
+
+// UserFavStatusRequestValidationError is the validation error returned by
+// UserFavStatusRequest.Validate if the designated constraints aren't met.
+type UserFavStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavStatusRequestValidationError) ErrorName() string {
+	return "UserFavStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavStatusRequestValidationError{}
+
+// Validate checks the field values on UserFavStatusInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserFavStatusInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavStatusInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavStatusInfoMultiError, or nil if none found.
+func (m *UserFavStatusInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavStatusInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GoodsId
+
+	// no validation rules for Favorited
+
+	if len(errors) > 0 {
+		return UserFavStatusInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavStatusInfoMultiError is an error wrapping multiple validation errors
+// returned by UserFavStatusInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UserFavStatusInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavStatusInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavStatusInfoMultiError) AllErrors() []error { return m }
+
+// UserFavStatusInfoValidationError is the validation error returned by
+// UserFavStatusInfo.Validate if the designated constraints aren't met.
+type UserFavStatusInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavStatusInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavStatusInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavStatusInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavStatusInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavStatusInfoValidationError) ErrorName() string {
+	return "UserFavStatusInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavStatusInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavStatusInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavStatusInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavStatusInfoValidationError{}
+
+// Validate checks the field values on UserFavStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserFavStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFavStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserFavStatusResponseMultiError, or nil if none found.
+func (m *UserFavStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFavStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserFavStatusResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserFavStatusResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserFavStatusResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserFavStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFavStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by UserFavStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserFavStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFavStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFavStatusResponseMultiError) AllErrors() []error { return m }
+
+// UserFavStatusResponseValidationError is the validation error returned by
+// UserFavStatusResponse.Validate if the designated constraints aren't met.
+type UserFavStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFavStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFavStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFavStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFavStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFavStatusResponseValidationError) ErrorName() string {
+	return "UserFavStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserFavStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFavStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFavStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFavStatusResponseValidationError{}
+
+// Validate checks the field values on GoodsReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsReviewRequestMultiError, or nil if none found.
+func (m *GoodsReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := GoodsReviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderGoodsId() <= 0 {
+		err := GoodsReviewRequestValidationError{
+			field:  "OrderGoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetStar(); val < 1 || val > 5 {
+		err := GoodsReviewRequestValidationError{
+			field:  "Star",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) > 1000 {
+		err := GoodsReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetImages()) > 9 {
+		err := GoodsReviewRequestValidationError{
+			field:  "Images",
+			reason: "value must contain no more than 9 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodsReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsReviewRequestMultiError) AllErrors() []error { return m }
+
+// GoodsReviewRequestValidationError is the validation error returned by
+// GoodsReviewRequest.Validate if the designated constraints aren't met.
+type GoodsReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsReviewRequestValidationError) ErrorName() string {
+	return "GoodsReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsReviewRequestValidationError{}
+
+// Validate checks the field values on ModerateGoodsReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerateGoodsReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerateGoodsReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerateGoodsReviewRequestMultiError, or nil if none found.
+func (m *ModerateGoodsReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerateGoodsReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ModerateGoodsReviewRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ModerateGoodsReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ModerateGoodsReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [2 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := ModerateGoodsReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ModerateGoodsReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerateGoodsReviewRequestMultiError is an error wrapping multiple
+// validation errors returned by ModerateGoodsReviewRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ModerateGoodsReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerateGoodsReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerateGoodsReviewRequestMultiError) AllErrors() []error { return m }
+
+// ModerateGoodsReviewRequestValidationError is the validation error returned
+// by ModerateGoodsReviewRequest.Validate if the designated constraints aren't met.
+type ModerateGoodsReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerateGoodsReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerateGoodsReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerateGoodsReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerateGoodsReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerateGoodsReviewRequestValidationError) ErrorName() string {
+	return "ModerateGoodsReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerateGoodsReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerateGoodsReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerateGoodsReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerateGoodsReviewRequestValidationError{}
+
+var _ModerateGoodsReviewRequest_Status_InLookup = map[int32]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on ReplyGoodsReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyGoodsReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyGoodsReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyGoodsReviewRequestMultiError, or nil if none found.
+func (m *ReplyGoodsReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyGoodsReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ReplyGoodsReviewRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReply()) > 500 {
+		err := ReplyGoodsReviewRequestValidationError{
+			field:  "Reply",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReplyGoodsReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyGoodsReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplyGoodsReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplyGoodsReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyGoodsReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyGoodsReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReplyGoodsReviewRequestValidationError is the validation error returned by
+// ReplyGoodsReviewRequest.Validate if the designated constraints aren't met.
+type ReplyGoodsReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyGoodsReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyGoodsReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyGoodsReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyGoodsReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyGoodsReviewRequestValidationError) ErrorName() string {
+	return "ReplyGoodsReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyGoodsReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyGoodsReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyGoodsReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyGoodsReviewRequestValidationError{}
+
+// Validate checks the field values on GoodsReviewFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsReviewFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsReviewFilterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsReviewFilterRequestMultiError, or nil if none found.
+func (m *GoodsReviewFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsReviewFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGoodsId() <= 0 {
+		err := GoodsReviewFilterRequestValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetStar(); val < 0 || val > 5 {
+		err := GoodsReviewFilterRequestValidationError{
+			field:  "Star",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WithImages
+
+	if m.GetPages() < 1 {
+		err := GoodsReviewFilterRequestValidationError{
+			field:  "Pages",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPagePerNums(); val < 1 || val > 100 {
+		err := GoodsReviewFilterRequestValidationError{
+			field:  "PagePerNums",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsReviewFilterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsReviewFilterRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsReviewFilterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsReviewFilterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsReviewFilterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsReviewFilterRequestMultiError) AllErrors() []error { return m }
+
+// GoodsReviewFilterRequestValidationError is the validation error returned by
+// GoodsReviewFilterRequest.Validate if the designated constraints aren't met.
+type GoodsReviewFilterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsReviewFilterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsReviewFilterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsReviewFilterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsReviewFilterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsReviewFilterRequestValidationError) ErrorName() string {
+	return "GoodsReviewFilterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsReviewFilterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsReviewFilterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsReviewFilterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsReviewFilterRequestValidationError{}
+
+// Validate checks the field values on AdminGoodsReviewFilterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminGoodsReviewFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGoodsReviewFilterRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGoodsReviewFilterRequestMultiError, or nil if none found.
+func (m *AdminGoodsReviewFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGoodsReviewFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGoodsId() < 0 {
+		err := AdminGoodsReviewFilterRequestValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetStar(); val < 0 || val > 5 {
+		err := AdminGoodsReviewFilterRequestValidationError{
+			field:  "Star",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for WithImages
+
+	if val := m.GetStatus(); val < 0 || val > 3 {
+		err := AdminGoodsReviewFilterRequestValidationError{
+			field:  "Status",
+			reason: "value must be inside range [0, 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPages() < 1 {
+		err := AdminGoodsReviewFilterRequestValidationError{
+			field:  "Pages",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPagePerNums(); val < 1 || val > 100 {
+		err := AdminGoodsReviewFilterRequestValidationError{
+			field:  "PagePerNums",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminGoodsReviewFilterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGoodsReviewFilterRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGoodsReviewFilterRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGoodsReviewFilterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGoodsReviewFilterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGoodsReviewFilterRequestMultiError) AllErrors() []error { return m }
+
+// AdminGoodsReviewFilterRequestValidationError is the validation error
+// returned by AdminGoodsReviewFilterRequest.Validate if the designated
+// constraints aren't met.
+type AdminGoodsReviewFilterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGoodsReviewFilterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGoodsReviewFilterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGoodsReviewFilterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGoodsReviewFilterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGoodsReviewFilterRequestValidationError) ErrorName() string {
+	return "AdminGoodsReviewFilterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminGoodsReviewFilterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGoodsReviewFilterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGoodsReviewFilterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGoodsReviewFilterRequestValidationError{}
+
+// Validate checks the field values on GoodsReviewInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsReviewInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsReviewInfoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsReviewInfoResponseMultiError, or nil if none found.
+func (m *GoodsReviewInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsReviewInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for GoodsId
+
+	// no validation rules for OrderGoodsId
+
+	// no validation rules for Star
+
+	// no validation rules for Content
+
+	// no validation rules for Status
+
+	// no validation rules for RejectReason
+
+	// no validation rules for Reply
+
+	// no validation rules for ReplyTime
+
+	// no validation rules for AddTime
+
+	if len(errors) > 0 {
+		return GoodsReviewInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsReviewInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsReviewInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsReviewInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsReviewInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsReviewInfoResponseMultiError) AllErrors() []error { return m }
+
+// GoodsReviewInfoResponseValidationError is the validation error returned by
+// GoodsReviewInfoResponse.Validate if the designated constraints aren't met.
+type GoodsReviewInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsReviewInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsReviewInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsReviewInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsReviewInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsReviewInfoResponseValidationError) ErrorName() string {
+	return "GoodsReviewInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsReviewInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsReviewInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsReviewInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsReviewInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsReviewListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsReviewListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsReviewListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsReviewListResponseMultiError, or nil if none found.
+func (m *GoodsReviewListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsReviewListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsReviewListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsReviewListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsReviewListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsReviewListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsReviewListResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsReviewListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsReviewListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsReviewListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsReviewListResponseMultiError) AllErrors() []error { return m }
+
+// GoodsReviewListResponseValidationError is the validation error returned by
+// GoodsReviewListResponse.Validate if the designated constraints aren't met.
+type GoodsReviewListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsReviewListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsReviewListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsReviewListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsReviewListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsReviewListResponseValidationError) ErrorName() string {
+	return "GoodsReviewListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsReviewListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsReviewListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsReviewListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsReviewListResponseValidationError{}
+
+// Validate checks the field values on GoodsCoPurchaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsCoPurchaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsCoPurchaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsCoPurchaseRequestMultiError, or nil if none found.
+func (m *GoodsCoPurchaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsCoPurchaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLookbackDays(); val < 1 || val > 3650 {
+		err := GoodsCoPurchaseRequestValidationError{
+			field:  "LookbackDays",
+			reason: "value must be inside range [1, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinOrders() < 1 {
+		err := GoodsCoPurchaseRequestValidationError{
+			field:  "MinOrders",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTopN(); val < 1 || val > 100 {
+		err := GoodsCoPurchaseRequestValidationError{
+			field:  "TopN",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterGoodsId() < 0 {
+		err := GoodsCoPurchaseRequestValidationError{
+			field:  "AfterGoodsId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetGoodsLimit(); val < 1 || val > 1000 {
+		err := GoodsCoPurchaseRequestValidationError{
+			field:  "GoodsLimit",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsCoPurchaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsCoPurchaseRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsCoPurchaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsCoPurchaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsCoPurchaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsCoPurchaseRequestMultiError) AllErrors() []error { return m }
+
+// GoodsCoPurchaseRequestValidationError is the validation error returned by
+// GoodsCoPurchaseRequest.Validate if the designated constraints aren't met.
+type GoodsCoPurchaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsCoPurchaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsCoPurchaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsCoPurchaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsCoPurchaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsCoPurchaseRequestValidationError) ErrorName() string {
+	return "GoodsCoPurchaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsCoPurchaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsCoPurchaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsCoPurchaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsCoPurchaseRequestValidationError{}
+
+// Validate checks the field values on GoodsCoPurchaseInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsCoPurchaseInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsCoPurchaseInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsCoPurchaseInfoMultiError, or nil if none found.
+func (m *GoodsCoPurchaseInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsCoPurchaseInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GoodsId
+
+	// no validation rules for RelatedGoodsId
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return GoodsCoPurchaseInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsCoPurchaseInfoMultiError is an error wrapping multiple validation
+// errors returned by GoodsCoPurchaseInfo.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsCoPurchaseInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsCoPurchaseInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsCoPurchaseInfoMultiError) AllErrors() []error { return m }
+
+// GoodsCoPurchaseInfoValidationError is the validation error returned by
+// GoodsCoPurchaseInfo.Validate if the designated constraints aren't met.
+type GoodsCoPurchaseInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsCoPurchaseInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsCoPurchaseInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsCoPurchaseInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsCoPurchaseInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsCoPurchaseInfoValidationError) ErrorName() string {
+	return "GoodsCoPurchaseInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsCoPurchaseInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsCoPurchaseInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsCoPurchaseInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsCoPurchaseInfoValidationError{}
+
+// Validate checks the field values on GoodsCoPurchaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsCoPurchaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsCoPurchaseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsCoPurchaseResponseMultiError, or nil if none found.
+func (m *GoodsCoPurchaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsCoPurchaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsCoPurchaseResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsCoPurchaseResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsCoPurchaseResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextGoodsId
+
+	if len(errors) > 0 {
+		return GoodsCoPurchaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsCoPurchaseResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsCoPurchaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsCoPurchaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsCoPurchaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsCoPurchaseResponseMultiError) AllErrors() []error { return m }
+
+// GoodsCoPurchaseResponseValidationError is the validation error returned by
+// GoodsCoPurchaseResponse.Validate if the designated constraints aren't met.
+type GoodsCoPurchaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsCoPurchaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsCoPurchaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsCoPurchaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsCoPurchaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsCoPurchaseResponseValidationError) ErrorName() string {
+	return "GoodsCoPurchaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsCoPurchaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsCoPurchaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsCoPurchaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsCoPurchaseResponseValidationError{}
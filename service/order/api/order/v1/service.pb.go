@@ -25,50 +25,96 @@ var File_order_v1_service_proto protoreflect.FileDescriptor
 
 const file_order_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16order/v1/service.proto\x12\x10service.order.v1\x1a\x16order/v1/message.proto\x1a\x1cgoogle/api/annotations.proto2\xe7\x06\n" +
+	"\x16order/v1/service.proto\x12\x10service.order.v1\x1a\x16order/v1/message.proto\x1a\x1cgoogle/api/annotations.proto2\xc1\x10\n" +
 	"\x05Order\x12i\n" +
 	"\fCartItemList\x12\x1a.service.order.v1.UserInfo\x1a&.service.order.v1.CartItemListResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/cart/{id}\x12p\n" +
 	"\x0eCreateCartItem\x12!.service.order.v1.CartItemRequest\x1a&.service.order.v1.ShopCartInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/cart\x12f\n" +
 	"\x0eUpdateCartItem\x12!.service.order.v1.CartItemRequest\x1a\x17.service.order.v1.Empty\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/v1/cart/{id}\x12c\n" +
-	"\x0eDeleteCartItem\x12!.service.order.v1.CartItemRequest\x1a\x17.service.order.v1.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/cart/{id}\x12h\n" +
+	"\x0eDeleteCartItem\x12!.service.order.v1.CartItemRequest\x1a\x17.service.order.v1.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/cart/{id}\x12m\n" +
+	"\vUserFavList\x12&.service.order.v1.UserFavFilterRequest\x1a%.service.order.v1.UserFavListResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/v1/fav\x12^\n" +
+	"\rCreateUserFav\x12 .service.order.v1.UserFavRequest\x1a\x17.service.order.v1.Empty\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/v1/fav\x12n\n" +
+	"\rDeleteUserFav\x12 .service.order.v1.UserFavRequest\x1a\x17.service.order.v1.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/fav/{userId}/{goodsId}\x12{\n" +
+	"\rUserFavStatus\x12&.service.order.v1.UserFavStatusRequest\x1a'.service.order.v1.UserFavStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/fav/status\x12{\n" +
+	"\x11CreateGoodsReview\x12$.service.order.v1.GoodsReviewRequest\x1a).service.order.v1.GoodsReviewInfoResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/review\x12|\n" +
+	"\x0fGoodsReviewList\x12*.service.order.v1.GoodsReviewFilterRequest\x1a).service.order.v1.GoodsReviewListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/review\x12\x8c\x01\n" +
+	"\x14AdminGoodsReviewList\x12/.service.order.v1.AdminGoodsReviewFilterRequest\x1a).service.order.v1.GoodsReviewListResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/admin/review\x12\x85\x01\n" +
+	"\x13ModerateGoodsReview\x12,.service.order.v1.ModerateGoodsReviewRequest\x1a\x17.service.order.v1.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/admin/review/{id}/status\x12~\n" +
+	"\x10ReplyGoodsReview\x12).service.order.v1.ReplyGoodsReviewRequest\x1a\x17.service.order.v1.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/admin/review/{id}/reply\x12h\n" +
 	"\vCreateOrder\x12\x1e.service.order.v1.OrderRequest\x1a#.service.order.v1.OrderInfoResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/order\x12i\n" +
 	"\tOrderList\x12$.service.order.v1.OrderFilterRequest\x1a#.service.order.v1.OrderListResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/order\x12p\n" +
 	"\vOrderDetail\x12\x1e.service.order.v1.OrderRequest\x1a).service.order.v1.OrderInfoDetailResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/order/{id}\x12m\n" +
-	"\x11UpdateOrderStatus\x12\x1d.service.order.v1.OrderStatus\x1a\x17.service.order.v1.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/order/{id}/statusBC\n" +
+	"\x11UpdateOrderStatus\x12\x1d.service.order.v1.OrderStatus\x1a\x17.service.order.v1.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/order/{id}/status\x12\x89\x01\n" +
+	"\x13GoodsCoPurchaseList\x12(.service.order.v1.GoodsCoPurchaseRequest\x1a).service.order.v1.GoodsCoPurchaseResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/stats/co-purchaseBC\n" +
 	"\x1aservice.order.api.order.v1P\x01Z#mshop/service/order/api/order/v1;v1b\x06proto3"
 
 var file_order_v1_service_proto_goTypes = []any{
-	(*UserInfo)(nil),                // 0: service.order.v1.UserInfo
-	(*CartItemRequest)(nil),         // 1: service.order.v1.CartItemRequest
-	(*OrderRequest)(nil),            // 2: service.order.v1.OrderRequest
-	(*OrderFilterRequest)(nil),      // 3: service.order.v1.OrderFilterRequest
-	(*OrderStatus)(nil),             // 4: service.order.v1.OrderStatus
-	(*CartItemListResponse)(nil),    // 5: service.order.v1.CartItemListResponse
-	(*ShopCartInfoResponse)(nil),    // 6: service.order.v1.ShopCartInfoResponse
-	(*Empty)(nil),                   // 7: service.order.v1.Empty
-	(*OrderInfoResponse)(nil),       // 8: service.order.v1.OrderInfoResponse
-	(*OrderListResponse)(nil),       // 9: service.order.v1.OrderListResponse
-	(*OrderInfoDetailResponse)(nil), // 10: service.order.v1.OrderInfoDetailResponse
+	(*UserInfo)(nil),                      // 0: service.order.v1.UserInfo
+	(*CartItemRequest)(nil),               // 1: service.order.v1.CartItemRequest
+	(*UserFavFilterRequest)(nil),          // 2: service.order.v1.UserFavFilterRequest
+	(*UserFavRequest)(nil),                // 3: service.order.v1.UserFavRequest
+	(*UserFavStatusRequest)(nil),          // 4: service.order.v1.UserFavStatusRequest
+	(*GoodsReviewRequest)(nil),            // 5: service.order.v1.GoodsReviewRequest
+	(*GoodsReviewFilterRequest)(nil),      // 6: service.order.v1.GoodsReviewFilterRequest
+	(*AdminGoodsReviewFilterRequest)(nil), // 7: service.order.v1.AdminGoodsReviewFilterRequest
+	(*ModerateGoodsReviewRequest)(nil),    // 8: service.order.v1.ModerateGoodsReviewRequest
+	(*ReplyGoodsReviewRequest)(nil),       // 9: service.order.v1.ReplyGoodsReviewRequest
+	(*OrderRequest)(nil),                  // 10: service.order.v1.OrderRequest
+	(*OrderFilterRequest)(nil),            // 11: service.order.v1.OrderFilterRequest
+	(*OrderStatus)(nil),                   // 12: service.order.v1.OrderStatus
+	(*GoodsCoPurchaseRequest)(nil),        // 13: service.order.v1.GoodsCoPurchaseRequest
+	(*CartItemListResponse)(nil),          // 14: service.order.v1.CartItemListResponse
+	(*ShopCartInfoResponse)(nil),          // 15: service.order.v1.ShopCartInfoResponse
+	(*Empty)(nil),                         // 16: service.order.v1.Empty
+	(*UserFavListResponse)(nil),           // 17: service.order.v1.UserFavListResponse
+	(*UserFavStatusResponse)(nil),         // 18: service.order.v1.UserFavStatusResponse
+	(*GoodsReviewInfoResponse)(nil),       // 19: service.order.v1.GoodsReviewInfoResponse
+	(*GoodsReviewListResponse)(nil),       // 20: service.order.v1.GoodsReviewListResponse
+	(*OrderInfoResponse)(nil),             // 21: service.order.v1.OrderInfoResponse
+	(*OrderListResponse)(nil),             // 22: service.order.v1.OrderListResponse
+	(*OrderInfoDetailResponse)(nil),       // 23: service.order.v1.OrderInfoDetailResponse
+	(*GoodsCoPurchaseResponse)(nil),       // 24: service.order.v1.GoodsCoPurchaseResponse
 }
 var file_order_v1_service_proto_depIdxs = []int32{
 	0,  // 0: service.order.v1.Order.CartItemList:input_type -> service.order.v1.UserInfo
 	1,  // 1: service.order.v1.Order.CreateCartItem:input_type -> service.order.v1.CartItemRequest
 	1,  // 2: service.order.v1.Order.UpdateCartItem:input_type -> service.order.v1.CartItemRequest
 	1,  // 3: service.order.v1.Order.DeleteCartItem:input_type -> service.order.v1.CartItemRequest
-	2,  // 4: service.order.v1.Order.CreateOrder:input_type -> service.order.v1.OrderRequest
-	3,  // 5: service.order.v1.Order.OrderList:input_type -> service.order.v1.OrderFilterRequest
-	2,  // 6: service.order.v1.Order.OrderDetail:input_type -> service.order.v1.OrderRequest
-	4,  // 7: service.order.v1.Order.UpdateOrderStatus:input_type -> service.order.v1.OrderStatus
-	5,  // 8: service.order.v1.Order.CartItemList:output_type -> service.order.v1.CartItemListResponse
-	6,  // 9: service.order.v1.Order.CreateCartItem:output_type -> service.order.v1.ShopCartInfoResponse
-	7,  // 10: service.order.v1.Order.UpdateCartItem:output_type -> service.order.v1.Empty
-	7,  // 11: service.order.v1.Order.DeleteCartItem:output_type -> service.order.v1.Empty
-	8,  // 12: service.order.v1.Order.CreateOrder:output_type -> service.order.v1.OrderInfoResponse
-	9,  // 13: service.order.v1.Order.OrderList:output_type -> service.order.v1.OrderListResponse
-	10, // 14: service.order.v1.Order.OrderDetail:output_type -> service.order.v1.OrderInfoDetailResponse
-	7,  // 15: service.order.v1.Order.UpdateOrderStatus:output_type -> service.order.v1.Empty
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	2,  // 4: service.order.v1.Order.UserFavList:input_type -> service.order.v1.UserFavFilterRequest
+	3,  // 5: service.order.v1.Order.CreateUserFav:input_type -> service.order.v1.UserFavRequest
+	3,  // 6: service.order.v1.Order.DeleteUserFav:input_type -> service.order.v1.UserFavRequest
+	4,  // 7: service.order.v1.Order.UserFavStatus:input_type -> service.order.v1.UserFavStatusRequest
+	5,  // 8: service.order.v1.Order.CreateGoodsReview:input_type -> service.order.v1.GoodsReviewRequest
+	6,  // 9: service.order.v1.Order.GoodsReviewList:input_type -> service.order.v1.GoodsReviewFilterRequest
+	7,  // 10: service.order.v1.Order.AdminGoodsReviewList:input_type -> service.order.v1.AdminGoodsReviewFilterRequest
+	8,  // 11: service.order.v1.Order.ModerateGoodsReview:input_type -> service.order.v1.ModerateGoodsReviewRequest
+	9,  // 12: service.order.v1.Order.ReplyGoodsReview:input_type -> service.order.v1.ReplyGoodsReviewRequest
+	10, // 13: service.order.v1.Order.CreateOrder:input_type -> service.order.v1.OrderRequest
+	11, // 14: service.order.v1.Order.OrderList:input_type -> service.order.v1.OrderFilterRequest
+	10, // 15: service.order.v1.Order.OrderDetail:input_type -> service.order.v1.OrderRequest
+	12, // 16: service.order.v1.Order.UpdateOrderStatus:input_type -> service.order.v1.OrderStatus
+	13, // 17: service.order.v1.Order.GoodsCoPurchaseList:input_type -> service.order.v1.GoodsCoPurchaseRequest
+	14, // 18: service.order.v1.Order.CartItemList:output_type -> service.order.v1.CartItemListResponse
+	15, // 19: service.order.v1.Order.CreateCartItem:output_type -> service.order.v1.ShopCartInfoResponse
+	16, // 20: service.order.v1.Order.UpdateCartItem:output_type -> service.order.v1.Empty
+	16, // 21: service.order.v1.Order.DeleteCartItem:output_type -> service.order.v1.Empty
+	17, // 22: service.order.v1.Order.UserFavList:output_type -> service.order.v1.UserFavListResponse
+	16, // 23: service.order.v1.Order.CreateUserFav:output_type -> service.order.v1.Empty
+	16, // 24: service.order.v1.Order.DeleteUserFav:output_type -> service.order.v1.Empty
+	18, // 25: service.order.v1.Order.UserFavStatus:output_type -> service.order.v1.UserFavStatusResponse
+	19, // 26: service.order.v1.Order.CreateGoodsReview:output_type -> service.order.v1.GoodsReviewInfoResponse
+	20, // 27: service.order.v1.Order.GoodsReviewList:output_type -> service.order.v1.GoodsReviewListResponse
+	20, // 28: service.order.v1.Order.AdminGoodsReviewList:output_type -> service.order.v1.GoodsReviewListResponse
+	16, // 29: service.order.v1.Order.ModerateGoodsReview:output_type -> service.order.v1.Empty
+	16, // 30: service.order.v1.Order.ReplyGoodsReview:output_type -> service.order.v1.Empty
+	21, // 31: service.order.v1.Order.CreateOrder:output_type -> service.order.v1.OrderInfoResponse
+	22, // 32: service.order.v1.Order.OrderList:output_type -> service.order.v1.OrderListResponse
+	23, // 33: service.order.v1.Order.OrderDetail:output_type -> service.order.v1.OrderInfoDetailResponse
+	16, // 34: service.order.v1.Order.UpdateOrderStatus:output_type -> service.order.v1.Empty
+	24, // 35: service.order.v1.Order.GoodsCoPurchaseList:output_type -> service.order.v1.GoodsCoPurchaseResponse
+	18, // [18:36] is the sub-list for method output_type
+	0,  // [0:18] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
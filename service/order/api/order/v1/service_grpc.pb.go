@@ -19,14 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Order_CartItemList_FullMethodName      = "/service.order.v1.Order/CartItemList"
-	Order_CreateCartItem_FullMethodName    = "/service.order.v1.Order/CreateCartItem"
-	Order_UpdateCartItem_FullMethodName    = "/service.order.v1.Order/UpdateCartItem"
-	Order_DeleteCartItem_FullMethodName    = "/service.order.v1.Order/DeleteCartItem"
-	Order_CreateOrder_FullMethodName       = "/service.order.v1.Order/CreateOrder"
-	Order_OrderList_FullMethodName         = "/service.order.v1.Order/OrderList"
-	Order_OrderDetail_FullMethodName       = "/service.order.v1.Order/OrderDetail"
-	Order_UpdateOrderStatus_FullMethodName = "/service.order.v1.Order/UpdateOrderStatus"
+	Order_CartItemList_FullMethodName         = "/service.order.v1.Order/CartItemList"
+	Order_CreateCartItem_FullMethodName       = "/service.order.v1.Order/CreateCartItem"
+	Order_UpdateCartItem_FullMethodName       = "/service.order.v1.Order/UpdateCartItem"
+	Order_DeleteCartItem_FullMethodName       = "/service.order.v1.Order/DeleteCartItem"
+	Order_UserFavList_FullMethodName          = "/service.order.v1.Order/UserFavList"
+	Order_CreateUserFav_FullMethodName        = "/service.order.v1.Order/CreateUserFav"
+	Order_DeleteUserFav_FullMethodName        = "/service.order.v1.Order/DeleteUserFav"
+	Order_UserFavStatus_FullMethodName        = "/service.order.v1.Order/UserFavStatus"
+	Order_CreateGoodsReview_FullMethodName    = "/service.order.v1.Order/CreateGoodsReview"
+	Order_GoodsReviewList_FullMethodName      = "/service.order.v1.Order/GoodsReviewList"
+	Order_AdminGoodsReviewList_FullMethodName = "/service.order.v1.Order/AdminGoodsReviewList"
+	Order_ModerateGoodsReview_FullMethodName  = "/service.order.v1.Order/ModerateGoodsReview"
+	Order_ReplyGoodsReview_FullMethodName     = "/service.order.v1.Order/ReplyGoodsReview"
+	Order_CreateOrder_FullMethodName          = "/service.order.v1.Order/CreateOrder"
+	Order_OrderList_FullMethodName            = "/service.order.v1.Order/OrderList"
+	Order_OrderDetail_FullMethodName          = "/service.order.v1.Order/OrderDetail"
+	Order_UpdateOrderStatus_FullMethodName    = "/service.order.v1.Order/UpdateOrderStatus"
+	Order_GoodsCoPurchaseList_FullMethodName  = "/service.order.v1.Order/GoodsCoPurchaseList"
 )
 
 // OrderClient is the client API for Order service.
@@ -45,6 +55,32 @@ type OrderClient interface {
 	// 删除购物车商品
 	// 从购物车中移除指定商品
 	DeleteCartItem(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 获取用户的收藏列表
+	// 按收藏时间倒序分页，并附带商品名称、图片、价格等信息
+	UserFavList(ctx context.Context, in *UserFavFilterRequest, opts ...grpc.CallOption) (*UserFavListResponse, error)
+	// 收藏商品
+	// 重复收藏不报错，首次收藏时累加商品收藏数
+	CreateUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 取消收藏
+	// 取消后扣减商品收藏数
+	DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 批量查询商品是否已收藏
+	// 用于商品列表、详情页展示收藏状态
+	UserFavStatus(ctx context.Context, in *UserFavStatusRequest, opts ...grpc.CallOption) (*UserFavStatusResponse, error)
+	// 评价订单商品
+	// 只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+	CreateGoodsReview(ctx context.Context, in *GoodsReviewRequest, opts ...grpc.CallOption) (*GoodsReviewInfoResponse, error)
+	// 获取商品评价列表
+	// 只返回审核通过的评价，支持按星级、是否有图筛选和分页查询
+	GoodsReviewList(ctx context.Context, in *GoodsReviewFilterRequest, opts ...grpc.CallOption) (*GoodsReviewListResponse, error)
+	// 获取评价审核列表
+	// 支持按商品、星级、是否有图、审核状态筛选和分页查询
+	AdminGoodsReviewList(ctx context.Context, in *AdminGoodsReviewFilterRequest, opts ...grpc.CallOption) (*GoodsReviewListResponse, error)
+	// 审核评价
+	// 审核结果变化后同步商品评分汇总
+	ModerateGoodsReview(ctx context.Context, in *ModerateGoodsReviewRequest, opts ...grpc.CallOption) (*Empty, error)
+	// 商家回复评价
+	ReplyGoodsReview(ctx context.Context, in *ReplyGoodsReviewRequest, opts ...grpc.CallOption) (*Empty, error)
 	// 创建订单
 	// 从购物车中选中的商品创建订单，包括扣减库存、生成订单号等操作
 	CreateOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoResponse, error)
@@ -57,6 +93,9 @@ type OrderClient interface {
 	// 更新订单状态
 	// 修改订单的支付状态或配送状态
 	UpdateOrderStatus(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*Empty, error)
+	// 获取商品共同购买统计
+	// 统计已支付订单中同一订单内的商品组合，按商品ID分页，供商品服务重建推荐数据
+	GoodsCoPurchaseList(ctx context.Context, in *GoodsCoPurchaseRequest, opts ...grpc.CallOption) (*GoodsCoPurchaseResponse, error)
 }
 
 type orderClient struct {
@@ -107,6 +146,96 @@ func (c *orderClient) DeleteCartItem(ctx context.Context, in *CartItemRequest, o
 	return out, nil
 }
 
+func (c *orderClient) UserFavList(ctx context.Context, in *UserFavFilterRequest, opts ...grpc.CallOption) (*UserFavListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFavListResponse)
+	err := c.cc.Invoke(ctx, Order_UserFavList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Order_CreateUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Order_DeleteUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) UserFavStatus(ctx context.Context, in *UserFavStatusRequest, opts ...grpc.CallOption) (*UserFavStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFavStatusResponse)
+	err := c.cc.Invoke(ctx, Order_UserFavStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateGoodsReview(ctx context.Context, in *GoodsReviewRequest, opts ...grpc.CallOption) (*GoodsReviewInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsReviewInfoResponse)
+	err := c.cc.Invoke(ctx, Order_CreateGoodsReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GoodsReviewList(ctx context.Context, in *GoodsReviewFilterRequest, opts ...grpc.CallOption) (*GoodsReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsReviewListResponse)
+	err := c.cc.Invoke(ctx, Order_GoodsReviewList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) AdminGoodsReviewList(ctx context.Context, in *AdminGoodsReviewFilterRequest, opts ...grpc.CallOption) (*GoodsReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsReviewListResponse)
+	err := c.cc.Invoke(ctx, Order_AdminGoodsReviewList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ModerateGoodsReview(ctx context.Context, in *ModerateGoodsReviewRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Order_ModerateGoodsReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ReplyGoodsReview(ctx context.Context, in *ReplyGoodsReviewRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Order_ReplyGoodsReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) CreateOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrderInfoResponse)
@@ -147,6 +276,16 @@ func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *OrderStatus, op
 	return out, nil
 }
 
+func (c *orderClient) GoodsCoPurchaseList(ctx context.Context, in *GoodsCoPurchaseRequest, opts ...grpc.CallOption) (*GoodsCoPurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsCoPurchaseResponse)
+	err := c.cc.Invoke(ctx, Order_GoodsCoPurchaseList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility.
@@ -163,6 +302,32 @@ type OrderServer interface {
 	// 删除购物车商品
 	// 从购物车中移除指定商品
 	DeleteCartItem(context.Context, *CartItemRequest) (*Empty, error)
+	// 获取用户的收藏列表
+	// 按收藏时间倒序分页，并附带商品名称、图片、价格等信息
+	UserFavList(context.Context, *UserFavFilterRequest) (*UserFavListResponse, error)
+	// 收藏商品
+	// 重复收藏不报错，首次收藏时累加商品收藏数
+	CreateUserFav(context.Context, *UserFavRequest) (*Empty, error)
+	// 取消收藏
+	// 取消后扣减商品收藏数
+	DeleteUserFav(context.Context, *UserFavRequest) (*Empty, error)
+	// 批量查询商品是否已收藏
+	// 用于商品列表、详情页展示收藏状态
+	UserFavStatus(context.Context, *UserFavStatusRequest) (*UserFavStatusResponse, error)
+	// 评价订单商品
+	// 只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+	CreateGoodsReview(context.Context, *GoodsReviewRequest) (*GoodsReviewInfoResponse, error)
+	// 获取商品评价列表
+	// 只返回审核通过的评价，支持按星级、是否有图筛选和分页查询
+	GoodsReviewList(context.Context, *GoodsReviewFilterRequest) (*GoodsReviewListResponse, error)
+	// 获取评价审核列表
+	// 支持按商品、星级、是否有图、审核状态筛选和分页查询
+	AdminGoodsReviewList(context.Context, *AdminGoodsReviewFilterRequest) (*GoodsReviewListResponse, error)
+	// 审核评价
+	// 审核结果变化后同步商品评分汇总
+	ModerateGoodsReview(context.Context, *ModerateGoodsReviewRequest) (*Empty, error)
+	// 商家回复评价
+	ReplyGoodsReview(context.Context, *ReplyGoodsReviewRequest) (*Empty, error)
 	// 创建订单
 	// 从购物车中选中的商品创建订单，包括扣减库存、生成订单号等操作
 	CreateOrder(context.Context, *OrderRequest) (*OrderInfoResponse, error)
@@ -175,6 +340,9 @@ type OrderServer interface {
 	// 更新订单状态
 	// 修改订单的支付状态或配送状态
 	UpdateOrderStatus(context.Context, *OrderStatus) (*Empty, error)
+	// 获取商品共同购买统计
+	// 统计已支付订单中同一订单内的商品组合，按商品ID分页，供商品服务重建推荐数据
+	GoodsCoPurchaseList(context.Context, *GoodsCoPurchaseRequest) (*GoodsCoPurchaseResponse, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -197,6 +365,33 @@ func (UnimplementedOrderServer) UpdateCartItem(context.Context, *CartItemRequest
 func (UnimplementedOrderServer) DeleteCartItem(context.Context, *CartItemRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCartItem not implemented")
 }
+func (UnimplementedOrderServer) UserFavList(context.Context, *UserFavFilterRequest) (*UserFavListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFavList not implemented")
+}
+func (UnimplementedOrderServer) CreateUserFav(context.Context, *UserFavRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserFav not implemented")
+}
+func (UnimplementedOrderServer) DeleteUserFav(context.Context, *UserFavRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserFav not implemented")
+}
+func (UnimplementedOrderServer) UserFavStatus(context.Context, *UserFavStatusRequest) (*UserFavStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFavStatus not implemented")
+}
+func (UnimplementedOrderServer) CreateGoodsReview(context.Context, *GoodsReviewRequest) (*GoodsReviewInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoodsReview not implemented")
+}
+func (UnimplementedOrderServer) GoodsReviewList(context.Context, *GoodsReviewFilterRequest) (*GoodsReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsReviewList not implemented")
+}
+func (UnimplementedOrderServer) AdminGoodsReviewList(context.Context, *AdminGoodsReviewFilterRequest) (*GoodsReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGoodsReviewList not implemented")
+}
+func (UnimplementedOrderServer) ModerateGoodsReview(context.Context, *ModerateGoodsReviewRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateGoodsReview not implemented")
+}
+func (UnimplementedOrderServer) ReplyGoodsReview(context.Context, *ReplyGoodsReviewRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyGoodsReview not implemented")
+}
 func (UnimplementedOrderServer) CreateOrder(context.Context, *OrderRequest) (*OrderInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
@@ -209,6 +404,9 @@ func (UnimplementedOrderServer) OrderDetail(context.Context, *OrderRequest) (*Or
 func (UnimplementedOrderServer) UpdateOrderStatus(context.Context, *OrderStatus) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServer) GoodsCoPurchaseList(context.Context, *GoodsCoPurchaseRequest) (*GoodsCoPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsCoPurchaseList not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 func (UnimplementedOrderServer) testEmbeddedByValue()               {}
 
@@ -302,6 +500,168 @@ func _Order_DeleteCartItem_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_UserFavList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UserFavList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_UserFavList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UserFavList(ctx, req.(*UserFavFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeleteUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DeleteUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_UserFavStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UserFavStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_UserFavStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UserFavStatus(ctx, req.(*UserFavStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateGoodsReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateGoodsReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateGoodsReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateGoodsReview(ctx, req.(*GoodsReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GoodsReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReviewFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GoodsReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GoodsReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GoodsReviewList(ctx, req.(*GoodsReviewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_AdminGoodsReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGoodsReviewFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).AdminGoodsReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_AdminGoodsReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).AdminGoodsReviewList(ctx, req.(*AdminGoodsReviewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ModerateGoodsReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateGoodsReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ModerateGoodsReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ModerateGoodsReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ModerateGoodsReview(ctx, req.(*ModerateGoodsReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ReplyGoodsReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyGoodsReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReplyGoodsReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReplyGoodsReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReplyGoodsReview(ctx, req.(*ReplyGoodsReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +734,24 @@ func _Order_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_GoodsCoPurchaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsCoPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GoodsCoPurchaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GoodsCoPurchaseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GoodsCoPurchaseList(ctx, req.(*GoodsCoPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +775,42 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCartItem",
 			Handler:    _Order_DeleteCartItem_Handler,
 		},
+		{
+			MethodName: "UserFavList",
+			Handler:    _Order_UserFavList_Handler,
+		},
+		{
+			MethodName: "CreateUserFav",
+			Handler:    _Order_CreateUserFav_Handler,
+		},
+		{
+			MethodName: "DeleteUserFav",
+			Handler:    _Order_DeleteUserFav_Handler,
+		},
+		{
+			MethodName: "UserFavStatus",
+			Handler:    _Order_UserFavStatus_Handler,
+		},
+		{
+			MethodName: "CreateGoodsReview",
+			Handler:    _Order_CreateGoodsReview_Handler,
+		},
+		{
+			MethodName: "GoodsReviewList",
+			Handler:    _Order_GoodsReviewList_Handler,
+		},
+		{
+			MethodName: "AdminGoodsReviewList",
+			Handler:    _Order_AdminGoodsReviewList_Handler,
+		},
+		{
+			MethodName: "ModerateGoodsReview",
+			Handler:    _Order_ModerateGoodsReview_Handler,
+		},
+		{
+			MethodName: "ReplyGoodsReview",
+			Handler:    _Order_ReplyGoodsReview_Handler,
+		},
 		{
 			MethodName: "CreateOrder",
 			Handler:    _Order_CreateOrder_Handler,
@@ -413,6 +827,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _Order_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "GoodsCoPurchaseList",
+			Handler:    _Order_GoodsCoPurchaseList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/v1/service.proto",
@@ -19,40 +19,79 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationOrderAdminGoodsReviewList = "/service.order.v1.Order/AdminGoodsReviewList"
 const OperationOrderCartItemList = "/service.order.v1.Order/CartItemList"
 const OperationOrderCreateCartItem = "/service.order.v1.Order/CreateCartItem"
+const OperationOrderCreateGoodsReview = "/service.order.v1.Order/CreateGoodsReview"
 const OperationOrderCreateOrder = "/service.order.v1.Order/CreateOrder"
+const OperationOrderCreateUserFav = "/service.order.v1.Order/CreateUserFav"
 const OperationOrderDeleteCartItem = "/service.order.v1.Order/DeleteCartItem"
+const OperationOrderDeleteUserFav = "/service.order.v1.Order/DeleteUserFav"
+const OperationOrderGoodsCoPurchaseList = "/service.order.v1.Order/GoodsCoPurchaseList"
+const OperationOrderGoodsReviewList = "/service.order.v1.Order/GoodsReviewList"
+const OperationOrderModerateGoodsReview = "/service.order.v1.Order/ModerateGoodsReview"
 const OperationOrderOrderDetail = "/service.order.v1.Order/OrderDetail"
 const OperationOrderOrderList = "/service.order.v1.Order/OrderList"
+const OperationOrderReplyGoodsReview = "/service.order.v1.Order/ReplyGoodsReview"
 const OperationOrderUpdateCartItem = "/service.order.v1.Order/UpdateCartItem"
 const OperationOrderUpdateOrderStatus = "/service.order.v1.Order/UpdateOrderStatus"
+const OperationOrderUserFavList = "/service.order.v1.Order/UserFavList"
+const OperationOrderUserFavStatus = "/service.order.v1.Order/UserFavStatus"
 
 type OrderHTTPServer interface {
+	// AdminGoodsReviewList 获取评价审核列表
+	// 支持按商品、星级、是否有图、审核状态筛选和分页查询
+	AdminGoodsReviewList(context.Context, *AdminGoodsReviewFilterRequest) (*GoodsReviewListResponse, error)
 	// CartItemList 获取用户的购物车列表
 	// 返回指定用户的所有购物车商品信息
 	CartItemList(context.Context, *UserInfo) (*CartItemListResponse, error)
 	// CreateCartItem 添加商品到购物车
 	// 如果商品已在购物车中，则累加数量；否则创建新记录
 	CreateCartItem(context.Context, *CartItemRequest) (*ShopCartInfoResponse, error)
+	// CreateGoodsReview 评价订单商品
+	// 只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+	CreateGoodsReview(context.Context, *GoodsReviewRequest) (*GoodsReviewInfoResponse, error)
 	// CreateOrder 创建订单
 	// 从购物车中选中的商品创建订单，包括扣减库存、生成订单号等操作
 	CreateOrder(context.Context, *OrderRequest) (*OrderInfoResponse, error)
+	// CreateUserFav 收藏商品
+	// 重复收藏不报错，首次收藏时累加商品收藏数
+	CreateUserFav(context.Context, *UserFavRequest) (*Empty, error)
 	// DeleteCartItem 删除购物车商品
 	// 从购物车中移除指定商品
 	DeleteCartItem(context.Context, *CartItemRequest) (*Empty, error)
+	// DeleteUserFav 取消收藏
+	// 取消后扣减商品收藏数
+	DeleteUserFav(context.Context, *UserFavRequest) (*Empty, error)
+	// GoodsCoPurchaseList 获取商品共同购买统计
+	// 统计已支付订单中同一订单内的商品组合，按商品ID分页，供商品服务重建推荐数据
+	GoodsCoPurchaseList(context.Context, *GoodsCoPurchaseRequest) (*GoodsCoPurchaseResponse, error)
+	// GoodsReviewList 获取商品评价列表
+	// 只返回审核通过的评价，支持按星级、是否有图筛选和分页查询
+	GoodsReviewList(context.Context, *GoodsReviewFilterRequest) (*GoodsReviewListResponse, error)
+	// ModerateGoodsReview 审核评价
+	// 审核结果变化后同步商品评分汇总
+	ModerateGoodsReview(context.Context, *ModerateGoodsReviewRequest) (*Empty, error)
 	// OrderDetail 获取订单详情
 	// 返回订单基本信息和订单商品明细列表
 	OrderDetail(context.Context, *OrderRequest) (*OrderInfoDetailResponse, error)
 	// OrderList 获取订单列表
 	// 支持按用户ID筛选和分页查询
 	OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error)
+	// ReplyGoodsReview 商家回复评价
+	ReplyGoodsReview(context.Context, *ReplyGoodsReviewRequest) (*Empty, error)
 	// UpdateCartItem 更新购物车商品信息
 	// 可以修改商品数量和选中状态
 	UpdateCartItem(context.Context, *CartItemRequest) (*Empty, error)
 	// UpdateOrderStatus 更新订单状态
 	// 修改订单的支付状态或配送状态
 	UpdateOrderStatus(context.Context, *OrderStatus) (*Empty, error)
+	// UserFavList 获取用户的收藏列表
+	// 按收藏时间倒序分页，并附带商品名称、图片、价格等信息
+	UserFavList(context.Context, *UserFavFilterRequest) (*UserFavListResponse, error)
+	// UserFavStatus 批量查询商品是否已收藏
+	// 用于商品列表、详情页展示收藏状态
+	UserFavStatus(context.Context, *UserFavStatusRequest) (*UserFavStatusResponse, error)
 }
 
 func RegisterOrderHTTPServer(s *http.Server, srv OrderHTTPServer) {
@@ -61,10 +100,20 @@ func RegisterOrderHTTPServer(s *http.Server, srv OrderHTTPServer) {
 	r.POST("/v1/cart", _Order_CreateCartItem0_HTTP_Handler(srv))
 	r.PUT("/v1/cart/{id}", _Order_UpdateCartItem0_HTTP_Handler(srv))
 	r.DELETE("/v1/cart/{id}", _Order_DeleteCartItem0_HTTP_Handler(srv))
+	r.GET("/v1/fav", _Order_UserFavList0_HTTP_Handler(srv))
+	r.POST("/v1/fav", _Order_CreateUserFav0_HTTP_Handler(srv))
+	r.DELETE("/v1/fav/{userId}/{goodsId}", _Order_DeleteUserFav0_HTTP_Handler(srv))
+	r.POST("/v1/fav/status", _Order_UserFavStatus0_HTTP_Handler(srv))
+	r.POST("/v1/review", _Order_CreateGoodsReview0_HTTP_Handler(srv))
+	r.GET("/v1/review", _Order_GoodsReviewList0_HTTP_Handler(srv))
+	r.GET("/v1/admin/review", _Order_AdminGoodsReviewList0_HTTP_Handler(srv))
+	r.PUT("/v1/admin/review/{id}/status", _Order_ModerateGoodsReview0_HTTP_Handler(srv))
+	r.PUT("/v1/admin/review/{id}/reply", _Order_ReplyGoodsReview0_HTTP_Handler(srv))
 	r.POST("/v1/order", _Order_CreateOrder0_HTTP_Handler(srv))
 	r.GET("/v1/order", _Order_OrderList0_HTTP_Handler(srv))
 	r.GET("/v1/order/{id}", _Order_OrderDetail0_HTTP_Handler(srv))
 	r.PUT("/v1/order/{id}/status", _Order_UpdateOrderStatus0_HTTP_Handler(srv))
+	r.GET("/v1/stats/co-purchase", _Order_GoodsCoPurchaseList0_HTTP_Handler(srv))
 }
 
 func _Order_CartItemList0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
@@ -158,6 +207,201 @@ func _Order_DeleteCartItem0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Order_UserFavList0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UserFavFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderUserFavList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UserFavList(ctx, req.(*UserFavFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserFavListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_CreateUserFav0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UserFavRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderCreateUserFav)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateUserFav(ctx, req.(*UserFavRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_DeleteUserFav0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UserFavRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderDeleteUserFav)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteUserFav(ctx, req.(*UserFavRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_UserFavStatus0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UserFavStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderUserFavStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UserFavStatus(ctx, req.(*UserFavStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserFavStatusResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_CreateGoodsReview0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderCreateGoodsReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGoodsReview(ctx, req.(*GoodsReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsReviewInfoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_GoodsReviewList0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsReviewFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderGoodsReviewList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsReviewList(ctx, req.(*GoodsReviewFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsReviewListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_AdminGoodsReviewList0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGoodsReviewFilterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderAdminGoodsReviewList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminGoodsReviewList(ctx, req.(*AdminGoodsReviewFilterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsReviewListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_ModerateGoodsReview0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ModerateGoodsReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderModerateGoodsReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ModerateGoodsReview(ctx, req.(*ModerateGoodsReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Order_ReplyGoodsReview0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReplyGoodsReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderReplyGoodsReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplyGoodsReview(ctx, req.(*ReplyGoodsReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Order_CreateOrder0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in OrderRequest
@@ -246,31 +490,79 @@ func _Order_UpdateOrderStatus0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.C
 	}
 }
 
+func _Order_GoodsCoPurchaseList0_HTTP_Handler(srv OrderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsCoPurchaseRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOrderGoodsCoPurchaseList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsCoPurchaseList(ctx, req.(*GoodsCoPurchaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsCoPurchaseResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type OrderHTTPClient interface {
+	// AdminGoodsReviewList 获取评价审核列表
+	// 支持按商品、星级、是否有图、审核状态筛选和分页查询
+	AdminGoodsReviewList(ctx context.Context, req *AdminGoodsReviewFilterRequest, opts ...http.CallOption) (rsp *GoodsReviewListResponse, err error)
 	// CartItemList 获取用户的购物车列表
 	// 返回指定用户的所有购物车商品信息
 	CartItemList(ctx context.Context, req *UserInfo, opts ...http.CallOption) (rsp *CartItemListResponse, err error)
 	// CreateCartItem 添加商品到购物车
 	// 如果商品已在购物车中，则累加数量；否则创建新记录
 	CreateCartItem(ctx context.Context, req *CartItemRequest, opts ...http.CallOption) (rsp *ShopCartInfoResponse, err error)
+	// CreateGoodsReview 评价订单商品
+	// 只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+	CreateGoodsReview(ctx context.Context, req *GoodsReviewRequest, opts ...http.CallOption) (rsp *GoodsReviewInfoResponse, err error)
 	// CreateOrder 创建订单
 	// 从购物车中选中的商品创建订单，包括扣减库存、生成订单号等操作
 	CreateOrder(ctx context.Context, req *OrderRequest, opts ...http.CallOption) (rsp *OrderInfoResponse, err error)
+	// CreateUserFav 收藏商品
+	// 重复收藏不报错，首次收藏时累加商品收藏数
+	CreateUserFav(ctx context.Context, req *UserFavRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// DeleteCartItem 删除购物车商品
 	// 从购物车中移除指定商品
 	DeleteCartItem(ctx context.Context, req *CartItemRequest, opts ...http.CallOption) (rsp *Empty, err error)
+	// DeleteUserFav 取消收藏
+	// 取消后扣减商品收藏数
+	DeleteUserFav(ctx context.Context, req *UserFavRequest, opts ...http.CallOption) (rsp *Empty, err error)
+	// GoodsCoPurchaseList 获取商品共同购买统计
+	// 统计已支付订单中同一订单内的商品组合，按商品ID分页，供商品服务重建推荐数据
+	GoodsCoPurchaseList(ctx context.Context, req *GoodsCoPurchaseRequest, opts ...http.CallOption) (rsp *GoodsCoPurchaseResponse, err error)
+	// GoodsReviewList 获取商品评价列表
+	// 只返回审核通过的评价，支持按星级、是否有图筛选和分页查询
+	GoodsReviewList(ctx context.Context, req *GoodsReviewFilterRequest, opts ...http.CallOption) (rsp *GoodsReviewListResponse, err error)
+	// ModerateGoodsReview 审核评价
+	// 审核结果变化后同步商品评分汇总
+	ModerateGoodsReview(ctx context.Context, req *ModerateGoodsReviewRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// OrderDetail 获取订单详情
 	// 返回订单基本信息和订单商品明细列表
 	OrderDetail(ctx context.Context, req *OrderRequest, opts ...http.CallOption) (rsp *OrderInfoDetailResponse, err error)
 	// OrderList 获取订单列表
 	// 支持按用户ID筛选和分页查询
 	OrderList(ctx context.Context, req *OrderFilterRequest, opts ...http.CallOption) (rsp *OrderListResponse, err error)
+	// ReplyGoodsReview 商家回复评价
+	ReplyGoodsReview(ctx context.Context, req *ReplyGoodsReviewRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateCartItem 更新购物车商品信息
 	// 可以修改商品数量和选中状态
 	UpdateCartItem(ctx context.Context, req *CartItemRequest, opts ...http.CallOption) (rsp *Empty, err error)
 	// UpdateOrderStatus 更新订单状态
 	// 修改订单的支付状态或配送状态
 	UpdateOrderStatus(ctx context.Context, req *OrderStatus, opts ...http.CallOption) (rsp *Empty, err error)
+	// UserFavList 获取用户的收藏列表
+	// 按收藏时间倒序分页，并附带商品名称、图片、价格等信息
+	UserFavList(ctx context.Context, req *UserFavFilterRequest, opts ...http.CallOption) (rsp *UserFavListResponse, err error)
+	// UserFavStatus 批量查询商品是否已收藏
+	// 用于商品列表、详情页展示收藏状态
+	UserFavStatus(ctx context.Context, req *UserFavStatusRequest, opts ...http.CallOption) (rsp *UserFavStatusResponse, err error)
 }
 
 type OrderHTTPClientImpl struct {
@@ -281,6 +573,21 @@ func NewOrderHTTPClient(client *http.Client) OrderHTTPClient {
 	return &OrderHTTPClientImpl{client}
 }
 
+// AdminGoodsReviewList 获取评价审核列表
+// 支持按商品、星级、是否有图、审核状态筛选和分页查询
+func (c *OrderHTTPClientImpl) AdminGoodsReviewList(ctx context.Context, in *AdminGoodsReviewFilterRequest, opts ...http.CallOption) (*GoodsReviewListResponse, error) {
+	var out GoodsReviewListResponse
+	pattern := "/v1/admin/review"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOrderAdminGoodsReviewList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CartItemList 获取用户的购物车列表
 // 返回指定用户的所有购物车商品信息
 func (c *OrderHTTPClientImpl) CartItemList(ctx context.Context, in *UserInfo, opts ...http.CallOption) (*CartItemListResponse, error) {
@@ -311,6 +618,21 @@ func (c *OrderHTTPClientImpl) CreateCartItem(ctx context.Context, in *CartItemRe
 	return &out, nil
 }
 
+// CreateGoodsReview 评价订单商品
+// 只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+func (c *OrderHTTPClientImpl) CreateGoodsReview(ctx context.Context, in *GoodsReviewRequest, opts ...http.CallOption) (*GoodsReviewInfoResponse, error) {
+	var out GoodsReviewInfoResponse
+	pattern := "/v1/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOrderCreateGoodsReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateOrder 创建订单
 // 从购物车中选中的商品创建订单，包括扣减库存、生成订单号等操作
 func (c *OrderHTTPClientImpl) CreateOrder(ctx context.Context, in *OrderRequest, opts ...http.CallOption) (*OrderInfoResponse, error) {
@@ -326,6 +648,21 @@ func (c *OrderHTTPClientImpl) CreateOrder(ctx context.Context, in *OrderRequest,
 	return &out, nil
 }
 
+// CreateUserFav 收藏商品
+// 重复收藏不报错，首次收藏时累加商品收藏数
+func (c *OrderHTTPClientImpl) CreateUserFav(ctx context.Context, in *UserFavRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/fav"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOrderCreateUserFav))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DeleteCartItem 删除购物车商品
 // 从购物车中移除指定商品
 func (c *OrderHTTPClientImpl) DeleteCartItem(ctx context.Context, in *CartItemRequest, opts ...http.CallOption) (*Empty, error) {
@@ -341,6 +678,66 @@ func (c *OrderHTTPClientImpl) DeleteCartItem(ctx context.Context, in *CartItemRe
 	return &out, nil
 }
 
+// DeleteUserFav 取消收藏
+// 取消后扣减商品收藏数
+func (c *OrderHTTPClientImpl) DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/fav/{userId}/{goodsId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOrderDeleteUserFav))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GoodsCoPurchaseList 获取商品共同购买统计
+// 统计已支付订单中同一订单内的商品组合，按商品ID分页，供商品服务重建推荐数据
+func (c *OrderHTTPClientImpl) GoodsCoPurchaseList(ctx context.Context, in *GoodsCoPurchaseRequest, opts ...http.CallOption) (*GoodsCoPurchaseResponse, error) {
+	var out GoodsCoPurchaseResponse
+	pattern := "/v1/stats/co-purchase"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOrderGoodsCoPurchaseList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GoodsReviewList 获取商品评价列表
+// 只返回审核通过的评价，支持按星级、是否有图筛选和分页查询
+func (c *OrderHTTPClientImpl) GoodsReviewList(ctx context.Context, in *GoodsReviewFilterRequest, opts ...http.CallOption) (*GoodsReviewListResponse, error) {
+	var out GoodsReviewListResponse
+	pattern := "/v1/review"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOrderGoodsReviewList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ModerateGoodsReview 审核评价
+// 审核结果变化后同步商品评分汇总
+func (c *OrderHTTPClientImpl) ModerateGoodsReview(ctx context.Context, in *ModerateGoodsReviewRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/admin/review/{id}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOrderModerateGoodsReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // OrderDetail 获取订单详情
 // 返回订单基本信息和订单商品明细列表
 func (c *OrderHTTPClientImpl) OrderDetail(ctx context.Context, in *OrderRequest, opts ...http.CallOption) (*OrderInfoDetailResponse, error) {
@@ -371,6 +768,20 @@ func (c *OrderHTTPClientImpl) OrderList(ctx context.Context, in *OrderFilterRequ
 	return &out, nil
 }
 
+// ReplyGoodsReview 商家回复评价
+func (c *OrderHTTPClientImpl) ReplyGoodsReview(ctx context.Context, in *ReplyGoodsReviewRequest, opts ...http.CallOption) (*Empty, error) {
+	var out Empty
+	pattern := "/v1/admin/review/{id}/reply"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOrderReplyGoodsReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateCartItem 更新购物车商品信息
 // 可以修改商品数量和选中状态
 func (c *OrderHTTPClientImpl) UpdateCartItem(ctx context.Context, in *CartItemRequest, opts ...http.CallOption) (*Empty, error) {
@@ -400,3 +811,33 @@ func (c *OrderHTTPClientImpl) UpdateOrderStatus(ctx context.Context, in *OrderSt
 	}
 	return &out, nil
 }
+
+// UserFavList 获取用户的收藏列表
+// 按收藏时间倒序分页，并附带商品名称、图片、价格等信息
+func (c *OrderHTTPClientImpl) UserFavList(ctx context.Context, in *UserFavFilterRequest, opts ...http.CallOption) (*UserFavListResponse, error) {
+	var out UserFavListResponse
+	pattern := "/v1/fav"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOrderUserFavList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UserFavStatus 批量查询商品是否已收藏
+// 用于商品列表、详情页展示收藏状态
+func (c *OrderHTTPClientImpl) UserFavStatus(ctx context.Context, in *UserFavStatusRequest, opts ...http.CallOption) (*UserFavStatusResponse, error) {
+	var out UserFavStatusResponse
+	pattern := "/v1/fav/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOrderUserFavStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"mshop/pkg/nacosx"
+	"mshop/service/order/internal/biz"
 	"mshop/service/order/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
@@ -31,7 +32,7 @@ func init() {
 	flag.StringVar(&env, "env", "dev", "config path, eg: -env dev")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ss *biz.OrderSoldSyncer, fs *biz.FavNumSyncer, rs *biz.GoodsRatingSyncer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +42,9 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			ss,
+			fs,
+			rs,
 		),
 	)
 }
@@ -49,7 +49,10 @@ func wireApp(confServer *conf.Server, confData *conf.Data, services *conf.Servic
 	orderService := service.NewOrderService(orderUsecase)
 	grpcServer := server.NewGRPCServer(confServer, orderService, logger)
 	httpServer := server.NewHTTPServer(confServer, orderService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	orderSoldSyncer := biz.NewOrderSoldSyncer(db, logger, orderUsecase)
+	favNumSyncer := biz.NewFavNumSyncer(db, logger, orderUsecase)
+	goodsRatingSyncer := biz.NewGoodsRatingSyncer(db, logger, orderUsecase)
+	app := newApp(logger, grpcServer, httpServer, orderSoldSyncer, favNumSyncer, goodsRatingSyncer)
 	return app, func() {
 		cleanup2()
 		cleanup()
@@ -12,7 +12,7 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewOrderUsecase)
+var ProviderSet = wire.NewSet(NewOrderUsecase, NewOrderSoldSyncer, NewFavNumSyncer, NewGoodsRatingSyncer)
 
 type OrderUsecase struct {
 	db              *gorm.DB
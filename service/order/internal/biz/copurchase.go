@@ -0,0 +1,68 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	pb "mshop/service/order/api/order/v1"
+)
+
+// GoodsCoPurchaseList 统计已支付订单中同一订单内的商品组合
+// 按商品ID分页，每页统计 goodsLimit 个商品，每个商品保留订单数最高的 topN 个共同购买商品
+func (uc *OrderUsecase) GoodsCoPurchaseList(ctx context.Context, req *pb.GoodsCoPurchaseRequest) (resp *pb.GoodsCoPurchaseResponse, err error) {
+	since := time.Now().AddDate(0, 0, -int(req.LookbackDays))
+
+	var goodsIds []int32
+	if result := uc.db.WithContext(ctx).Table("order_goods a").
+		Joins("JOIN order_info o ON o.id = a.order_id").
+		Where("o.status IN ? AND a.add_time >= ? AND a.goods_id > ?", paidStatuses, since, req.AfterGoodsId).
+		Distinct("a.goods_id").Order("a.goods_id").Limit(int(req.GoodsLimit)).
+		Pluck("a.goods_id", &goodsIds); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.GoodsCoPurchaseResponse{
+		Data: make([]*pb.GoodsCoPurchaseInfo, 0),
+	}
+	if len(goodsIds) == 0 {
+		return resp, nil
+	}
+	if len(goodsIds) == int(req.GoodsLimit) {
+		resp.NextGoodsId = goodsIds[len(goodsIds)-1]
+	}
+
+	rows, err := uc.db.WithContext(ctx).Raw(`
+SELECT a.goods_id, b.goods_id AS related_goods_id, COUNT(DISTINCT a.order_id) AS score
+FROM order_goods a
+JOIN order_goods b ON b.order_id = a.order_id AND b.goods_id <> a.goods_id
+JOIN order_info o ON o.id = a.order_id
+WHERE o.status IN ? AND a.add_time >= ? AND a.goods_id IN ?
+GROUP BY a.goods_id, b.goods_id
+HAVING COUNT(DISTINCT a.order_id) >= ?
+ORDER BY a.goods_id, score DESC, related_goods_id`,
+		paidStatuses, since, goodsIds, req.MinOrders).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currentId, kept int32
+	for rows.Next() {
+		info := &pb.GoodsCoPurchaseInfo{}
+		if err := rows.Scan(&info.GoodsId, &info.RelatedGoodsId, &info.Score); err != nil {
+			return nil, err
+		}
+		if info.GoodsId != currentId {
+			currentId, kept = info.GoodsId, 0
+		}
+		if kept >= req.TopN {
+			continue
+		}
+		kept++
+		resp.Data = append(resp.Data, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
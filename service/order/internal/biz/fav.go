@@ -0,0 +1,272 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mshop/pkg/errx"
+	"mshop/pkg/utils"
+	goodsV1 "mshop/service/goods/api/goods/v1"
+	pb "mshop/service/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxFavStatusGoods 批量查询收藏状态的商品数量上限
+	maxFavStatusGoods = 100
+
+	// favSyncInterval 补偿同步收藏数的间隔
+	favSyncInterval = time.Minute
+	// favSyncDelay 变更写入超过该时间仍未同步才补偿，避免与收藏操作时的同步重复
+	favSyncDelay = time.Minute
+	// favSyncBatchSize 每轮补偿的变更数量
+	favSyncBatchSize = 100
+)
+
+// UserFavList 获取用户的收藏列表，按收藏时间倒序，并通过商品服务补充商品信息
+func (uc *OrderUsecase) UserFavList(ctx context.Context, req *pb.UserFavFilterRequest) (resp *pb.UserFavListResponse, err error) {
+	query := uc.db.WithContext(ctx).Model(&UserFav{}).Where("user_id = ?", req.UserId)
+
+	var count int64
+	if result := query.Count(&count); result.Error != nil {
+		return nil, result.Error
+	}
+	var favs []*UserFav
+	if result := query.Scopes(utils.Paginate(req.Pages, req.PagePerNums)).Order("id DESC").Find(&favs); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp = &pb.UserFavListResponse{
+		Total: int32(count),
+		Data:  make([]*pb.UserFavInfoResponse, 0, len(favs)),
+	}
+	if len(favs) == 0 {
+		return resp, nil
+	}
+
+	goodsIds := make([]int32, 0, len(favs))
+	for _, fav := range favs {
+		goodsIds = append(goodsIds, fav.GoodsId)
+	}
+	goodsResp, err := uc.goodsClient.BatchGetGoods(ctx, &goodsV1.BatchGoodsIdInfo{Id: goodsIds})
+	if err != nil {
+		return nil, err
+	}
+	goodsMap := make(map[int32]*goodsV1.GoodsInfoResponse, len(goodsResp.Data))
+	for _, good := range goodsResp.Data {
+		goodsMap[good.Id] = good
+	}
+
+	for _, fav := range favs {
+		info := &pb.UserFavInfoResponse{
+			Id:      fav.ID,
+			UserId:  fav.UserId,
+			GoodsId: fav.GoodsId,
+			AddTime: fav.AddTime.Format(time.DateTime),
+		}
+		// 商品已删除时保留收藏记录，由前端提示失效
+		if good, ok := goodsMap[fav.GoodsId]; ok {
+			info.GoodsExists = true
+			info.GoodsName = good.Name
+			info.GoodsImage = good.GoodsFrontImage
+			info.ShopPrice = good.ShopPrice
+			info.OnSale = good.OnSale
+		}
+		resp.Data = append(resp.Data, info)
+	}
+	return resp, nil
+}
+
+// CreateUserFav 收藏商品，重复收藏直接返回成功，只有新增收藏时累加商品收藏数
+func (uc *OrderUsecase) CreateUserFav(ctx context.Context, req *pb.UserFavRequest) (resp *pb.Empty, err error) {
+	if _, err = uc.goodsClient.GetGoodsDetail(ctx, &goodsV1.GoodInfoRequest{Id: req.GoodsId}); err != nil {
+		return nil, err
+	}
+
+	fav := &UserFav{
+		UserId:  req.UserId,
+		GoodsId: req.GoodsId,
+		AddTime: time.Now(),
+	}
+	var outbox *UserFavOutbox
+	err = uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(fav)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		outbox = newUserFavOutbox(fav, 1)
+		return tx.Create(outbox).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if outbox != nil {
+		uc.syncGoodsFavNum(ctx, outbox)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// DeleteUserFav 取消收藏并扣减商品收藏数
+func (uc *OrderUsecase) DeleteUserFav(ctx context.Context, req *pb.UserFavRequest) (resp *pb.Empty, err error) {
+	var fav UserFav
+	if result := uc.db.WithContext(ctx).Where("user_id = ? AND goods_id = ?", req.UserId, req.GoodsId).Limit(1).Find(&fav); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorUserFavNotFound("fav not found")
+	}
+
+	var outbox *UserFavOutbox
+	err = uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&UserFav{}, fav.ID)
+		// 并发取消时只有删除成功的请求扣减收藏数
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		outbox = newUserFavOutbox(&fav, -1)
+		return tx.Create(outbox).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if outbox != nil {
+		uc.syncGoodsFavNum(ctx, outbox)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// UserFavStatus 批量查询用户是否已收藏商品，结果与请求顺序一致
+func (uc *OrderUsecase) UserFavStatus(ctx context.Context, req *pb.UserFavStatusRequest) (resp *pb.UserFavStatusResponse, err error) {
+	if len(req.GoodsIds) > maxFavStatusGoods {
+		return nil, errx.ErrorInvalidParams("too many goods ids, max %d", maxFavStatusGoods)
+	}
+
+	resp = &pb.UserFavStatusResponse{
+		Data: make([]*pb.UserFavStatusInfo, 0, len(req.GoodsIds)),
+	}
+	if len(req.GoodsIds) == 0 {
+		return resp, nil
+	}
+
+	var favGoodsIds []int32
+	if result := uc.db.WithContext(ctx).Model(&UserFav{}).Where("user_id = ? AND goods_id IN ?", req.UserId, req.GoodsIds).Pluck("goods_id", &favGoodsIds); result.Error != nil {
+		return nil, result.Error
+	}
+	favorited := make(map[int32]bool, len(favGoodsIds))
+	for _, id := range favGoodsIds {
+		favorited[id] = true
+	}
+
+	for _, id := range req.GoodsIds {
+		resp.Data = append(resp.Data, &pb.UserFavStatusInfo{
+			GoodsId:   id,
+			Favorited: favorited[id],
+		})
+	}
+	return resp, nil
+}
+
+// newUserFavOutbox 创建收藏数变更，以收藏记录ID作为幂等键，重试不会重复计数
+func newUserFavOutbox(fav *UserFav, delta int32) *UserFavOutbox {
+	action := "add"
+	if delta < 0 {
+		action = "del"
+	}
+	return &UserFavOutbox{
+		RequestId: fmt.Sprintf("fav-%s:%d", action, fav.ID),
+		GoodsId:   fav.GoodsId,
+		Delta:     delta,
+		AddTime:   time.Now(),
+	}
+}
+
+// syncGoodsFavNum 同步商品收藏数，同步失败不影响收藏操作，由 FavNumSyncer 补偿
+func (uc *OrderUsecase) syncGoodsFavNum(ctx context.Context, outbox *UserFavOutbox) {
+	if err := uc.incrGoodsFavNum(ctx, outbox); err != nil {
+		uc.log.Errorf("failed to sync fav num of goods %d: %v", outbox.GoodsId, err)
+	}
+}
+
+// incrGoodsFavNum 累加商品收藏数并删除已同步的变更
+func (uc *OrderUsecase) incrGoodsFavNum(ctx context.Context, outbox *UserFavOutbox) error {
+	if _, err := uc.goodsClient.IncrGoodsCounters(ctx, &goodsV1.IncrGoodsCountersRequest{
+		Items:     []*goodsV1.GoodsCounterInfo{{GoodsId: outbox.GoodsId, FavNum: outbox.Delta}},
+		RequestId: outbox.RequestId,
+	}); err != nil {
+		return err
+	}
+	return uc.db.WithContext(ctx).Delete(&UserFavOutbox{}, outbox.ID).Error
+}
+
+// FavNumSyncer 收藏数补偿任务
+// 定期重新同步未成功同步的收藏数变更，保证商品收藏数最终与收藏记录一致
+type FavNumSyncer struct {
+	db  *gorm.DB
+	log *log.Helper
+	uc  *OrderUsecase
+
+	stop chan struct{}
+}
+
+// NewFavNumSyncer 创建收藏数补偿任务
+func NewFavNumSyncer(db *gorm.DB, logger log.Logger, uc *OrderUsecase) *FavNumSyncer {
+	return &FavNumSyncer{
+		db:   db,
+		log:  log.NewHelper(log.With(logger, "module", "biz/fav")),
+		uc:   uc,
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动补偿任务
+func (s *FavNumSyncer) Start(ctx context.Context) error {
+	s.log.Info("fav num syncer started")
+
+	ticker := time.NewTicker(favSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.sync(ctx)
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止补偿任务
+func (s *FavNumSyncer) Stop(ctx context.Context) error {
+	close(s.stop)
+	s.log.Info("fav num syncer stopped")
+	return nil
+}
+
+// sync 按写入顺序补偿一批变更，多实例同时补偿同一变更时由幂等键去重
+func (s *FavNumSyncer) sync(ctx context.Context) {
+	var outboxes []*UserFavOutbox
+	if result := s.db.WithContext(ctx).Where("add_time < ?", time.Now().Add(-favSyncDelay)).
+		Order("id").Limit(favSyncBatchSize).Find(&outboxes); result.Error != nil {
+		s.log.Errorf("failed to find fav num changes to sync: %v", result.Error)
+		return
+	}
+
+	synced := 0
+	for _, outbox := range outboxes {
+		if err := s.uc.incrGoodsFavNum(ctx, outbox); err != nil {
+			s.log.Errorf("failed to sync fav num of goods %d: %v", outbox.GoodsId, err)
+			continue
+		}
+		synced++
+	}
+	if synced > 0 {
+		s.log.Infof("synced %d fav num changes", synced)
+	}
+}
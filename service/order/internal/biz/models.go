@@ -32,6 +32,9 @@ type OrderInfo struct {
 	// 支付时间
 	PayTime time.Time `gorm:"type:datetime" json:"pay_time"`
 
+	// 是否已计入商品销量，支付后同步销量成功时置为 true
+	SoldCounted bool `gorm:"type:boolean;default:false" json:"sold_counted"`
+
 	// 收货人信息
 	Address      string `gorm:"type:varchar(200)" json:"address"`
 	SignerName   string `gorm:"type:varchar(20)" json:"signer_name"`
@@ -59,6 +62,62 @@ type OrderGoods struct {
 	UpdateTime time.Time `gorm:"type:datetime" json:"update_time"`
 }
 
+// UserFav 用户收藏表
+type UserFav struct {
+	ID      int32     `gorm:"primarykey;type:int" json:"id"`
+	UserId  int32     `gorm:"type:int;uniqueIndex:idx_user_goods,priority:1" json:"user_id"`
+	GoodsId int32     `gorm:"type:int;uniqueIndex:idx_user_goods,priority:2;index" json:"goods_id"`
+	AddTime time.Time `gorm:"type:datetime" json:"add_time"`
+}
+
+// UserFavOutbox 待同步到商品服务的收藏数变更，与收藏记录在同一事务中写入，同步成功后删除
+type UserFavOutbox struct {
+	ID        int32     `gorm:"primarykey;type:int" json:"id"`
+	RequestId string    `gorm:"type:varchar(64);uniqueIndex" json:"request_id"` // 商品服务计数幂等键
+	GoodsId   int32     `gorm:"type:int" json:"goods_id"`
+	Delta     int32     `gorm:"type:int" json:"delta"`
+	AddTime   time.Time `gorm:"type:datetime;index" json:"add_time"`
+}
+
+// 评价审核状态
+const (
+	ReviewStatusPending  int32 = 1 // 待审核
+	ReviewStatusApproved int32 = 2 // 审核通过
+	ReviewStatusRejected int32 = 3 // 驳回
+)
+
+// GoodsReview 商品评价表，每个订单商品只能评价一次
+type GoodsReview struct {
+	ID           int32    `gorm:"primarykey;type:int" json:"id"`
+	UserId       int32    `gorm:"type:int;index" json:"user_id"`
+	OrderId      int32    `gorm:"type:int" json:"order_id"`
+	OrderGoodsId int32    `gorm:"type:int;uniqueIndex" json:"order_goods_id"`
+	GoodsId      int32    `gorm:"type:int;index:idx_goods_status,priority:1" json:"goods_id"`
+	Star         int32    `gorm:"type:int" json:"star"` // 星级 1-5
+	Content      string   `gorm:"type:varchar(1000)" json:"content"`
+	Images       []string `gorm:"type:json;serializer:json" json:"images"`
+	HasImage     bool     `gorm:"type:boolean;default:false" json:"has_image"` // 冗余字段，用于筛选有图评价
+
+	// 审核状态
+	Status       int32  `gorm:"type:int;index:idx_goods_status,priority:2;comment:'审核状态 1(待审核), 2(通过), 3(驳回)'" json:"status"`
+	RejectReason string `gorm:"type:varchar(200)" json:"reject_reason"`
+
+	// 商家回复
+	Reply     string     `gorm:"type:varchar(500)" json:"reply"`
+	ReplyTime *time.Time `gorm:"type:datetime" json:"reply_time"`
+
+	AddTime    time.Time `gorm:"type:datetime" json:"add_time"`
+	UpdateTime time.Time `gorm:"type:datetime" json:"update_time"`
+}
+
+// GoodsRatingOutbox 待同步到商品服务的商品评分，与审核结果在同一事务中写入，同步成功后删除
+// 同一商品只保留一条，每次审核递增 Version，同步期间再次审核时不会误删
+type GoodsRatingOutbox struct {
+	GoodsId    int32     `gorm:"primarykey;type:int;autoIncrement:false" json:"goods_id"`
+	Version    int32     `gorm:"type:int;default:1" json:"version"`
+	UpdateTime time.Time `gorm:"type:datetime;index" json:"update_time"`
+}
+
 func (ShoppingCart) TableName() string {
 	return "shopping_cart"
 }
@@ -70,3 +129,19 @@ func (OrderInfo) TableName() string {
 func (OrderGoods) TableName() string {
 	return "order_goods"
 }
+
+func (UserFav) TableName() string {
+	return "user_fav"
+}
+
+func (UserFavOutbox) TableName() string {
+	return "user_fav_outbox"
+}
+
+func (GoodsReview) TableName() string {
+	return "goods_review"
+}
+
+func (GoodsRatingOutbox) TableName() string {
+	return "goods_rating_outbox"
+}
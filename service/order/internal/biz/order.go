@@ -223,5 +223,9 @@ func (uc *OrderUsecase) UpdateOrderStatus(ctx context.Context, req *pb.OrderStat
 		return nil, errx.ErrorOrderUpdateFailed("update order status failed: %v", result.Error)
 	}
 
+	if isPaidStatus(req.Status) {
+		uc.syncOrderSold(ctx, req.OrderSn)
+	}
+
 	return
 }
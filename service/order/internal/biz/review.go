@@ -0,0 +1,359 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"mshop/pkg/errx"
+	"mshop/pkg/utils"
+	goodsV1 "mshop/service/goods/api/goods/v1"
+	pb "mshop/service/order/api/order/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxReviewContentLen 评价内容最大字符数
+	maxReviewContentLen = 1000
+	// maxReviewImages 评价图片数量上限
+	maxReviewImages = 9
+	// maxReviewImageURLLen 评价图片地址最大长度
+	maxReviewImageURLLen = 500
+	// maxReviewReplyLen 商家回复最大字符数
+	maxReviewReplyLen = 500
+
+	// ratingSyncInterval 补偿同步商品评分的间隔
+	ratingSyncInterval = time.Minute
+	// ratingSyncDelay 审核后超过该时间仍未同步才补偿，避免与审核时的同步重复
+	ratingSyncDelay = time.Minute
+	// ratingSyncBatchSize 每轮补偿的商品数量
+	ratingSyncBatchSize = 100
+)
+
+// CreateGoodsReview 评价订单商品，只有订单状态为 TRADE_FINISHED 的下单用户可以评价，评价需审核通过后才展示
+func (uc *OrderUsecase) CreateGoodsReview(ctx context.Context, req *pb.GoodsReviewRequest) (resp *pb.GoodsReviewInfoResponse, err error) {
+	if req.Star < 1 || req.Star > 5 {
+		return nil, errx.ErrorGoodsReviewInvalid("star must be between 1 and 5")
+	}
+	content := strings.TrimSpace(req.Content)
+	if utf8.RuneCountInString(content) > maxReviewContentLen {
+		return nil, errx.ErrorGoodsReviewInvalid("content is too long, max %d characters", maxReviewContentLen)
+	}
+	images, err := checkReviewImages(req.Images)
+	if err != nil {
+		return nil, err
+	}
+
+	var orderGoods OrderGoods
+	if result := uc.db.WithContext(ctx).Limit(1).Find(&orderGoods, req.OrderGoodsId); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsReviewNotAllowed("order goods not found")
+	}
+	var orderInfo OrderInfo
+	if result := uc.db.WithContext(ctx).Limit(1).Find(&orderInfo, orderGoods.OrderId); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 || orderInfo.UserId != req.UserId {
+		return nil, errx.ErrorGoodsReviewNotAllowed("order goods does not belong to the user")
+	}
+	if orderInfo.Status != "TRADE_FINISHED" {
+		return nil, errx.ErrorGoodsReviewNotAllowed("order %s is not finished", orderInfo.OrderSn)
+	}
+
+	now := time.Now()
+	review := &GoodsReview{
+		UserId:       req.UserId,
+		OrderId:      orderInfo.ID,
+		OrderGoodsId: orderGoods.ID,
+		GoodsId:      orderGoods.GoodsId,
+		Star:         req.Star,
+		Content:      content,
+		Images:       images,
+		HasImage:     len(images) > 0,
+		Status:       ReviewStatusPending,
+		AddTime:      now,
+		UpdateTime:   now,
+	}
+	// 并发提交时由唯一索引保证只有一条评价
+	result := uc.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(review)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsReviewExists("order goods %d already reviewed", orderGoods.ID)
+	}
+
+	return newGoodsReviewInfo(review), nil
+}
+
+// GoodsReviewList 获取商品评价列表，只返回审核通过的评价
+func (uc *OrderUsecase) GoodsReviewList(ctx context.Context, req *pb.GoodsReviewFilterRequest) (resp *pb.GoodsReviewListResponse, err error) {
+	query := uc.db.WithContext(ctx).Model(&GoodsReview{}).Where("status = ?", ReviewStatusApproved)
+	return uc.goodsReviewList(query, req.GoodsId, req.Star, req.WithImages, req.Pages, req.PagePerNums)
+}
+
+// AdminGoodsReviewList 获取评价审核列表，未指定审核状态时返回全部评价
+func (uc *OrderUsecase) AdminGoodsReviewList(ctx context.Context, req *pb.AdminGoodsReviewFilterRequest) (resp *pb.GoodsReviewListResponse, err error) {
+	query := uc.db.WithContext(ctx).Model(&GoodsReview{})
+	if req.Status > 0 {
+		query = query.Where("status = ?", req.Status)
+	}
+	return uc.goodsReviewList(query, req.GoodsId, req.Star, req.WithImages, req.Pages, req.PagePerNums)
+}
+
+// goodsReviewList 按商品、星级、是否有图筛选评价并分页
+func (uc *OrderUsecase) goodsReviewList(query *gorm.DB, goodsId, star int32, withImages bool, pages, pagePerNums int32) (*pb.GoodsReviewListResponse, error) {
+	if goodsId > 0 {
+		query = query.Where("goods_id = ?", goodsId)
+	}
+	if star > 0 {
+		query = query.Where("star = ?", star)
+	}
+	if withImages {
+		query = query.Where("has_image = ?", true)
+	}
+
+	var count int64
+	if result := query.Count(&count); result.Error != nil {
+		return nil, result.Error
+	}
+	var reviews []*GoodsReview
+	if result := query.Scopes(utils.Paginate(pages, pagePerNums)).Order("id DESC").Find(&reviews); result.Error != nil {
+		return nil, result.Error
+	}
+
+	resp := &pb.GoodsReviewListResponse{
+		Total: int32(count),
+		Data:  make([]*pb.GoodsReviewInfoResponse, 0, len(reviews)),
+	}
+	for _, review := range reviews {
+		resp.Data = append(resp.Data, newGoodsReviewInfo(review))
+	}
+	return resp, nil
+}
+
+// ModerateGoodsReview 审核评价，通过或撤销通过时同步商品评分汇总
+func (uc *OrderUsecase) ModerateGoodsReview(ctx context.Context, req *pb.ModerateGoodsReviewRequest) (resp *pb.Empty, err error) {
+	if req.Status != ReviewStatusApproved && req.Status != ReviewStatusRejected {
+		return nil, errx.ErrorGoodsReviewInvalid("invalid review status %d", req.Status)
+	}
+
+	var review GoodsReview
+	if result := uc.db.WithContext(ctx).Limit(1).Find(&review, req.Id); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsReviewNotFound("review not found")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if req.Status == ReviewStatusApproved {
+		reason = ""
+	}
+	// 通过或撤销通过时在同一事务中登记待同步的商品评分
+	syncRating := review.Status == ReviewStatusApproved || req.Status == ReviewStatusApproved
+	var outbox *GoodsRatingOutbox
+	err = uc.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if result := tx.Model(&GoodsReview{}).Where("id = ?", review.ID).Updates(map[string]interface{}{
+			"status":        req.Status,
+			"reject_reason": reason,
+			"update_time":   now,
+		}); result.Error != nil {
+			return result.Error
+		}
+		if !syncRating {
+			return nil
+		}
+
+		if result := tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"version":     gorm.Expr("version + 1"),
+				"update_time": now,
+			}),
+		}).Create(&GoodsRatingOutbox{GoodsId: review.GoodsId, Version: 1, UpdateTime: now}); result.Error != nil {
+			return result.Error
+		}
+		outbox = &GoodsRatingOutbox{}
+		return tx.Where("goods_id = ?", review.GoodsId).Take(outbox).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if outbox != nil {
+		if err := uc.syncGoodsRating(ctx, outbox); err != nil {
+			uc.log.Errorf("failed to sync rating of goods %d: %v", outbox.GoodsId, err)
+		}
+	}
+	return &pb.Empty{}, nil
+}
+
+// ReplyGoodsReview 商家回复评价，回复为空时删除回复
+func (uc *OrderUsecase) ReplyGoodsReview(ctx context.Context, req *pb.ReplyGoodsReviewRequest) (resp *pb.Empty, err error) {
+	reply := strings.TrimSpace(req.Reply)
+	if utf8.RuneCountInString(reply) > maxReviewReplyLen {
+		return nil, errx.ErrorGoodsReviewInvalid("reply is too long, max %d characters", maxReviewReplyLen)
+	}
+
+	now := time.Now()
+	var replyTime *time.Time
+	if reply != "" {
+		replyTime = &now
+	}
+	result := uc.db.WithContext(ctx).Model(&GoodsReview{}).Where("id = ?", req.Id).Updates(map[string]interface{}{
+		"reply":       reply,
+		"reply_time":  replyTime,
+		"update_time": now,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errx.ErrorGoodsReviewNotFound("review not found")
+	}
+	return &pb.Empty{}, nil
+}
+
+// syncGoodsRating 汇总商品审核通过的评价并同步到商品服务，成功后删除对应版本的待同步记录
+// 同步失败时由 GoodsRatingSyncer 补偿
+func (uc *OrderUsecase) syncGoodsRating(ctx context.Context, outbox *GoodsRatingOutbox) error {
+	var stat struct {
+		Count int32
+		Avg   float64
+	}
+	if result := uc.db.WithContext(ctx).Model(&GoodsReview{}).
+		Select("COUNT(*) AS count, COALESCE(AVG(star), 0) AS avg").
+		Where("goods_id = ? AND status = ?", outbox.GoodsId, ReviewStatusApproved).
+		Scan(&stat); result.Error != nil {
+		return result.Error
+	}
+
+	if _, err := uc.goodsClient.UpdateGoodsRating(ctx, &goodsV1.GoodsRatingInfo{
+		GoodsId:     outbox.GoodsId,
+		RatingAvg:   float32(math.Round(stat.Avg*100) / 100),
+		RatingCount: stat.Count,
+	}); err != nil {
+		return err
+	}
+
+	// 同步期间再次审核时版本已变化，保留记录由下一轮同步
+	return uc.db.WithContext(ctx).Where("goods_id = ? AND version = ?", outbox.GoodsId, outbox.Version).Delete(&GoodsRatingOutbox{}).Error
+}
+
+// GoodsRatingSyncer 商品评分补偿任务
+// 定期重新同步未成功同步的商品评分，保证商品评分最终与审核通过的评价一致
+type GoodsRatingSyncer struct {
+	db  *gorm.DB
+	log *log.Helper
+	uc  *OrderUsecase
+
+	stop chan struct{}
+}
+
+// NewGoodsRatingSyncer 创建商品评分补偿任务
+func NewGoodsRatingSyncer(db *gorm.DB, logger log.Logger, uc *OrderUsecase) *GoodsRatingSyncer {
+	return &GoodsRatingSyncer{
+		db:   db,
+		log:  log.NewHelper(log.With(logger, "module", "biz/review")),
+		uc:   uc,
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动补偿任务
+func (s *GoodsRatingSyncer) Start(ctx context.Context) error {
+	s.log.Info("goods rating syncer started")
+
+	ticker := time.NewTicker(ratingSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.sync(ctx)
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止补偿任务
+func (s *GoodsRatingSyncer) Stop(ctx context.Context) error {
+	close(s.stop)
+	s.log.Info("goods rating syncer stopped")
+	return nil
+}
+
+// sync 补偿一批待同步的商品评分，评分按当前评价重新汇总，重复同步结果一致
+func (s *GoodsRatingSyncer) sync(ctx context.Context) {
+	var outboxes []*GoodsRatingOutbox
+	if result := s.db.WithContext(ctx).Where("update_time < ?", time.Now().Add(-ratingSyncDelay)).
+		Order("update_time").Limit(ratingSyncBatchSize).Find(&outboxes); result.Error != nil {
+		s.log.Errorf("failed to find goods ratings to sync: %v", result.Error)
+		return
+	}
+
+	synced := 0
+	for _, outbox := range outboxes {
+		if err := s.uc.syncGoodsRating(ctx, outbox); err != nil {
+			s.log.Errorf("failed to sync rating of goods %d: %v", outbox.GoodsId, err)
+			continue
+		}
+		synced++
+	}
+	if synced > 0 {
+		s.log.Infof("synced rating of %d goods", synced)
+	}
+}
+
+// checkReviewImages 校验评价图片数量和地址，只允许 http(s) 地址
+func checkReviewImages(images []string) ([]string, error) {
+	if len(images) > maxReviewImages {
+		return nil, errx.ErrorGoodsReviewInvalid("too many images, max %d", maxReviewImages)
+	}
+	result := make([]string, 0, len(images))
+	for _, image := range images {
+		image = strings.TrimSpace(image)
+		if image == "" {
+			continue
+		}
+		if len(image) > maxReviewImageURLLen {
+			return nil, errx.ErrorGoodsReviewInvalid("image url is too long, max %d characters", maxReviewImageURLLen)
+		}
+		u, err := url.Parse(image)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errx.ErrorGoodsReviewInvalid("invalid image url %q", image)
+		}
+		result = append(result, image)
+	}
+	return result, nil
+}
+
+// newGoodsReviewInfo 由评价模型构建响应
+func newGoodsReviewInfo(review *GoodsReview) *pb.GoodsReviewInfoResponse {
+	info := &pb.GoodsReviewInfoResponse{
+		Id:           review.ID,
+		UserId:       review.UserId,
+		GoodsId:      review.GoodsId,
+		OrderGoodsId: review.OrderGoodsId,
+		Star:         review.Star,
+		Content:      review.Content,
+		Images:       review.Images,
+		Status:       review.Status,
+		RejectReason: review.RejectReason,
+		Reply:        review.Reply,
+		AddTime:      review.AddTime.Format(time.DateTime),
+	}
+	if review.ReplyTime != nil {
+		info.ReplyTime = review.ReplyTime.Format(time.DateTime)
+	}
+	return info
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	goodsV1 "mshop/service/goods/api/goods/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// soldSyncInterval 补偿同步销量的间隔
+	soldSyncInterval = time.Minute
+	// soldSyncDelay 订单状态更新后超过该时间仍未计入销量才补偿，避免与更新状态时的同步重复
+	soldSyncDelay = time.Minute
+	// soldSyncWindow 只补偿该时间内更新的订单，与商品服务计数幂等键的有效期一致
+	soldSyncWindow = 7 * 24 * time.Hour
+	// soldSyncBatchSize 每轮补偿的订单数量
+	soldSyncBatchSize = 100
+)
+
+// paidStatuses 已支付的订单状态
+var paidStatuses = []string{"TRADE_SUCCESS", "TRADE_FINISHED"}
+
+// isPaidStatus 订单状态是否为已支付
+func isPaidStatus(status string) bool {
+	for _, s := range paidStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// syncOrderSold 已支付且未计入销量的订单同步商品销量，同步失败时由 OrderSoldSyncer 补偿
+func (uc *OrderUsecase) syncOrderSold(ctx context.Context, orderSn string) {
+	var order OrderInfo
+	if result := uc.db.WithContext(ctx).Where("order_sn = ?", orderSn).Limit(1).Find(&order); result.Error != nil {
+		uc.log.Errorf("failed to get order %s for sold num: %v", orderSn, result.Error)
+		return
+	} else if result.RowsAffected == 0 {
+		return
+	}
+	if !isPaidStatus(order.Status) || order.SoldCounted {
+		return
+	}
+	if err := uc.incrGoodsSoldNum(ctx, &order); err != nil {
+		uc.log.Errorf("failed to incr sold num of order %s: %v", orderSn, err)
+	}
+}
+
+// incrGoodsSoldNum 累加订单商品的销量并标记订单已计入销量
+// 以订单号作为幂等键，标记失败后重试不会重复计数
+func (uc *OrderUsecase) incrGoodsSoldNum(ctx context.Context, order *OrderInfo) error {
+	var orderGoods []OrderGoods
+	if result := uc.db.WithContext(ctx).Where("order_id = ?", order.ID).Find(&orderGoods); result.Error != nil {
+		return result.Error
+	}
+
+	if len(orderGoods) > 0 {
+		items := make([]*goodsV1.GoodsCounterInfo, 0, len(orderGoods))
+		for _, g := range orderGoods {
+			items = append(items, &goodsV1.GoodsCounterInfo{GoodsId: g.GoodsId, SoldNum: g.Nums})
+		}
+		if _, err := uc.goodsClient.IncrGoodsCounters(ctx, &goodsV1.IncrGoodsCountersRequest{
+			Items:     items,
+			RequestId: "order-paid:" + order.OrderSn,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return uc.db.WithContext(ctx).Model(&OrderInfo{}).Where("id = ?", order.ID).Update("sold_counted", true).Error
+}
+
+// OrderSoldSyncer 订单销量补偿任务
+// 定期查找已支付但未计入销量的订单重新同步，保证支付时同步失败的销量最终计入
+type OrderSoldSyncer struct {
+	db  *gorm.DB
+	log *log.Helper
+	uc  *OrderUsecase
+
+	stop chan struct{}
+}
+
+// NewOrderSoldSyncer 创建订单销量补偿任务
+func NewOrderSoldSyncer(db *gorm.DB, logger log.Logger, uc *OrderUsecase) *OrderSoldSyncer {
+	return &OrderSoldSyncer{
+		db:   db,
+		log:  log.NewHelper(log.With(logger, "module", "biz/sold")),
+		uc:   uc,
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，随应用启动补偿任务
+func (s *OrderSoldSyncer) Start(ctx context.Context) error {
+	s.log.Info("order sold syncer started")
+
+	ticker := time.NewTicker(soldSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.sync(ctx)
+		}
+	}
+}
+
+// Stop 实现 transport.Server，停止补偿任务
+func (s *OrderSoldSyncer) Stop(ctx context.Context) error {
+	close(s.stop)
+	s.log.Info("order sold syncer stopped")
+	return nil
+}
+
+// sync 补偿一批未计入销量的订单，多实例同时补偿同一订单时由幂等键去重
+func (s *OrderSoldSyncer) sync(ctx context.Context) {
+	now := time.Now()
+	var orders []*OrderInfo
+	if result := s.db.WithContext(ctx).
+		Where("status IN ? AND sold_counted = ?", paidStatuses, false).
+		Where("update_time BETWEEN ? AND ?", now.Add(-soldSyncWindow), now.Add(-soldSyncDelay)).
+		Order("id").Limit(soldSyncBatchSize).Find(&orders); result.Error != nil {
+		s.log.Errorf("failed to find orders to sync sold num: %v", result.Error)
+		return
+	}
+
+	synced := 0
+	for _, order := range orders {
+		if err := s.uc.incrGoodsSoldNum(ctx, order); err != nil {
+			s.log.Errorf("failed to sync sold num of order %s: %v", order.OrderSn, err)
+			continue
+		}
+		synced++
+	}
+	if synced > 0 {
+		s.log.Infof("synced sold num of %d orders", synced)
+	}
+}
@@ -51,3 +51,43 @@ func (s *OrderService) OrderDetail(ctx context.Context, req *pb.OrderRequest) (*
 func (s *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.OrderStatus) (*pb.Empty, error) {
 	return s.orderUsecase.UpdateOrderStatus(ctx, req)
 }
+
+func (s *OrderService) UserFavList(ctx context.Context, req *pb.UserFavFilterRequest) (*pb.UserFavListResponse, error) {
+	return s.orderUsecase.UserFavList(ctx, req)
+}
+
+func (s *OrderService) CreateUserFav(ctx context.Context, req *pb.UserFavRequest) (*pb.Empty, error) {
+	return s.orderUsecase.CreateUserFav(ctx, req)
+}
+
+func (s *OrderService) DeleteUserFav(ctx context.Context, req *pb.UserFavRequest) (*pb.Empty, error) {
+	return s.orderUsecase.DeleteUserFav(ctx, req)
+}
+
+func (s *OrderService) UserFavStatus(ctx context.Context, req *pb.UserFavStatusRequest) (*pb.UserFavStatusResponse, error) {
+	return s.orderUsecase.UserFavStatus(ctx, req)
+}
+
+func (s *OrderService) CreateGoodsReview(ctx context.Context, req *pb.GoodsReviewRequest) (*pb.GoodsReviewInfoResponse, error) {
+	return s.orderUsecase.CreateGoodsReview(ctx, req)
+}
+
+func (s *OrderService) GoodsReviewList(ctx context.Context, req *pb.GoodsReviewFilterRequest) (*pb.GoodsReviewListResponse, error) {
+	return s.orderUsecase.GoodsReviewList(ctx, req)
+}
+
+func (s *OrderService) AdminGoodsReviewList(ctx context.Context, req *pb.AdminGoodsReviewFilterRequest) (*pb.GoodsReviewListResponse, error) {
+	return s.orderUsecase.AdminGoodsReviewList(ctx, req)
+}
+
+func (s *OrderService) GoodsCoPurchaseList(ctx context.Context, req *pb.GoodsCoPurchaseRequest) (*pb.GoodsCoPurchaseResponse, error) {
+	return s.orderUsecase.GoodsCoPurchaseList(ctx, req)
+}
+
+func (s *OrderService) ModerateGoodsReview(ctx context.Context, req *pb.ModerateGoodsReviewRequest) (*pb.Empty, error) {
+	return s.orderUsecase.ModerateGoodsReview(ctx, req)
+}
+
+func (s *OrderService) ReplyGoodsReview(ctx context.Context, req *pb.ReplyGoodsReviewRequest) (*pb.Empty, error) {
+	return s.orderUsecase.ReplyGoodsReview(ctx, req)
+}